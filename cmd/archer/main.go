@@ -5,6 +5,7 @@
 package main
 
 import (
+	"errors"
 	"os"
 
 	"github.com/spf13/cobra"
@@ -23,6 +24,11 @@ func init() {
 func main() {
 	cmd := buildRootCmd()
 	if err := cmd.Execute(); err != nil {
+		// Commands such as "task run" exit with the exit code of what they ran.
+		var exitCoder interface{ ExitCode() int }
+		if errors.As(err, &exitCoder) {
+			os.Exit(exitCoder.ExitCode())
+		}
 		os.Exit(1)
 	}
 }
@@ -46,6 +52,8 @@ func buildRootCmd() *cobra.Command {
 	cmd.Version = version.Version
 	cmd.SetVersionTemplate("Archer version: {{.Version}}\n")
 
+	cli.AddGlobalFlags(cmd)
+
 	// NOTE: Order for each grouping below is significant in that it affects help menu output ordering.
 	// "Getting Started" command group.
 	cmd.AddCommand(cli.BuildInitCmd())
@@ -54,10 +62,15 @@ func buildRootCmd() *cobra.Command {
 	cmd.AddCommand(cli.BuildProjCmd())
 	cmd.AddCommand(cli.BuildEnvCmd())
 	cmd.AddCommand(cli.BuildAppCmd())
+	cmd.AddCommand(cli.BuildStorageCmd())
+	cmd.AddCommand(cli.BuildTaskCmd())
+	cmd.AddCommand(cli.BuildSecretCmd())
 
 	// "Settings" command group.
 	cmd.AddCommand(cli.BuildVersionCmd())
+	cmd.AddCommand(cli.BuildDoctorCmd())
 	cmd.AddCommand(cli.BuildCompletionCmd(cmd))
+	cmd.AddCommand(cli.BuildCompleteCmd(cmd))
 
 	// "Release" command group.
 	cmd.AddCommand(cli.BuildPipelineCmd())
@@ -0,0 +1,241 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package addons merges the CloudFormation templates under an application's addons directory
+// into a single template that is deployed as a nested stack of the application's stack.
+// It also provides ready-made addon templates for storage resources such as DynamoDB tables.
+package addons
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Parameters passed by the application stack to the addons nested stack.
+const (
+	ProjectParamKey = "Project"
+	EnvParamKey     = "Env"
+	AppParamKey     = "App"
+)
+
+const (
+	managedPolicyType = "AWS::IAM::ManagedPolicy"
+	refTag            = "!Ref"
+	refKey            = "Ref"
+)
+
+type workspaceReader interface {
+	ListAddonFiles(appName string) ([]string, error)
+	ReadFile(filename string) ([]byte, error)
+}
+
+// Addons represents the additional CloudFormation resources of an application.
+type Addons struct {
+	appName string
+	ws      workspaceReader
+}
+
+// Stack is the merged template of all the addons of an application.
+type Stack struct {
+	Template string   // The CloudFormation template of the nested stack.
+	Outputs  []Output // The outputs of the nested stack.
+}
+
+// Output is an output of the addons nested stack.
+type Output struct {
+	Name            string // The logical ID of the output.
+	IsManagedPolicy bool   // True if the output refers to an AWS::IAM::ManagedPolicy resource.
+}
+
+// New returns an Addons that reads the addon templates of the application from the workspace.
+func New(appName string, ws workspaceReader) *Addons {
+	return &Addons{
+		appName: appName,
+		ws:      ws,
+	}
+}
+
+// addonTemplate holds the sections of a CloudFormation template that addons can declare.
+type addonTemplate struct {
+	Parameters yaml.Node `yaml:"Parameters"`
+	Mappings   yaml.Node `yaml:"Mappings"`
+	Conditions yaml.Node `yaml:"Conditions"`
+	Resources  yaml.Node `yaml:"Resources"`
+	Outputs    yaml.Node `yaml:"Outputs"`
+}
+
+// cfnTemplate is the merged CloudFormation template of all the addons.
+type cfnTemplate struct {
+	AWSTemplateFormatVersion string     `yaml:"AWSTemplateFormatVersion,omitempty"`
+	Description              string     `yaml:"Description,omitempty"`
+	Parameters               *yaml.Node `yaml:"Parameters,omitempty"`
+	Mappings                 *yaml.Node `yaml:"Mappings,omitempty"`
+	Conditions               *yaml.Node `yaml:"Conditions,omitempty"`
+	Resources                *yaml.Node `yaml:"Resources,omitempty"`
+	Outputs                  *yaml.Node `yaml:"Outputs,omitempty"`
+}
+
+// Stack merges all the addon templates of the application into a single template.
+// If the application doesn't have any addons, it returns an ErrAddonsNotFound.
+func (a *Addons) Stack() (*Stack, error) {
+	files, err := a.ws.ListAddonFiles(a.appName)
+	if err != nil {
+		return nil, fmt.Errorf("list addons for application %s: %w", a.appName, err)
+	}
+	if len(files) == 0 {
+		return nil, &ErrAddonsNotFound{AppName: a.appName}
+	}
+
+	merged := &cfnTemplate{
+		AWSTemplateFormatVersion: "2010-09-09",
+		Description:              fmt.Sprintf("Additional resources for application %s.", a.appName),
+		Parameters:               defaultParameters(),
+		Mappings:                 newMappingNode(),
+		Conditions:               newMappingNode(),
+		Resources:                newMappingNode(),
+		Outputs:                  newMappingNode(),
+	}
+	for _, file := range files {
+		content, err := a.ws.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read addon %s: %w", file, err)
+		}
+		var tpl addonTemplate
+		if err := yaml.Unmarshal(content, &tpl); err != nil {
+			return nil, fmt.Errorf("unmarshal addon %s: %w", file, err)
+		}
+		if err := merged.merge(&tpl, file); err != nil {
+			return nil, err
+		}
+	}
+
+	outputs := merged.outputs()
+	for _, section := range []**yaml.Node{&merged.Mappings, &merged.Conditions, &merged.Outputs} {
+		if len((*section).Content) == 0 {
+			*section = nil
+		}
+	}
+	out, err := yaml.Marshal(merged)
+	if err != nil {
+		return nil, fmt.Errorf("marshal addons template for application %s: %w", a.appName, err)
+	}
+	return &Stack{
+		Template: string(out),
+		Outputs:  outputs,
+	}, nil
+}
+
+// merge adds the entries of each section of the other template to this template.
+// Parameters passed by the application stack are ignored, and any other duplicated
+// logical ID results in an ErrDuplicateLogicalID.
+func (t *cfnTemplate) merge(other *addonTemplate, fileName string) error {
+	sections := []struct {
+		name string
+		dst  *yaml.Node
+		src  *yaml.Node
+	}{
+		{name: "Parameters", dst: t.Parameters, src: &other.Parameters},
+		{name: "Mappings", dst: t.Mappings, src: &other.Mappings},
+		{name: "Conditions", dst: t.Conditions, src: &other.Conditions},
+		{name: "Resources", dst: t.Resources, src: &other.Resources},
+		{name: "Outputs", dst: t.Outputs, src: &other.Outputs},
+	}
+	for _, section := range sections {
+		if section.src.Kind == 0 {
+			// The addon doesn't declare this section.
+			continue
+		}
+		if section.src.Kind != yaml.MappingNode {
+			return fmt.Errorf("section %s of addon %s must be a map", section.name, fileName)
+		}
+		for i := 0; i < len(section.src.Content); i += 2 {
+			key, value := section.src.Content[i], section.src.Content[i+1]
+			if section.name == "Parameters" && isDefaultParameter(key.Value) {
+				continue
+			}
+			if mappingValue(section.dst, key.Value) != nil {
+				return &ErrDuplicateLogicalID{Section: section.name, LogicalID: key.Value, FileName: fileName}
+			}
+			section.dst.Content = append(section.dst.Content, key, value)
+		}
+	}
+	return nil
+}
+
+// outputs returns the outputs of the template and whether they refer to a managed policy.
+func (t *cfnTemplate) outputs() []Output {
+	var outputs []Output
+	for i := 0; i < len(t.Outputs.Content); i += 2 {
+		name, output := t.Outputs.Content[i].Value, t.Outputs.Content[i+1]
+		outputs = append(outputs, Output{
+			Name:            name,
+			IsManagedPolicy: t.resourceType(refName(mappingValue(output, "Value"))) == managedPolicyType,
+		})
+	}
+	return outputs
+}
+
+// resourceType returns the type of the resource with the logical ID, or an empty string if it doesn't exist.
+func (t *cfnTemplate) resourceType(logicalID string) string {
+	if logicalID == "" {
+		return ""
+	}
+	typ := mappingValue(mappingValue(t.Resources, logicalID), "Type")
+	if typ == nil {
+		return ""
+	}
+	return typ.Value
+}
+
+// refName returns the logical ID referred by a "!Ref logicalID" or "Ref: logicalID" node.
+func refName(node *yaml.Node) string {
+	if node == nil {
+		return ""
+	}
+	if node.Kind == yaml.ScalarNode && node.Tag == refTag {
+		return node.Value
+	}
+	if ref := mappingValue(node, refKey); ref != nil && ref.Kind == yaml.ScalarNode {
+		return ref.Value
+	}
+	return ""
+}
+
+// mappingValue returns the value associated with the key in a mapping node, or nil if it doesn't exist.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func defaultParameters() *yaml.Node {
+	params := newMappingNode()
+	for _, key := range []string{ProjectParamKey, EnvParamKey, AppParamKey} {
+		params.Content = append(params.Content,
+			newScalarNode(key),
+			&yaml.Node{
+				Kind:    yaml.MappingNode,
+				Content: []*yaml.Node{newScalarNode("Type"), newScalarNode("String")},
+			})
+	}
+	return params
+}
+
+func isDefaultParameter(key string) bool {
+	return key == ProjectParamKey || key == EnvParamKey || key == AppParamKey
+}
+
+func newMappingNode() *yaml.Node {
+	return &yaml.Node{Kind: yaml.MappingNode}
+}
+
+func newScalarNode(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Value: value}
+}
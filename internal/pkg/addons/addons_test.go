@@ -0,0 +1,144 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package addons
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddons_Stack(t *testing.T) {
+	const tableAddon = `Parameters:
+  App:
+    Type: String
+Resources:
+  MyTable:
+    Type: AWS::DynamoDB::Table
+    Properties:
+      TableName: !Sub ${Project}-${Env}-${App}-MyTable
+  MyTableAccessPolicy:
+    Type: AWS::IAM::ManagedPolicy
+    Properties:
+      PolicyDocument:
+        Version: 2012-10-17
+        Statement:
+          - Effect: Allow
+            Action: dynamodb:*
+            Resource: !GetAtt MyTable.Arn
+Outputs:
+  MyTableName:
+    Value: !Ref MyTable
+  MyTableAccessPolicyArn:
+    Value: !Ref MyTableAccessPolicy
+`
+	const topicAddon = `Resources:
+  MyTopic:
+    Type: AWS::SNS::Topic
+Outputs:
+  MyTopicArn:
+    Value:
+      Ref: MyTopic
+`
+
+	testCases := map[string]struct {
+		mockWorkspace func(m *mocks.MockWorkspace)
+
+		wantedStack *Stack
+		wantedErr   error
+	}{
+		"wraps error if addons cannot be listed": {
+			mockWorkspace: func(m *mocks.MockWorkspace) {
+				m.EXPECT().ListAddonFiles("frontend").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list addons for application frontend: some error"),
+		},
+		"returns ErrAddonsNotFound if there are no addons": {
+			mockWorkspace: func(m *mocks.MockWorkspace) {
+				m.EXPECT().ListAddonFiles("frontend").Return(nil, nil)
+			},
+			wantedErr: &ErrAddonsNotFound{AppName: "frontend"},
+		},
+		"returns an error if two addons declare the same resource": {
+			mockWorkspace: func(m *mocks.MockWorkspace) {
+				m.EXPECT().ListAddonFiles("frontend").Return([]string{"frontend/addons/a.yml", "frontend/addons/b.yml"}, nil)
+				m.EXPECT().ReadFile("frontend/addons/a.yml").Return([]byte(topicAddon), nil)
+				m.EXPECT().ReadFile("frontend/addons/b.yml").Return([]byte(topicAddon), nil)
+			},
+			wantedErr: &ErrDuplicateLogicalID{Section: "Resources", LogicalID: "MyTopic", FileName: "frontend/addons/b.yml"},
+		},
+		"merges addons": {
+			mockWorkspace: func(m *mocks.MockWorkspace) {
+				m.EXPECT().ListAddonFiles("frontend").Return([]string{"frontend/addons/table.yml", "frontend/addons/topic.yml"}, nil)
+				m.EXPECT().ReadFile("frontend/addons/table.yml").Return([]byte(tableAddon), nil)
+				m.EXPECT().ReadFile("frontend/addons/topic.yml").Return([]byte(topicAddon), nil)
+			},
+			wantedStack: &Stack{
+				Template: `AWSTemplateFormatVersion: "2010-09-09"
+Description: Additional resources for application frontend.
+Parameters:
+    Project:
+        Type: String
+    Env:
+        Type: String
+    App:
+        Type: String
+Resources:
+    MyTable:
+        Type: AWS::DynamoDB::Table
+        Properties:
+            TableName: !Sub ${Project}-${Env}-${App}-MyTable
+    MyTableAccessPolicy:
+        Type: AWS::IAM::ManagedPolicy
+        Properties:
+            PolicyDocument:
+                Version: 2012-10-17
+                Statement:
+                  - Effect: Allow
+                    Action: dynamodb:*
+                    Resource: !GetAtt MyTable.Arn
+    MyTopic:
+        Type: AWS::SNS::Topic
+Outputs:
+    MyTableName:
+        Value: !Ref MyTable
+    MyTableAccessPolicyArn:
+        Value: !Ref MyTableAccessPolicy
+    MyTopicArn:
+        Value:
+            Ref: MyTopic
+`,
+				Outputs: []Output{
+					{Name: "MyTableName"},
+					{Name: "MyTableAccessPolicyArn", IsManagedPolicy: true},
+					{Name: "MyTopicArn"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWorkspace := mocks.NewMockWorkspace(ctrl)
+			tc.mockWorkspace(mockWorkspace)
+
+			// WHEN
+			stack, err := New("frontend", mockWorkspace).Stack()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStack, stack)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package addons
+
+import "fmt"
+
+// ErrAddonsNotFound occurs when an application doesn't have any addon templates.
+type ErrAddonsNotFound struct {
+	AppName string
+}
+
+func (e *ErrAddonsNotFound) Error() string {
+	return fmt.Sprintf("no addons found for application %s", e.AppName)
+}
+
+// ErrDuplicateLogicalID occurs when two addon templates declare the same logical ID in a section.
+type ErrDuplicateLogicalID struct {
+	Section   string
+	LogicalID string
+	FileName  string
+}
+
+func (e *ErrDuplicateLogicalID) Error() string {
+	return fmt.Sprintf("%s %s in addon %s is already declared by another addon", e.Section, e.LogicalID, e.FileName)
+}
@@ -0,0 +1,224 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package addons
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/aws/amazon-ecs-cli-v2/templates"
+	"github.com/gobuffalo/packd"
+)
+
+const (
+	dynamoDBTemplatePath = "addons/dynamodb/cf.yml"
+	s3TemplatePath       = "addons/s3/cf.yml"
+	auroraTemplatePath   = "addons/aurora/cf.yml"
+)
+
+// Storage types supported by the storage addons.
+const (
+	DynamoDBStorageType = "DynamoDB"
+	S3StorageType       = "S3"
+	AuroraStorageType   = "Aurora Serverless"
+)
+
+// StorageTypes are the storage types that can be attached to an application.
+var StorageTypes = []string{
+	DynamoDBStorageType,
+	S3StorageType,
+	AuroraStorageType,
+}
+
+// Attribute data types supported by DynamoDB keys.
+const (
+	DDBStringType = "S"
+	DDBNumberType = "N"
+	DDBBinaryType = "B"
+)
+
+// DDBAttributeTypes are the data types of the attributes that can be used as DynamoDB keys.
+var DDBAttributeTypes = []string{
+	DDBStringType,
+	DDBNumberType,
+	DDBBinaryType,
+}
+
+// Aurora Serverless database engines.
+const (
+	AuroraMySQLEngine      = "MySQL"
+	AuroraPostgreSQLEngine = "PostgreSQL"
+)
+
+// AuroraEngines are the database engines supported by Aurora Serverless clusters.
+var AuroraEngines = []string{
+	AuroraMySQLEngine,
+	AuroraPostgreSQLEngine,
+}
+
+// DDBAttribute is a DynamoDB attribute used as a key.
+type DDBAttribute struct {
+	Name     string
+	DataType string // One of DDBAttributeTypes.
+}
+
+// DynamoDBProps holds the configuration of a DynamoDB table.
+type DynamoDBProps struct {
+	Name         string
+	PartitionKey DDBAttribute
+	SortKey      *DDBAttribute  // Optional.
+	LSIs         []DDBAttribute // Optional local secondary indexes sorted by the attribute.
+}
+
+// S3Props holds the configuration of an S3 bucket.
+type S3Props struct {
+	Name       string
+	Versioning bool
+}
+
+// AuroraProps holds the configuration of an Aurora Serverless cluster.
+type AuroraProps struct {
+	Name          string
+	Engine        string // One of AuroraEngines.
+	InitialDBName string
+}
+
+// DynamoDBTable is a storage addon for a DynamoDB table.
+type DynamoDBTable struct {
+	*DynamoDBProps
+	box packd.Box
+}
+
+// S3Bucket is a storage addon for an S3 bucket.
+type S3Bucket struct {
+	*S3Props
+	box packd.Box
+}
+
+// AuroraCluster is a storage addon for an Aurora Serverless cluster.
+type AuroraCluster struct {
+	*AuroraProps
+	box packd.Box
+}
+
+// NewDynamoDBTable returns a DynamoDB table addon.
+func NewDynamoDBTable(props *DynamoDBProps) *DynamoDBTable {
+	return &DynamoDBTable{
+		DynamoDBProps: props,
+		box:           templates.Box(),
+	}
+}
+
+// NewS3Bucket returns an S3 bucket addon.
+func NewS3Bucket(props *S3Props) *S3Bucket {
+	return &S3Bucket{
+		S3Props: props,
+		box:     templates.Box(),
+	}
+}
+
+// NewAuroraCluster returns an Aurora Serverless cluster addon.
+func NewAuroraCluster(props *AuroraProps) *AuroraCluster {
+	return &AuroraCluster{
+		AuroraProps: props,
+		box:         templates.Box(),
+	}
+}
+
+// Template returns the CloudFormation template of the table.
+func (t *DynamoDBTable) Template() (string, error) {
+	// Each attribute used as a key by the table or an index must be defined exactly once.
+	var attrs []DDBAttribute
+	seen := make(map[string]bool)
+	keys := []DDBAttribute{t.PartitionKey}
+	if t.SortKey != nil {
+		keys = append(keys, *t.SortKey)
+	}
+	for _, attr := range append(keys, t.LSIs...) {
+		if seen[attr.Name] {
+			continue
+		}
+		seen[attr.Name] = true
+		attrs = append(attrs, attr)
+	}
+	return render(t.box, dynamoDBTemplatePath, struct {
+		*DynamoDBProps
+		Attributes []DDBAttribute
+	}{
+		DynamoDBProps: t.DynamoDBProps,
+		Attributes:    attrs,
+	})
+}
+
+// ResourceName returns the name of the table in the environment.
+func (t *DynamoDBTable) ResourceName(project, env, app string) string {
+	return fmt.Sprintf("%s-%s-%s-%s", project, env, app, t.Name)
+}
+
+// Template returns the CloudFormation template of the bucket.
+func (b *S3Bucket) Template() (string, error) {
+	return render(b.box, s3TemplatePath, struct {
+		*S3Props
+		BucketSuffix string
+	}{
+		S3Props:      b.S3Props,
+		BucketSuffix: strings.ToLower(b.Name),
+	})
+}
+
+// ResourceName returns the name of the bucket in the environment.
+func (b *S3Bucket) ResourceName(project, env, app string) string {
+	return fmt.Sprintf("%s-%s-%s-%s", project, env, app, strings.ToLower(b.Name))
+}
+
+// Template returns the CloudFormation template of the cluster.
+func (c *AuroraCluster) Template() (string, error) {
+	data := struct {
+		*AuroraProps
+		Engine        string
+		EngineVersion string
+		Port          int
+		MinCapacity   int
+		Username      string
+	}{
+		AuroraProps: c.AuroraProps,
+		Engine:      "aurora-mysql",
+		// Aurora Serverless only supports specific engine versions.
+		EngineVersion: "5.7.mysql_aurora.2.07.1",
+		Port:          3306,
+		MinCapacity:   1,
+		Username:      "admin",
+	}
+	if c.AuroraProps.Engine == AuroraPostgreSQLEngine {
+		data.Engine = "aurora-postgresql"
+		data.EngineVersion = "10.7"
+		data.Port = 5432
+		data.MinCapacity = 2
+		data.Username = "postgres"
+	}
+	return render(c.box, auroraTemplatePath, data)
+}
+
+// ResourceName returns the name of the Secrets Manager secret holding the credentials of the cluster in the environment.
+func (c *AuroraCluster) ResourceName(project, env, app string) string {
+	return fmt.Sprintf("%s-%s-%s-%s", project, env, app, c.Name)
+}
+
+func render(box packd.Box, path string, data interface{}) (string, error) {
+	content, err := box.FindString(path)
+	if err != nil {
+		return "", fmt.Errorf("find template %s: %w", path, err)
+	}
+	tpl, err := template.New("template").Parse(content)
+	if err != nil {
+		return "", fmt.Errorf("parse template %s: %w", path, err)
+	}
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("execute template %s: %w", path, err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,161 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package addons
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/gobuffalo/packd"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDynamoDBTable_Template(t *testing.T) {
+	const mockTemplate = `Resources:
+  {{.Name}}:
+    Type: AWS::DynamoDB::Table
+    Properties:
+      AttributeDefinitions:{{range $attr := .Attributes}}
+        - {{$attr.Name}}: {{$attr.DataType}}{{end}}
+      HashKey: {{.PartitionKey.Name}}{{if .SortKey}}
+      RangeKey: {{.SortKey.Name}}{{end}}{{range $lsi := .LSIs}}
+      LSI: {{$lsi.Name}}{{end}}`
+
+	testCases := map[string]struct {
+		props   *DynamoDBProps
+		mockBox func(box *packd.MemoryBox)
+
+		wantedTemplate string
+		wantedErr      error
+	}{
+		"unavailable template": {
+			props:     &DynamoDBProps{Name: "Users"},
+			mockBox:   func(box *packd.MemoryBox) {},
+			wantedErr: os.ErrNotExist,
+		},
+		"render table with partition key": {
+			props: &DynamoDBProps{
+				Name:         "Users",
+				PartitionKey: DDBAttribute{Name: "id", DataType: DDBStringType},
+			},
+			mockBox: func(box *packd.MemoryBox) {
+				box.AddString(dynamoDBTemplatePath, mockTemplate)
+			},
+			wantedTemplate: `Resources:
+  Users:
+    Type: AWS::DynamoDB::Table
+    Properties:
+      AttributeDefinitions:
+        - id: S
+      HashKey: id`,
+		},
+		"render table with sort key and indexes": {
+			props: &DynamoDBProps{
+				Name:         "Users",
+				PartitionKey: DDBAttribute{Name: "id", DataType: DDBStringType},
+				SortKey:      &DDBAttribute{Name: "joined", DataType: DDBNumberType},
+				LSIs: []DDBAttribute{
+					{Name: "joined", DataType: DDBNumberType},
+					{Name: "email", DataType: DDBStringType},
+				},
+			},
+			mockBox: func(box *packd.MemoryBox) {
+				box.AddString(dynamoDBTemplatePath, mockTemplate)
+			},
+			wantedTemplate: `Resources:
+  Users:
+    Type: AWS::DynamoDB::Table
+    Properties:
+      AttributeDefinitions:
+        - id: S
+        - joined: N
+        - email: S
+      HashKey: id
+      RangeKey: joined
+      LSI: joined
+      LSI: email`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			box := packd.NewMemoryBox()
+			tc.mockBox(box)
+			table := &DynamoDBTable{
+				DynamoDBProps: tc.props,
+				box:           box,
+			}
+
+			// WHEN
+			tpl, err := table.Template()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.True(t, errors.Is(err, tc.wantedErr), "expected: %v, got: %v", tc.wantedErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTemplate, tpl)
+		})
+	}
+}
+
+func TestS3Bucket_Template(t *testing.T) {
+	// GIVEN
+	box := packd.NewMemoryBox()
+	box.AddString(s3TemplatePath, `{{.Name}}: {{.BucketSuffix}}{{if .Versioning}} versioned{{end}}`)
+	bucket := &S3Bucket{
+		S3Props: &S3Props{Name: "MyBucket", Versioning: true},
+		box:     box,
+	}
+
+	// WHEN
+	tpl, err := bucket.Template()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "MyBucket: mybucket versioned", tpl)
+	require.Equal(t, "phonetool-test-frontend-mybucket", bucket.ResourceName("phonetool", "test", "frontend"))
+}
+
+func TestAuroraCluster_Template(t *testing.T) {
+	const mockTemplate = `{{.Name}}: {{.Engine}} {{.EngineVersion}} {{.Port}} {{.MinCapacity}} {{.Username}} {{.InitialDBName}}`
+
+	testCases := map[string]struct {
+		props *AuroraProps
+
+		wantedTemplate string
+	}{
+		"mysql": {
+			props:          &AuroraProps{Name: "MyDB", Engine: AuroraMySQLEngine, InitialDBName: "main"},
+			wantedTemplate: "MyDB: aurora-mysql 5.7.mysql_aurora.2.07.1 3306 1 admin main",
+		},
+		"postgresql": {
+			props:          &AuroraProps{Name: "MyDB", Engine: AuroraPostgreSQLEngine, InitialDBName: "main"},
+			wantedTemplate: "MyDB: aurora-postgresql 10.7 5432 2 postgres main",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			box := packd.NewMemoryBox()
+			box.AddString(auroraTemplatePath, mockTemplate)
+			cluster := &AuroraCluster{
+				AuroraProps: tc.props,
+				box:         box,
+			}
+
+			// WHEN
+			tpl, err := cluster.Template()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTemplate, tpl)
+			require.Equal(t, "phonetool-test-frontend-MyDB", cluster.ResourceName("phonetool", "test", "frontend"))
+		})
+	}
+}
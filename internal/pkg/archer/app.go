@@ -10,11 +10,12 @@ type Application struct {
 	Type    string `json:"type"`    // Type of the application (LoadBalanced app, etc)
 }
 
-// ApplicationStore can List, Create and Get applications in an underlying project management store
+// ApplicationStore can List, Create, Get and Delete applications in an underlying project management store
 type ApplicationStore interface {
 	ApplicationLister
 	ApplicationGetter
 	ApplicationCreator
+	ApplicationDeleter
 }
 
 // ApplicationLister fetches and returns a list of application from an underlying project management store
@@ -32,6 +33,11 @@ type ApplicationCreator interface {
 	CreateApplication(app *Application) error
 }
 
+// ApplicationDeleter deletes an application from the underlying project management store
+type ApplicationDeleter interface {
+	DeleteApplication(projectName, appName string) error
+}
+
 const (
 	// AppCfnTemplateNameFormat is the base output file name when `app package`
 	// is called. This is also used to render the pipeline CFN template.
@@ -40,4 +46,7 @@ const (
 	// file name when `app package` is called. It's also used to render the
 	// pipeline CFN template.
 	AppCfnTemplateConfigurationNameFormat = "%s-%s.params.json"
+	// AddonsCfnTemplateNameFormat is the output file name of the merged addons
+	// template when `app package` is called with an output directory.
+	AddonsCfnTemplateNameFormat = "%s.addons.stack.yml"
 )
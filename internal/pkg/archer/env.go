@@ -7,14 +7,16 @@ package archer
 // Environment represents the configuration of a particular environment in a project. It includes
 // the environment's account and region, name, as well as the project it belongs to.
 type Environment struct {
-	Project          string `json:"project"`          // Name of the project this environment belongs to.
-	Name             string `json:"name"`             // Name of the environment, must be unique within a project.
-	Region           string `json:"region"`           // Name of the region this environment is stored in.
-	AccountID        string `json:"accountID"`        // Account ID of the account this environment is stored in.
-	Prod             bool   `json:"prod"`             // Whether or not this environment is a production environment.
-	RegistryURL      string `json:"registryURL"`      // URL For ECR Registry for this environment.
-	ExecutionRoleARN string `json:"executionRoleARN"` // ARN used by CloudFormation to make modification to the environment stack.
-	ManagerRoleARN   string `json:"managerRoleARN"`   // ARN for the manager role assumed to manipulate the environment and its applications.
+	Project          string `json:"project"`                   // Name of the project this environment belongs to.
+	Name             string `json:"name"`                      // Name of the environment, must be unique within a project.
+	Region           string `json:"region"`                    // Name of the region this environment is stored in.
+	AccountID        string `json:"accountID"`                 // Account ID of the account this environment is stored in.
+	Prod             bool   `json:"prod"`                      // Whether or not this environment is a production environment.
+	RegistryURL      string `json:"registryURL"`               // URL For ECR Registry for this environment.
+	ExecutionRoleARN string `json:"executionRoleARN"`          // ARN used by CloudFormation to make modification to the environment stack.
+	ManagerRoleARN   string `json:"managerRoleARN"`            // ARN for the manager role assumed to manipulate the environment and its applications.
+	TemplateVersion  string `json:"templateVersion,omitempty"` // Version of the CloudFormation template the environment stack was last deployed with.
+	Private          bool   `json:"private,omitempty"`         // Whether the environment has no access to the internet.
 }
 
 // EnvironmentStore can List, Create, Get, and Delete environments in an underlying project management store.
@@ -22,6 +24,7 @@ type EnvironmentStore interface {
 	EnvironmentLister
 	EnvironmentGetter
 	EnvironmentCreator
+	EnvironmentUpdater
 	EnvironmentDeleter
 }
 
@@ -40,6 +43,11 @@ type EnvironmentCreator interface {
 	CreateEnvironment(env *Environment) error
 }
 
+// EnvironmentUpdater updates an existing environment in the underlying project management store.
+type EnvironmentUpdater interface {
+	UpdateEnvironment(env *Environment) error
+}
+
 // EnvironmentDeleter deletes an environment from the underlying project management store.
 type EnvironmentDeleter interface {
 	DeleteEnvironment(projectName, environmentName string) error
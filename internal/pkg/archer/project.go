@@ -29,6 +29,7 @@ type ProjectStore interface {
 	ProjectLister
 	ProjectGetter
 	ProjectCreator
+	ProjectDeleter
 }
 
 // ProjectLister lists all the projects in the underlying project manager.
@@ -41,6 +42,11 @@ type ProjectCreator interface {
 	CreateProject(project *Project) error
 }
 
+// ProjectDeleter deletes a project and everything stored under it from the underlying project manager.
+type ProjectDeleter interface {
+	DeleteProject(projectName string) error
+}
+
 // ProjectGetter fetches an individual project from the underlying project manager.
 type ProjectGetter interface {
 	GetProject(projectName string) (*Project, error)
@@ -0,0 +1,43 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package archer
+
+import "time"
+
+// AppRevision represents a successful deployment of an application to an environment.
+type AppRevision struct {
+	Project      string    `json:"project"`              // Name of the project the application belongs to.
+	App          string    `json:"app"`                  // Name of the deployed application.
+	Env          string    `json:"env"`                  // Name of the environment the application was deployed to.
+	Number       int       `json:"number"`               // Revision number, incremented with every deployment.
+	ImageTag     string    `json:"imageTag"`             // Tag of the deployed image.
+	ManifestHash string    `json:"manifestHash"`         // SHA256 of the manifest at the time of the deployment.
+	GitCommit    string    `json:"gitCommit,omitempty"`  // Commit of the workspace, if it's a git repository.
+	Actor        string    `json:"actor"`                // ARN of the IAM identity that deployed the application.
+	DeployedAt   time.Time `json:"deployedAt"`           // Time of the deployment.
+	TemplateURL  string    `json:"templateURL"`          // URL of the deployed CloudFormation template.
+	RollbackOf   int       `json:"rollbackOf,omitempty"` // Revision that was redeployed, if the deployment is a rollback.
+}
+
+// AppRevisionStore records and retrieves the revisions of an application in an environment.
+type AppRevisionStore interface {
+	AppRevisionCreator
+	AppRevisionLister
+	AppRevisionGetter
+}
+
+// AppRevisionCreator records a new revision of an application in an environment.
+type AppRevisionCreator interface {
+	CreateAppRevision(rev *AppRevision) error
+}
+
+// AppRevisionLister lists the revisions of an application in an environment, from the most recent.
+type AppRevisionLister interface {
+	ListAppRevisions(projectName, envName, appName string) ([]*AppRevision, error)
+}
+
+// AppRevisionGetter fetches a revision of an application in an environment by number.
+type AppRevisionGetter interface {
+	GetAppRevision(projectName, envName, appName string, number int) (*AppRevision, error)
+}
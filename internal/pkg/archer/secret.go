@@ -6,9 +6,21 @@ package archer
 // Secretsmanager can create secrets in an underlying secret management store
 type SecretsManager interface {
 	SecretCreator
+	SecretLister
+	SecretDeleter
 }
 
 // SecretCreator creates a secretin the underlying secret management store
 type SecretCreator interface {
-	CreateSecret(secretName, secretString string) (string, error)
+	CreateSecret(secretName, secretString string, tags map[string]string) (string, error)
+}
+
+// SecretLister lists the names of secrets in the underlying secret management store.
+type SecretLister interface {
+	ListSecretNames(tags map[string]string) ([]string, error)
+}
+
+// SecretDeleter deletes a secret from the underlying secret management store.
+type SecretDeleter interface {
+	DeleteSecret(secretName string) error
 }
@@ -15,6 +15,7 @@ type Workspace interface {
 	Create(projectName string) error
 	Summary() (*WorkspaceSummary, error)
 	AppNames() ([]string, error)
+	ListAddonFiles(appName string) ([]string, error)
 }
 
 // ManifestIO can read, write and list local manifest files.
@@ -24,8 +25,9 @@ type ManifestIO interface {
 	AppManifestFileName(appName string) string
 }
 
-// WorkspaceFileReadWriter is the interface to read and write files to the project directory in the workspace.
+// WorkspaceFileReadWriter is the interface to read, write and delete files in the project directory in the workspace.
 type WorkspaceFileReadWriter interface {
 	WriteFile(blob []byte, filename string) (string, error)
 	ReadFile(filename string) ([]byte, error)
+	DeleteFile(filename string) error
 }
@@ -0,0 +1,93 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cloudwatchlogs wraps AWS CloudWatch Logs API functionality.
+package cloudwatchlogs
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+)
+
+// Service wraps the internal cloudwatchlogs client.
+type Service struct {
+	cwlogs cloudwatchlogsiface.CloudWatchLogsAPI
+}
+
+// New returns a Service configured with the input session.
+func New(s *session.Session) Service {
+	return Service{
+		cwlogs: cloudwatchlogs.New(s),
+	}
+}
+
+// LogEventsInput holds the parameters to filter the events of a log group.
+type LogEventsInput struct {
+	LogGroupName        string
+	LogStreamNamePrefix string // Optional. Only returns events from the log streams with this prefix.
+	FilterPattern       string // Optional. Only returns events matching the CloudWatch Logs filter pattern.
+	StartTime           int64  // Optional. Only returns events that happened at or after this time in milliseconds since epoch.
+}
+
+// Event is a log event written by an ECS task.
+type Event struct {
+	ID            string `json:"id"`
+	TaskID        string `json:"taskID"`
+	LogStreamName string `json:"logStreamName"`
+	Timestamp     int64  `json:"timestamp"`
+	Message       string `json:"message"`
+}
+
+// LogEvents returns the events of all the log streams in the log group matching the input, sorted by timestamp.
+func (s Service) LogEvents(in *LogEventsInput) ([]*Event, error) {
+	req := &cloudwatchlogs.FilterLogEventsInput{
+		LogGroupName: aws.String(in.LogGroupName),
+		Interleaved:  aws.Bool(true),
+	}
+	if in.LogStreamNamePrefix != "" {
+		req.LogStreamNamePrefix = aws.String(in.LogStreamNamePrefix)
+	}
+	if in.FilterPattern != "" {
+		req.FilterPattern = aws.String(in.FilterPattern)
+	}
+	if in.StartTime != 0 {
+		req.StartTime = aws.Int64(in.StartTime)
+	}
+
+	var events []*Event
+	for {
+		resp, err := s.cwlogs.FilterLogEvents(req)
+		if err != nil {
+			return nil, fmt.Errorf("filter log events of log group %s: %w", in.LogGroupName, err)
+		}
+		for _, event := range resp.Events {
+			events = append(events, &Event{
+				ID:            aws.StringValue(event.EventId),
+				TaskID:        taskID(aws.StringValue(event.LogStreamName)),
+				LogStreamName: aws.StringValue(event.LogStreamName),
+				Timestamp:     aws.Int64Value(event.Timestamp),
+				Message:       strings.TrimSuffix(aws.StringValue(event.Message), "\n"),
+			})
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		req.NextToken = resp.NextToken
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Timestamp < events[j].Timestamp
+	})
+	return events, nil
+}
+
+// taskID returns the ID of the task that writes to an awslogs log stream.
+// The log stream names of ECS tasks look like "prefix/container-name/task-id".
+func taskID(logStreamName string) string {
+	return logStreamName[strings.LastIndex(logStreamName, "/")+1:]
+}
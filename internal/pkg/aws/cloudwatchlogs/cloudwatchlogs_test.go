@@ -0,0 +1,117 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudwatchlogs
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/stretchr/testify/require"
+)
+
+type mockCloudWatchLogs struct {
+	cloudwatchlogsiface.CloudWatchLogsAPI
+
+	mockFilterLogEvents func(*cloudwatchlogs.FilterLogEventsInput) (*cloudwatchlogs.FilterLogEventsOutput, error)
+}
+
+func (m mockCloudWatchLogs) FilterLogEvents(in *cloudwatchlogs.FilterLogEventsInput) (*cloudwatchlogs.FilterLogEventsOutput, error) {
+	return m.mockFilterLogEvents(in)
+}
+
+func TestService_LogEvents(t *testing.T) {
+	mockError := errors.New("some error")
+
+	testCases := map[string]struct {
+		in                  *LogEventsInput
+		mockFilterLogEvents func(*cloudwatchlogs.FilterLogEventsInput) (*cloudwatchlogs.FilterLogEventsOutput, error)
+
+		wantedEvents []*Event
+		wantedErr    error
+	}{
+		"should wrap error from FilterLogEvents": {
+			in: &LogEventsInput{LogGroupName: "/ecs/phonetool-test-frontend"},
+			mockFilterLogEvents: func(*cloudwatchlogs.FilterLogEventsInput) (*cloudwatchlogs.FilterLogEventsOutput, error) {
+				return nil, mockError
+			},
+			wantedErr: fmt.Errorf("filter log events of log group /ecs/phonetool-test-frontend: %w", mockError),
+		},
+		"should pass optional filters": {
+			in: &LogEventsInput{
+				LogGroupName:        "/ecs/phonetool-test-frontend",
+				LogStreamNamePrefix: "ecs/frontend/abc",
+				FilterPattern:       "ERROR",
+				StartTime:           1000,
+			},
+			mockFilterLogEvents: func(in *cloudwatchlogs.FilterLogEventsInput) (*cloudwatchlogs.FilterLogEventsOutput, error) {
+				require.Equal(t, &cloudwatchlogs.FilterLogEventsInput{
+					LogGroupName:        aws.String("/ecs/phonetool-test-frontend"),
+					LogStreamNamePrefix: aws.String("ecs/frontend/abc"),
+					FilterPattern:       aws.String("ERROR"),
+					StartTime:           aws.Int64(1000),
+					Interleaved:         aws.Bool(true),
+				}, in)
+				return &cloudwatchlogs.FilterLogEventsOutput{}, nil
+			},
+		},
+		"should return events of all pages sorted by timestamp": {
+			in: &LogEventsInput{LogGroupName: "/ecs/phonetool-test-frontend"},
+			mockFilterLogEvents: func(in *cloudwatchlogs.FilterLogEventsInput) (*cloudwatchlogs.FilterLogEventsOutput, error) {
+				if in.NextToken == nil {
+					return &cloudwatchlogs.FilterLogEventsOutput{
+						Events: []*cloudwatchlogs.FilteredLogEvent{
+							{
+								EventId:       aws.String("2"),
+								LogStreamName: aws.String("ecs/frontend/task2"),
+								Timestamp:     aws.Int64(20),
+								Message:       aws.String("world\n"),
+							},
+						},
+						NextToken: aws.String("next"),
+					}, nil
+				}
+				return &cloudwatchlogs.FilterLogEventsOutput{
+					Events: []*cloudwatchlogs.FilteredLogEvent{
+						{
+							EventId:       aws.String("1"),
+							LogStreamName: aws.String("ecs/frontend/task1"),
+							Timestamp:     aws.Int64(10),
+							Message:       aws.String("hello"),
+						},
+					},
+				}, nil
+			},
+			wantedEvents: []*Event{
+				{ID: "1", TaskID: "task1", LogStreamName: "ecs/frontend/task1", Timestamp: 10, Message: "hello"},
+				{ID: "2", TaskID: "task2", LogStreamName: "ecs/frontend/task2", Timestamp: 20, Message: "world"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			service := Service{
+				cwlogs: mockCloudWatchLogs{
+					mockFilterLogEvents: tc.mockFilterLogEvents,
+				},
+			}
+
+			// WHEN
+			events, err := service.LogEvents(tc.in)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEvents, events)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ec2 wraps AWS Elastic Compute Cloud (EC2) API functionality.
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+)
+
+const (
+	nameTagKey     = "Name"
+	vpcIDFilterKey = "vpc-id"
+)
+
+// Service wraps an AWS EC2 client.
+type Service struct {
+	ec2 ec2iface.EC2API
+}
+
+// New returns a Service configured against the input session.
+func New(s *session.Session) Service {
+	return Service{
+		ec2: ec2.New(s),
+	}
+}
+
+// VPC is a virtual private cloud of the account.
+type VPC struct {
+	ID   string
+	Name string // Value of the "Name" tag, empty if the VPC isn't named.
+	CIDR string
+}
+
+// Subnet is a subnet of a VPC.
+type Subnet struct {
+	ID                  string
+	Name                string // Value of the "Name" tag, empty if the subnet isn't named.
+	CIDR                string
+	AvailabilityZone    string
+	MapPublicIPOnLaunch bool // Whether instances launched in the subnet get a public IP, a hint that the subnet is public.
+}
+
+// String returns a description of the VPC for prompts.
+func (v VPC) String() string {
+	if v.Name == "" {
+		return fmt.Sprintf("%s (%s)", v.ID, v.CIDR)
+	}
+	return fmt.Sprintf("%s (%s, %s)", v.ID, v.Name, v.CIDR)
+}
+
+// String returns a description of the subnet for prompts.
+func (s Subnet) String() string {
+	if s.Name == "" {
+		return fmt.Sprintf("%s (%s, %s)", s.ID, s.CIDR, s.AvailabilityZone)
+	}
+	return fmt.Sprintf("%s (%s, %s, %s)", s.ID, s.Name, s.CIDR, s.AvailabilityZone)
+}
+
+// ListVPCs returns the VPCs of the account in the region of the session.
+func (s Service) ListVPCs() ([]VPC, error) {
+	var vpcs []VPC
+	err := s.ec2.DescribeVpcsPages(&ec2.DescribeVpcsInput{}, func(out *ec2.DescribeVpcsOutput, lastPage bool) bool {
+		for _, vpc := range out.Vpcs {
+			vpcs = append(vpcs, VPC{
+				ID:   aws.StringValue(vpc.VpcId),
+				Name: nameTag(vpc.Tags),
+				CIDR: aws.StringValue(vpc.CidrBlock),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe VPCs: %w", err)
+	}
+	return vpcs, nil
+}
+
+// ListSubnets returns the subnets of a VPC.
+func (s Service) ListSubnets(vpcID string) ([]Subnet, error) {
+	var subnets []Subnet
+	err := s.ec2.DescribeSubnetsPages(&ec2.DescribeSubnetsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String(vpcIDFilterKey),
+				Values: aws.StringSlice([]string{vpcID}),
+			},
+		},
+	}, func(out *ec2.DescribeSubnetsOutput, lastPage bool) bool {
+		for _, subnet := range out.Subnets {
+			subnets = append(subnets, Subnet{
+				ID:                  aws.StringValue(subnet.SubnetId),
+				Name:                nameTag(subnet.Tags),
+				CIDR:                aws.StringValue(subnet.CidrBlock),
+				AvailabilityZone:    aws.StringValue(subnet.AvailabilityZone),
+				MapPublicIPOnLaunch: aws.BoolValue(subnet.MapPublicIpOnLaunch),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe subnets of VPC %s: %w", vpcID, err)
+	}
+	return subnets, nil
+}
+
+func nameTag(tags []*ec2.Tag) string {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == nameTagKey {
+			return aws.StringValue(tag.Value)
+		}
+	}
+	return ""
+}
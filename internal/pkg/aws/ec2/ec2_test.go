@@ -0,0 +1,133 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ec2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/stretchr/testify/require"
+)
+
+type mockEC2 struct {
+	ec2iface.EC2API
+
+	mockDescribeVpcsPages    func(*ec2.DescribeVpcsInput, func(*ec2.DescribeVpcsOutput, bool) bool) error
+	mockDescribeSubnetsPages func(*ec2.DescribeSubnetsInput, func(*ec2.DescribeSubnetsOutput, bool) bool) error
+}
+
+func (m mockEC2) DescribeVpcsPages(in *ec2.DescribeVpcsInput, fn func(*ec2.DescribeVpcsOutput, bool) bool) error {
+	return m.mockDescribeVpcsPages(in, fn)
+}
+
+func (m mockEC2) DescribeSubnetsPages(in *ec2.DescribeSubnetsInput, fn func(*ec2.DescribeSubnetsOutput, bool) bool) error {
+	return m.mockDescribeSubnetsPages(in, fn)
+}
+
+func TestService_ListVPCs(t *testing.T) {
+	testCases := map[string]struct {
+		mockDescribeVpcsPages func(*ec2.DescribeVpcsInput, func(*ec2.DescribeVpcsOutput, bool) bool) error
+
+		wantedVPCs []VPC
+		wantedErr  error
+	}{
+		"returns the VPCs of every page with their names": {
+			mockDescribeVpcsPages: func(in *ec2.DescribeVpcsInput, fn func(*ec2.DescribeVpcsOutput, bool) bool) error {
+				fn(&ec2.DescribeVpcsOutput{
+					Vpcs: []*ec2.Vpc{
+						{
+							VpcId:     aws.String("vpc-1"),
+							CidrBlock: aws.String("10.0.0.0/16"),
+							Tags:      []*ec2.Tag{{Key: aws.String("Name"), Value: aws.String("shared")}},
+						},
+					},
+				}, false)
+				fn(&ec2.DescribeVpcsOutput{
+					Vpcs: []*ec2.Vpc{{VpcId: aws.String("vpc-2"), CidrBlock: aws.String("172.31.0.0/16")}},
+				}, true)
+				return nil
+			},
+			wantedVPCs: []VPC{
+				{ID: "vpc-1", Name: "shared", CIDR: "10.0.0.0/16"},
+				{ID: "vpc-2", CIDR: "172.31.0.0/16"},
+			},
+		},
+		"wraps error": {
+			mockDescribeVpcsPages: func(in *ec2.DescribeVpcsInput, fn func(*ec2.DescribeVpcsOutput, bool) bool) error {
+				return errors.New("some error")
+			},
+			wantedErr: errors.New("describe VPCs: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			s := Service{ec2: mockEC2{mockDescribeVpcsPages: tc.mockDescribeVpcsPages}}
+
+			vpcs, err := s.ListVPCs()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedVPCs, vpcs)
+		})
+	}
+}
+
+func TestService_ListSubnets(t *testing.T) {
+	testCases := map[string]struct {
+		mockDescribeSubnetsPages func(*ec2.DescribeSubnetsInput, func(*ec2.DescribeSubnetsOutput, bool) bool) error
+
+		wantedSubnets []Subnet
+		wantedErr     error
+	}{
+		"returns the subnets of the VPC": {
+			mockDescribeSubnetsPages: func(in *ec2.DescribeSubnetsInput, fn func(*ec2.DescribeSubnetsOutput, bool) bool) error {
+				require.Equal(t, "vpc-id", aws.StringValue(in.Filters[0].Name))
+				require.Equal(t, []string{"vpc-1"}, aws.StringValueSlice(in.Filters[0].Values))
+				fn(&ec2.DescribeSubnetsOutput{
+					Subnets: []*ec2.Subnet{
+						{
+							SubnetId:            aws.String("subnet-1"),
+							CidrBlock:           aws.String("10.0.0.0/24"),
+							AvailabilityZone:    aws.String("us-west-2a"),
+							MapPublicIpOnLaunch: aws.Bool(true),
+							Tags:                []*ec2.Tag{{Key: aws.String("Name"), Value: aws.String("public-a")}},
+						},
+					},
+				}, true)
+				return nil
+			},
+			wantedSubnets: []Subnet{
+				{ID: "subnet-1", Name: "public-a", CIDR: "10.0.0.0/24", AvailabilityZone: "us-west-2a", MapPublicIPOnLaunch: true},
+			},
+		},
+		"wraps error": {
+			mockDescribeSubnetsPages: func(in *ec2.DescribeSubnetsInput, fn func(*ec2.DescribeSubnetsOutput, bool) bool) error {
+				return errors.New("some error")
+			},
+			wantedErr: errors.New("describe subnets of VPC vpc-1: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			s := Service{ec2: mockEC2{mockDescribeSubnetsPages: tc.mockDescribeSubnetsPages}}
+
+			subnets, err := s.ListSubnets("vpc-1")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSubnets, subnets)
+		})
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ecs wraps AWS Elastic Container Service (ECS) API functionality.
+package ecs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+)
+
+const (
+	startedBy = "ecs-cli"
+
+	// TaskStatusStopped is the last status of a task that exited.
+	TaskStatusStopped = ecs.DesiredStatusStopped
+)
+
+// Service wraps an AWS ECS client.
+type Service struct {
+	ecs ecsiface.ECSAPI
+}
+
+// New returns a Service configured against the input session.
+func New(s *session.Session) Service {
+	return Service{
+		ecs: ecs.New(s),
+	}
+}
+
+// RunTaskInput holds the configuration of a one-off Fargate task.
+type RunTaskInput struct {
+	Cluster        string
+	TaskDefinition string
+	ContainerName  string   // Name of the container whose command is overridden.
+	Command        []string // Optional. Overrides the command of the container.
+	Subnets        []string
+	SecurityGroups []string
+}
+
+// Task is the status of a task and of its container.
+type Task struct {
+	ID            string
+	ARN           string
+	LastStatus    string
+	StoppedReason string
+	ExitCode      *int64 // Exit code of the container, nil until the container exits.
+	ExitReason    string
+}
+
+// RunTask starts a task in the private subnets of the cluster and returns its ARN.
+func (s Service) RunTask(in *RunTaskInput) (string, error) {
+	req := &ecs.RunTaskInput{
+		Cluster:        aws.String(in.Cluster),
+		TaskDefinition: aws.String(in.TaskDefinition),
+		LaunchType:     aws.String(ecs.LaunchTypeFargate),
+		StartedBy:      aws.String(startedBy),
+		NetworkConfiguration: &ecs.NetworkConfiguration{
+			AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+				Subnets:        aws.StringSlice(in.Subnets),
+				SecurityGroups: aws.StringSlice(in.SecurityGroups),
+				AssignPublicIp: aws.String(ecs.AssignPublicIpDisabled),
+			},
+		},
+	}
+	if len(in.Command) > 0 {
+		req.Overrides = &ecs.TaskOverride{
+			ContainerOverrides: []*ecs.ContainerOverride{
+				{
+					Name:    aws.String(in.ContainerName),
+					Command: aws.StringSlice(in.Command),
+				},
+			},
+		}
+	}
+	resp, err := s.ecs.RunTask(req)
+	if err != nil {
+		return "", fmt.Errorf("run task with task definition %s: %w", in.TaskDefinition, err)
+	}
+	if len(resp.Failures) > 0 {
+		failure := resp.Failures[0]
+		return "", fmt.Errorf("run task with task definition %s: %s", in.TaskDefinition, aws.StringValue(failure.Reason))
+	}
+	if len(resp.Tasks) == 0 {
+		return "", fmt.Errorf("run task with task definition %s: no task started", in.TaskDefinition)
+	}
+	return aws.StringValue(resp.Tasks[0].TaskArn), nil
+}
+
+// Task returns the status of the task and of its container with the name passed in.
+func (s Service) Task(cluster, taskARN, containerName string) (*Task, error) {
+	resp, err := s.ecs.DescribeTasks(&ecs.DescribeTasksInput{
+		Cluster: aws.String(cluster),
+		Tasks:   []*string{aws.String(taskARN)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe task %s: %w", taskARN, err)
+	}
+	if len(resp.Tasks) == 0 {
+		return nil, fmt.Errorf("task %s not found in cluster %s", taskARN, cluster)
+	}
+	task := resp.Tasks[0]
+	status := &Task{
+		ID:            taskID(aws.StringValue(task.TaskArn)),
+		ARN:           aws.StringValue(task.TaskArn),
+		LastStatus:    aws.StringValue(task.LastStatus),
+		StoppedReason: aws.StringValue(task.StoppedReason),
+	}
+	for _, container := range task.Containers {
+		if aws.StringValue(container.Name) != containerName {
+			continue
+		}
+		status.ExitCode = container.ExitCode
+		status.ExitReason = aws.StringValue(container.Reason)
+	}
+	return status, nil
+}
+
+// RunningTasks returns the tasks of the service that are running in the cluster.
+func (s Service) RunningTasks(cluster, serviceName string) ([]*Task, error) {
+	var taskARNs []*string
+	var nextToken *string
+	for {
+		resp, err := s.ecs.ListTasks(&ecs.ListTasksInput{
+			Cluster:       aws.String(cluster),
+			ServiceName:   aws.String(serviceName),
+			DesiredStatus: aws.String(ecs.DesiredStatusRunning),
+			NextToken:     nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list tasks of service %s: %w", serviceName, err)
+		}
+		taskARNs = append(taskARNs, resp.TaskArns...)
+		if resp.NextToken == nil {
+			break
+		}
+		nextToken = resp.NextToken
+	}
+	if len(taskARNs) == 0 {
+		return nil, nil
+	}
+
+	var tasks []*Task
+	const maxTasksPerDescribe = 100 // DescribeTasks accepts up to 100 tasks per call.
+	for start := 0; start < len(taskARNs); start += maxTasksPerDescribe {
+		end := start + maxTasksPerDescribe
+		if end > len(taskARNs) {
+			end = len(taskARNs)
+		}
+		resp, err := s.ecs.DescribeTasks(&ecs.DescribeTasksInput{
+			Cluster: aws.String(cluster),
+			Tasks:   taskARNs[start:end],
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe tasks of service %s: %w", serviceName, err)
+		}
+		for _, task := range resp.Tasks {
+			if aws.StringValue(task.LastStatus) != ecs.DesiredStatusRunning {
+				continue
+			}
+			tasks = append(tasks, &Task{
+				ID:         taskID(aws.StringValue(task.TaskArn)),
+				ARN:        aws.StringValue(task.TaskArn),
+				LastStatus: aws.StringValue(task.LastStatus),
+			})
+		}
+	}
+	return tasks, nil
+}
+
+// RegisterTaskDefinitionWithImage registers a copy of the task definition under the family passed in,
+// with the image of the container replaced. It returns the ARN of the new task definition.
+func (s Service) RegisterTaskDefinitionWithImage(taskDefinition, family, containerName, image string) (string, error) {
+	resp, err := s.ecs.DescribeTaskDefinition(&ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: aws.String(taskDefinition),
+	})
+	if err != nil {
+		return "", fmt.Errorf("describe task definition %s: %w", taskDefinition, err)
+	}
+	def := resp.TaskDefinition
+	found := false
+	for _, container := range def.ContainerDefinitions {
+		if aws.StringValue(container.Name) == containerName {
+			container.Image = aws.String(image)
+			found = true
+		}
+	}
+	if !found {
+		return "", fmt.Errorf("container %s not found in task definition %s", containerName, taskDefinition)
+	}
+
+	out, err := s.ecs.RegisterTaskDefinition(&ecs.RegisterTaskDefinitionInput{
+		Family:                  aws.String(family),
+		ContainerDefinitions:    def.ContainerDefinitions,
+		Cpu:                     def.Cpu,
+		Memory:                  def.Memory,
+		ExecutionRoleArn:        def.ExecutionRoleArn,
+		TaskRoleArn:             def.TaskRoleArn,
+		NetworkMode:             def.NetworkMode,
+		RequiresCompatibilities: def.RequiresCompatibilities,
+		Volumes:                 def.Volumes,
+	})
+	if err != nil {
+		return "", fmt.Errorf("register task definition %s: %w", family, err)
+	}
+	return aws.StringValue(out.TaskDefinition.TaskDefinitionArn), nil
+}
+
+// taskID returns the ID of the task from its ARN.
+// Task ARNs look like "arn:aws:ecs:region:account:task/cluster-name/task-id" or "arn:aws:ecs:region:account:task/task-id".
+func taskID(taskARN string) string {
+	return taskARN[strings.LastIndex(taskARN, "/")+1:]
+}
@@ -0,0 +1,271 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecs
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/stretchr/testify/require"
+)
+
+type mockECS struct {
+	ecsiface.ECSAPI
+
+	mockRunTask                func(*ecs.RunTaskInput) (*ecs.RunTaskOutput, error)
+	mockListTasks              func(*ecs.ListTasksInput) (*ecs.ListTasksOutput, error)
+	mockDescribeTasks          func(*ecs.DescribeTasksInput) (*ecs.DescribeTasksOutput, error)
+	mockDescribeTaskDefinition func(*ecs.DescribeTaskDefinitionInput) (*ecs.DescribeTaskDefinitionOutput, error)
+	mockRegisterTaskDefinition func(*ecs.RegisterTaskDefinitionInput) (*ecs.RegisterTaskDefinitionOutput, error)
+}
+
+func (m mockECS) RunTask(in *ecs.RunTaskInput) (*ecs.RunTaskOutput, error) {
+	return m.mockRunTask(in)
+}
+
+func (m mockECS) ListTasks(in *ecs.ListTasksInput) (*ecs.ListTasksOutput, error) {
+	return m.mockListTasks(in)
+}
+
+func (m mockECS) DescribeTasks(in *ecs.DescribeTasksInput) (*ecs.DescribeTasksOutput, error) {
+	return m.mockDescribeTasks(in)
+}
+
+func (m mockECS) DescribeTaskDefinition(in *ecs.DescribeTaskDefinitionInput) (*ecs.DescribeTaskDefinitionOutput, error) {
+	return m.mockDescribeTaskDefinition(in)
+}
+
+func (m mockECS) RegisterTaskDefinition(in *ecs.RegisterTaskDefinitionInput) (*ecs.RegisterTaskDefinitionOutput, error) {
+	return m.mockRegisterTaskDefinition(in)
+}
+
+func TestService_RunTask(t *testing.T) {
+	mockError := errors.New("some error")
+	in := &RunTaskInput{
+		Cluster:        "phonetool-test-Cluster",
+		TaskDefinition: "arn:aws:ecs:us-west-2:1111:task-definition/phonetool-test-frontend:3",
+		ContainerName:  "frontend",
+		Command:        []string{"/bin/sh", "-c", "./migrate"},
+		Subnets:        []string{"subnet-1", "subnet-2"},
+		SecurityGroups: []string{"sg-1"},
+	}
+
+	testCases := map[string]struct {
+		mockRunTask func(*ecs.RunTaskInput) (*ecs.RunTaskOutput, error)
+
+		wantedARN string
+		wantedErr error
+	}{
+		"should wrap error from RunTask": {
+			mockRunTask: func(*ecs.RunTaskInput) (*ecs.RunTaskOutput, error) {
+				return nil, mockError
+			},
+			wantedErr: fmt.Errorf("run task with task definition %s: %w", in.TaskDefinition, mockError),
+		},
+		"should return error on failures": {
+			mockRunTask: func(*ecs.RunTaskInput) (*ecs.RunTaskOutput, error) {
+				return &ecs.RunTaskOutput{
+					Failures: []*ecs.Failure{{Reason: aws.String("RESOURCE:MEMORY")}},
+				}, nil
+			},
+			wantedErr: fmt.Errorf("run task with task definition %s: RESOURCE:MEMORY", in.TaskDefinition),
+		},
+		"should run the task in the private subnets with the command": {
+			mockRunTask: func(req *ecs.RunTaskInput) (*ecs.RunTaskOutput, error) {
+				require.Equal(t, "phonetool-test-Cluster", *req.Cluster)
+				require.Equal(t, ecs.LaunchTypeFargate, *req.LaunchType)
+				require.Equal(t, []string{"subnet-1", "subnet-2"}, aws.StringValueSlice(req.NetworkConfiguration.AwsvpcConfiguration.Subnets))
+				require.Equal(t, []string{"sg-1"}, aws.StringValueSlice(req.NetworkConfiguration.AwsvpcConfiguration.SecurityGroups))
+				require.Equal(t, ecs.AssignPublicIpDisabled, *req.NetworkConfiguration.AwsvpcConfiguration.AssignPublicIp)
+				require.Equal(t, "frontend", *req.Overrides.ContainerOverrides[0].Name)
+				require.Equal(t, []string{"/bin/sh", "-c", "./migrate"}, aws.StringValueSlice(req.Overrides.ContainerOverrides[0].Command))
+				return &ecs.RunTaskOutput{
+					Tasks: []*ecs.Task{{TaskArn: aws.String("arn:aws:ecs:us-west-2:1111:task/phonetool-test-Cluster/abc123")}},
+				}, nil
+			},
+			wantedARN: "arn:aws:ecs:us-west-2:1111:task/phonetool-test-Cluster/abc123",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			service := Service{
+				ecs: mockECS{mockRunTask: tc.mockRunTask},
+			}
+
+			arn, err := service.RunTask(in)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedARN, arn)
+		})
+	}
+}
+
+func TestService_Task(t *testing.T) {
+	service := Service{
+		ecs: mockECS{
+			mockDescribeTasks: func(in *ecs.DescribeTasksInput) (*ecs.DescribeTasksOutput, error) {
+				require.Equal(t, "phonetool-test-Cluster", *in.Cluster)
+				return &ecs.DescribeTasksOutput{
+					Tasks: []*ecs.Task{
+						{
+							TaskArn:       aws.String("arn:aws:ecs:us-west-2:1111:task/phonetool-test-Cluster/abc123"),
+							LastStatus:    aws.String("STOPPED"),
+							StoppedReason: aws.String("Essential container in task exited"),
+							Containers: []*ecs.Container{
+								{Name: aws.String("sidecar"), ExitCode: aws.Int64(0)},
+								{Name: aws.String("frontend"), ExitCode: aws.Int64(3)},
+							},
+						},
+					},
+				}, nil
+			},
+		},
+	}
+
+	task, err := service.Task("phonetool-test-Cluster", "arn:aws:ecs:us-west-2:1111:task/phonetool-test-Cluster/abc123", "frontend")
+
+	require.NoError(t, err)
+	require.Equal(t, &Task{
+		ID:            "abc123",
+		ARN:           "arn:aws:ecs:us-west-2:1111:task/phonetool-test-Cluster/abc123",
+		LastStatus:    "STOPPED",
+		StoppedReason: "Essential container in task exited",
+		ExitCode:      aws.Int64(3),
+	}, task)
+}
+
+func TestService_RunningTasks(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		mockListTasks     func(*ecs.ListTasksInput) (*ecs.ListTasksOutput, error)
+		mockDescribeTasks func(*ecs.DescribeTasksInput) (*ecs.DescribeTasksOutput, error)
+
+		wantedTasks []*Task
+		wantedErr   error
+	}{
+		"should wrap error from ListTasks": {
+			mockListTasks: func(*ecs.ListTasksInput) (*ecs.ListTasksOutput, error) {
+				return nil, mockError
+			},
+			wantedErr: fmt.Errorf("list tasks of service phonetool-test-frontend-Service: %w", mockError),
+		},
+		"should return no tasks if the service has none running": {
+			mockListTasks: func(*ecs.ListTasksInput) (*ecs.ListTasksOutput, error) {
+				return &ecs.ListTasksOutput{}, nil
+			},
+		},
+		"should return the running tasks across pages": {
+			mockListTasks: func(in *ecs.ListTasksInput) (*ecs.ListTasksOutput, error) {
+				require.Equal(t, "phonetool-test-frontend-Service", *in.ServiceName)
+				require.Equal(t, ecs.DesiredStatusRunning, *in.DesiredStatus)
+				if in.NextToken == nil {
+					return &ecs.ListTasksOutput{
+						TaskArns:  aws.StringSlice([]string{"arn:aws:ecs:us-west-2:1111:task/phonetool-test-Cluster/abc123"}),
+						NextToken: aws.String("next"),
+					}, nil
+				}
+				return &ecs.ListTasksOutput{
+					TaskArns: aws.StringSlice([]string{"arn:aws:ecs:us-west-2:1111:task/phonetool-test-Cluster/def456"}),
+				}, nil
+			},
+			mockDescribeTasks: func(in *ecs.DescribeTasksInput) (*ecs.DescribeTasksOutput, error) {
+				require.Equal(t, 2, len(in.Tasks))
+				return &ecs.DescribeTasksOutput{
+					Tasks: []*ecs.Task{
+						{TaskArn: aws.String("arn:aws:ecs:us-west-2:1111:task/phonetool-test-Cluster/abc123"), LastStatus: aws.String("RUNNING")},
+						{TaskArn: aws.String("arn:aws:ecs:us-west-2:1111:task/phonetool-test-Cluster/def456"), LastStatus: aws.String("PENDING")},
+					},
+				}, nil
+			},
+			wantedTasks: []*Task{
+				{ID: "abc123", ARN: "arn:aws:ecs:us-west-2:1111:task/phonetool-test-Cluster/abc123", LastStatus: "RUNNING"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			service := Service{
+				ecs: mockECS{
+					mockListTasks:     tc.mockListTasks,
+					mockDescribeTasks: tc.mockDescribeTasks,
+				},
+			}
+
+			tasks, err := service.RunningTasks("phonetool-test-Cluster", "phonetool-test-frontend-Service")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTasks, tasks)
+		})
+	}
+}
+
+func TestService_RegisterTaskDefinitionWithImage(t *testing.T) {
+	testCases := map[string]struct {
+		inContainerName string
+
+		wantedErr error
+	}{
+		"should return error if the container is missing": {
+			inContainerName: "backend",
+			wantedErr:       errors.New("container backend not found in task definition phonetool-test-frontend:3"),
+		},
+		"should register a copy with the new image": {
+			inContainerName: "frontend",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			service := Service{
+				ecs: mockECS{
+					mockDescribeTaskDefinition: func(in *ecs.DescribeTaskDefinitionInput) (*ecs.DescribeTaskDefinitionOutput, error) {
+						return &ecs.DescribeTaskDefinitionOutput{
+							TaskDefinition: &ecs.TaskDefinition{
+								Cpu:         aws.String("256"),
+								Memory:      aws.String("512"),
+								NetworkMode: aws.String(ecs.NetworkModeAwsvpc),
+								ContainerDefinitions: []*ecs.ContainerDefinition{
+									{Name: aws.String("frontend"), Image: aws.String("frontend:v1")},
+								},
+							},
+						}, nil
+					},
+					mockRegisterTaskDefinition: func(in *ecs.RegisterTaskDefinitionInput) (*ecs.RegisterTaskDefinitionOutput, error) {
+						require.Equal(t, "phonetool-test-frontend-task", *in.Family)
+						require.Equal(t, "256", *in.Cpu)
+						require.Equal(t, "frontend:adhoc", *in.ContainerDefinitions[0].Image)
+						return &ecs.RegisterTaskDefinitionOutput{
+							TaskDefinition: &ecs.TaskDefinition{
+								TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:1111:task-definition/phonetool-test-frontend-task:1"),
+							},
+						}, nil
+					},
+				},
+			}
+
+			arn, err := service.RegisterTaskDefinitionWithImage("phonetool-test-frontend:3", "phonetool-test-frontend-task", tc.inContainerName, "frontend:adhoc")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "arn:aws:ecs:us-west-2:1111:task-definition/phonetool-test-frontend-task:1", arn)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+const (
+	awsCLIBinary               = "aws"
+	sessionManagerPluginBinary = "session-manager-plugin"
+)
+
+// ErrSessionManagerPluginNotInstalled occurs when the session-manager plugin cannot be found in the PATH.
+var ErrSessionManagerPluginNotInstalled = errors.New(`the Session Manager plugin is not installed, see https://docs.aws.amazon.com/systems-manager/latest/userguide/session-manager-working-with-install-plugin.html`)
+
+// ExecuteCommandInput holds the task and container to start an interactive session in.
+type ExecuteCommandInput struct {
+	Cluster   string
+	Task      string // ID or ARN of the task.
+	Container string
+	Command   string
+}
+
+type runner interface {
+	Run() error
+}
+
+// CommandExecutor starts interactive sessions in the containers of running tasks with ECS Exec.
+type CommandExecutor struct {
+	sess *session.Session
+
+	lookPath      func(file string) (string, error)
+	createCommand func(env []string, name string, args ...string) runner
+}
+
+// NewCommandExecutor returns a CommandExecutor that starts sessions with the credentials and region of the input session.
+func NewCommandExecutor(s *session.Session) *CommandExecutor {
+	return &CommandExecutor{
+		sess:          s,
+		lookPath:      exec.LookPath,
+		createCommand: newInteractiveCommand,
+	}
+}
+
+// ExecuteCommand runs the command in the container and attaches the terminal to it until the command exits.
+//
+// The session is started with the AWS CLI, which negotiates the data channel through the session-manager plugin.
+func (e *CommandExecutor) ExecuteCommand(in *ExecuteCommandInput) error {
+	if _, err := e.lookPath(sessionManagerPluginBinary); err != nil {
+		return ErrSessionManagerPluginNotInstalled
+	}
+	if _, err := e.lookPath(awsCLIBinary); err != nil {
+		return fmt.Errorf("find the AWS CLI to start the session: %w", err)
+	}
+	creds, err := e.sess.Config.Credentials.Get()
+	if err != nil {
+		return fmt.Errorf("get credentials to start the session: %w", err)
+	}
+	env := append(os.Environ(),
+		"AWS_ACCESS_KEY_ID="+creds.AccessKeyID,
+		"AWS_SECRET_ACCESS_KEY="+creds.SecretAccessKey,
+		"AWS_SESSION_TOKEN="+creds.SessionToken,
+	)
+	cmd := e.createCommand(env, awsCLIBinary, "ecs", "execute-command",
+		"--region", aws.StringValue(e.sess.Config.Region),
+		"--cluster", in.Cluster,
+		"--task", in.Task,
+		"--container", in.Container,
+		"--command", in.Command,
+		"--interactive")
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("execute command in container %s of task %s: %w", in.Container, in.Task, err)
+	}
+	return nil
+}
+
+func newInteractiveCommand(env []string, name string, args ...string) runner {
+	cmd := exec.Command(name, args...)
+	cmd.Env = env
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd
+}
@@ -0,0 +1,90 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRunner struct {
+	err error
+}
+
+func (r mockRunner) Run() error {
+	return r.err
+}
+
+func TestCommandExecutor_ExecuteCommand(t *testing.T) {
+	sess := session.Must(session.NewSession(&aws.Config{
+		Region:      aws.String("us-west-2"),
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", "TOKEN"),
+	}))
+	in := &ExecuteCommandInput{
+		Cluster:   "phonetool-test-Cluster",
+		Task:      "abc123",
+		Container: "frontend",
+		Command:   "/bin/sh",
+	}
+
+	testCases := map[string]struct {
+		missingBinary string
+		runErr        error
+
+		wantedErr error
+	}{
+		"should return error if the session-manager plugin is missing": {
+			missingBinary: "session-manager-plugin",
+			wantedErr:     ErrSessionManagerPluginNotInstalled,
+		},
+		"should return error if the AWS CLI is missing": {
+			missingBinary: "aws",
+			wantedErr:     errors.New("find the AWS CLI to start the session: not found"),
+		},
+		"should wrap error from the session": {
+			runErr:    errors.New("exit status 1"),
+			wantedErr: errors.New("execute command in container frontend of task abc123: exit status 1"),
+		},
+		"should start the session with the credentials of the session": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			e := &CommandExecutor{
+				sess: sess,
+				lookPath: func(file string) (string, error) {
+					if file == tc.missingBinary {
+						return "", errors.New("not found")
+					}
+					return "/usr/local/bin/" + file, nil
+				},
+				createCommand: func(env []string, name string, args ...string) runner {
+					require.Equal(t, "aws", name)
+					require.Equal(t, []string{"ecs", "execute-command",
+						"--region", "us-west-2",
+						"--cluster", "phonetool-test-Cluster",
+						"--task", "abc123",
+						"--container", "frontend",
+						"--command", "/bin/sh",
+						"--interactive"}, args)
+					require.Subset(t, env, []string{"AWS_ACCESS_KEY_ID=AKID", "AWS_SECRET_ACCESS_KEY=SECRET", "AWS_SESSION_TOKEN=TOKEN"})
+					return mockRunner{err: tc.runErr}
+				},
+			}
+
+			err := e.ExecuteCommand(in)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
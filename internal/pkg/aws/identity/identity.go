@@ -7,6 +7,7 @@ package identity
 import (
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
@@ -27,6 +28,7 @@ func New(s *session.Session) Service {
 // Caller holds information about a calling entity.
 type Caller struct {
 	RootUserARN string
+	ARN         string // ARN of the calling IAM user or assumed role.
 	Account     string
 	UserID      string
 }
@@ -41,6 +43,7 @@ func (s Service) Get() (Caller, error) {
 
 	return Caller{
 		RootUserARN: fmt.Sprintf("arn:aws:iam::%s:root", *out.Account),
+		ARN:         aws.StringValue(out.Arn),
 		Account:     *out.Account,
 		UserID:      *out.UserId,
 	}, nil
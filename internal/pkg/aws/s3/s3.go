@@ -0,0 +1,40 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package s3 wraps AWS Simple Storage Service (S3) functionality.
+package s3
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager/s3manageriface"
+)
+
+// Service wraps an AWS S3 uploader.
+type Service struct {
+	uploader s3manageriface.UploaderAPI
+}
+
+// New returns a Service configured against the input session.
+func New(s *session.Session) Service {
+	return Service{
+		uploader: s3manager.NewUploader(s),
+	}
+}
+
+// PutArtifact uploads data to the bucket under the key and returns the URL of the object.
+func (s Service) PutArtifact(bucket, key string, data io.Reader) (string, error) {
+	resp, err := s.uploader.Upload(&s3manager.UploadInput{
+		Body:   data,
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return "", fmt.Errorf("upload %s to bucket %s: %w", key, bucket, err)
+	}
+	return resp.Location, nil
+}
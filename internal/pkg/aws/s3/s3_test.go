@@ -0,0 +1,72 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager/s3manageriface"
+	"github.com/stretchr/testify/require"
+)
+
+type mockUploader struct {
+	s3manageriface.UploaderAPI
+
+	mockUpload func(*s3manager.UploadInput) (*s3manager.UploadOutput, error)
+}
+
+func (m mockUploader) Upload(input *s3manager.UploadInput, _ ...func(*s3manager.Uploader)) (*s3manager.UploadOutput, error) {
+	return m.mockUpload(input)
+}
+
+func TestPutArtifact(t *testing.T) {
+	mockError := errors.New("some error")
+
+	testCases := map[string]struct {
+		mockUpload func(*s3manager.UploadInput) (*s3manager.UploadOutput, error)
+
+		wantURL string
+		wantErr error
+	}{
+		"should return wrapped error given error returned from Upload": {
+			mockUpload: func(*s3manager.UploadInput) (*s3manager.UploadOutput, error) {
+				return nil, mockError
+			},
+			wantErr: fmt.Errorf("upload addons/frontend/template.yml to bucket mockBucket: %w", mockError),
+		},
+		"should return the location of the uploaded object": {
+			mockUpload: func(in *s3manager.UploadInput) (*s3manager.UploadOutput, error) {
+				require.Equal(t, "mockBucket", *in.Bucket)
+				require.Equal(t, "addons/frontend/template.yml", *in.Key)
+				return &s3manager.UploadOutput{
+					Location: "https://mockBucket.s3-us-west-2.amazonaws.com/addons/frontend/template.yml",
+				}, nil
+			},
+			wantURL: "https://mockBucket.s3-us-west-2.amazonaws.com/addons/frontend/template.yml",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			service := Service{
+				mockUploader{
+					mockUpload: tc.mockUpload,
+				},
+			}
+
+			gotURL, gotErr := service.PutArtifact("mockBucket", "addons/frontend/template.yml", strings.NewReader("hello"))
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, tc.wantURL, gotURL)
+		})
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package secrets manages the values of application secrets in AWS Systems Manager Parameter Store
+// and retrieves them from AWS Secrets Manager.
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+)
+
+const secretsManagerARNPrefix = "arn:aws:secretsmanager:"
+
+// Secret is an SSM parameter holding a secret.
+type Secret struct {
+	Name         string    `json:"name"`
+	Version      int64     `json:"version"`
+	LastModified time.Time `json:"lastModified"`
+}
+
+// Service wraps the internal SSM and Secrets Manager clients.
+type Service struct {
+	ssm            ssmiface.SSMAPI
+	secretsManager secretsmanageriface.SecretsManagerAPI
+}
+
+// New returns a Service configured with the input session.
+func New(s *session.Session) Service {
+	return Service{
+		ssm:            ssm.New(s),
+		secretsManager: secretsmanager.New(s),
+	}
+}
+
+// Value returns the decrypted value of a secret referenced the same way as in the "secrets" of a manifest:
+// either the name or ARN of an SSM parameter, or the ARN of a Secrets Manager secret.
+func (s Service) Value(valueFrom string) (string, error) {
+	if strings.HasPrefix(valueFrom, secretsManagerARNPrefix) {
+		resp, err := s.secretsManager.GetSecretValue(&secretsmanager.GetSecretValueInput{
+			SecretId: aws.String(valueFrom),
+		})
+		if err != nil {
+			return "", fmt.Errorf("get value of secret %s: %w", valueFrom, err)
+		}
+		return aws.StringValue(resp.SecretString), nil
+	}
+	resp, err := s.ssm.GetParameter(&ssm.GetParameterInput{
+		Name:           aws.String(valueFrom),
+		WithDecryption: aws.Bool(true),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get value of parameter %s: %w", valueFrom, err)
+	}
+	return aws.StringValue(resp.Parameter.Value), nil
+}
+
+// PutSecret writes the value of a secret in a SecureString SSM parameter tagged with the input tags.
+// The value of an existing parameter is overwritten and its tags are updated.
+func (s Service) PutSecret(name, value string, tags map[string]string) error {
+	var ssmTags []*ssm.Tag
+	for k, v := range tags {
+		ssmTags = append(ssmTags, &ssm.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+	_, err := s.ssm.PutParameter(&ssm.PutParameterInput{
+		Name:  aws.String(name),
+		Value: aws.String(value),
+		Type:  aws.String(ssm.ParameterTypeSecureString),
+		Tags:  ssmTags,
+	})
+	if err == nil {
+		return nil
+	}
+	var aerr awserr.Error
+	if !errors.As(err, &aerr) || aerr.Code() != ssm.ErrCodeParameterAlreadyExists {
+		return fmt.Errorf("create parameter %s: %w", name, err)
+	}
+
+	// Tags can't be passed when a parameter is overwritten, so they're added separately.
+	if _, err := s.ssm.PutParameter(&ssm.PutParameterInput{
+		Name:      aws.String(name),
+		Value:     aws.String(value),
+		Type:      aws.String(ssm.ParameterTypeSecureString),
+		Overwrite: aws.Bool(true),
+	}); err != nil {
+		return fmt.Errorf("overwrite parameter %s: %w", name, err)
+	}
+	if len(ssmTags) == 0 {
+		return nil
+	}
+	if _, err := s.ssm.AddTagsToResource(&ssm.AddTagsToResourceInput{
+		ResourceId:   aws.String(name),
+		ResourceType: aws.String(ssm.ResourceTypeForTaggingParameter),
+		Tags:         ssmTags,
+	}); err != nil {
+		return fmt.Errorf("tag parameter %s: %w", name, err)
+	}
+	return nil
+}
+
+// ListSecrets returns the SSM parameters directly under a path without their values.
+func (s Service) ListSecrets(path string) ([]Secret, error) {
+	var secrets []Secret
+	var nextToken *string
+	for {
+		resp, err := s.ssm.GetParametersByPath(&ssm.GetParametersByPathInput{
+			Path:      aws.String(path),
+			Recursive: aws.Bool(false),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get parameters under path %s: %w", path, err)
+		}
+		for _, param := range resp.Parameters {
+			secrets = append(secrets, Secret{
+				Name:         aws.StringValue(param.Name),
+				Version:      aws.Int64Value(param.Version),
+				LastModified: aws.TimeValue(param.LastModifiedDate),
+			})
+		}
+		nextToken = resp.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	return secrets, nil
+}
+
+// DeleteSecret deletes the SSM parameter holding a secret.
+// If the parameter doesn't exist, it returns an ErrSecretNotFound.
+func (s Service) DeleteSecret(name string) error {
+	_, err := s.ssm.DeleteParameter(&ssm.DeleteParameterInput{
+		Name: aws.String(name),
+	})
+	if err == nil {
+		return nil
+	}
+	var aerr awserr.Error
+	if errors.As(err, &aerr) && aerr.Code() == ssm.ErrCodeParameterNotFound {
+		return &ErrSecretNotFound{Name: name}
+	}
+	return fmt.Errorf("delete parameter %s: %w", name, err)
+}
+
+// ErrSecretNotFound means the parameter of a secret couldn't be found.
+type ErrSecretNotFound struct {
+	Name string
+}
+
+func (e *ErrSecretNotFound) Error() string {
+	return fmt.Sprintf("couldn't find secret %s", e.Name)
+}
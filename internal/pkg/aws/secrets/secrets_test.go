@@ -0,0 +1,295 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSSM struct {
+	ssmiface.SSMAPI
+
+	mockGetParameter        func(*ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+	mockPutParameter        func(*ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+	mockAddTagsToResource   func(*ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error)
+	mockGetParametersByPath func(*ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error)
+	mockDeleteParameter     func(*ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
+}
+
+func (m mockSSM) GetParameter(in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+	return m.mockGetParameter(in)
+}
+
+func (m mockSSM) PutParameter(in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+	return m.mockPutParameter(in)
+}
+
+func (m mockSSM) AddTagsToResource(in *ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error) {
+	return m.mockAddTagsToResource(in)
+}
+
+func (m mockSSM) GetParametersByPath(in *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+	return m.mockGetParametersByPath(in)
+}
+
+func (m mockSSM) DeleteParameter(in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+	return m.mockDeleteParameter(in)
+}
+
+type mockSecretsManager struct {
+	secretsmanageriface.SecretsManagerAPI
+
+	mockGetSecretValue func(*secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error)
+}
+
+func (m mockSecretsManager) GetSecretValue(in *secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error) {
+	return m.mockGetSecretValue(in)
+}
+
+func TestService_Value(t *testing.T) {
+	mockError := errors.New("some error")
+	getParameter := func(in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+		require.Equal(t, "GITHUB_TOKEN", aws.StringValue(in.Name))
+		require.True(t, aws.BoolValue(in.WithDecryption))
+		return &ssm.GetParameterOutput{Parameter: &ssm.Parameter{Value: aws.String("ssm-value")}}, nil
+	}
+	getSecretValue := func(in *secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error) {
+		require.Equal(t, "arn:aws:secretsmanager:us-west-2:1111:secret:db-password", aws.StringValue(in.SecretId))
+		return &secretsmanager.GetSecretValueOutput{SecretString: aws.String("sm-value")}, nil
+	}
+
+	testCases := map[string]struct {
+		inValueFrom        string
+		mockGetParameter   func(*ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+		mockGetSecretValue func(*secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error)
+
+		wantedValue string
+		wantedErr   error
+	}{
+		"should return the decrypted value of an SSM parameter": {
+			inValueFrom:      "GITHUB_TOKEN",
+			mockGetParameter: getParameter,
+			wantedValue:      "ssm-value",
+		},
+		"should wrap error from SSM": {
+			inValueFrom: "GITHUB_TOKEN",
+			mockGetParameter: func(*ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return nil, mockError
+			},
+			wantedErr: errors.New("get value of parameter GITHUB_TOKEN: some error"),
+		},
+		"should return the value of a Secrets Manager secret": {
+			inValueFrom:        "arn:aws:secretsmanager:us-west-2:1111:secret:db-password",
+			mockGetSecretValue: getSecretValue,
+			wantedValue:        "sm-value",
+		},
+		"should wrap error from Secrets Manager": {
+			inValueFrom: "arn:aws:secretsmanager:us-west-2:1111:secret:db-password",
+			mockGetSecretValue: func(*secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error) {
+				return nil, mockError
+			},
+			wantedErr: errors.New("get value of secret arn:aws:secretsmanager:us-west-2:1111:secret:db-password: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			s := Service{
+				ssm:            mockSSM{mockGetParameter: tc.mockGetParameter},
+				secretsManager: mockSecretsManager{mockGetSecretValue: tc.mockGetSecretValue},
+			}
+
+			value, err := s.Value(tc.inValueFrom)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedValue, value)
+		})
+	}
+}
+
+func TestService_PutSecret(t *testing.T) {
+	const name = "/archer/phonetool/test/secrets/DB_PASSWORD"
+	tags := map[string]string{"ecs-project": "phonetool"}
+	wantedTags := []*ssm.Tag{{Key: aws.String("ecs-project"), Value: aws.String("phonetool")}}
+	alreadyExists := awserr.New(ssm.ErrCodeParameterAlreadyExists, "exists", nil)
+
+	testCases := map[string]struct {
+		mockPutParameter      func(*ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+		mockAddTagsToResource func(*ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error)
+
+		wantedErr error
+	}{
+		"creates a tagged SecureString parameter": {
+			mockPutParameter: func(in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, name, aws.StringValue(in.Name))
+				require.Equal(t, "hunter2", aws.StringValue(in.Value))
+				require.Equal(t, ssm.ParameterTypeSecureString, aws.StringValue(in.Type))
+				require.Equal(t, wantedTags, in.Tags)
+				require.False(t, aws.BoolValue(in.Overwrite))
+				return &ssm.PutParameterOutput{}, nil
+			},
+		},
+		"overwrites an existing parameter and tags it": {
+			mockPutParameter: func(in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				if !aws.BoolValue(in.Overwrite) {
+					return nil, alreadyExists
+				}
+				require.Nil(t, in.Tags)
+				return &ssm.PutParameterOutput{}, nil
+			},
+			mockAddTagsToResource: func(in *ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error) {
+				require.Equal(t, name, aws.StringValue(in.ResourceId))
+				require.Equal(t, ssm.ResourceTypeForTaggingParameter, aws.StringValue(in.ResourceType))
+				require.Equal(t, wantedTags, in.Tags)
+				return &ssm.AddTagsToResourceOutput{}, nil
+			},
+		},
+		"wraps error from creating the parameter": {
+			mockPutParameter: func(in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedErr: errors.New("create parameter /archer/phonetool/test/secrets/DB_PASSWORD: some error"),
+		},
+		"wraps error from tagging the parameter": {
+			mockPutParameter: func(in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				if !aws.BoolValue(in.Overwrite) {
+					return nil, alreadyExists
+				}
+				return &ssm.PutParameterOutput{}, nil
+			},
+			mockAddTagsToResource: func(in *ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedErr: errors.New("tag parameter /archer/phonetool/test/secrets/DB_PASSWORD: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			s := Service{
+				ssm: mockSSM{
+					mockPutParameter:      tc.mockPutParameter,
+					mockAddTagsToResource: tc.mockAddTagsToResource,
+				},
+			}
+
+			err := s.PutSecret("/archer/phonetool/test/secrets/DB_PASSWORD", "hunter2", tags)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestService_ListSecrets(t *testing.T) {
+	lastModified := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	testCases := map[string]struct {
+		mockGetParametersByPath func(*ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error)
+
+		wantedSecrets []Secret
+		wantedErr     error
+	}{
+		"returns the parameters of every page": {
+			mockGetParametersByPath: func(in *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+				require.Equal(t, "/archer/phonetool/test/secrets/", aws.StringValue(in.Path))
+				if in.NextToken == nil {
+					return &ssm.GetParametersByPathOutput{
+						Parameters: []*ssm.Parameter{{Name: aws.String("/archer/phonetool/test/secrets/A"), Version: aws.Int64(1), LastModifiedDate: aws.Time(lastModified)}},
+						NextToken:  aws.String("next"),
+					}, nil
+				}
+				return &ssm.GetParametersByPathOutput{
+					Parameters: []*ssm.Parameter{{Name: aws.String("/archer/phonetool/test/secrets/B"), Version: aws.Int64(3), LastModifiedDate: aws.Time(lastModified)}},
+				}, nil
+			},
+			wantedSecrets: []Secret{
+				{Name: "/archer/phonetool/test/secrets/A", Version: 1, LastModified: lastModified},
+				{Name: "/archer/phonetool/test/secrets/B", Version: 3, LastModified: lastModified},
+			},
+		},
+		"wraps error from SSM": {
+			mockGetParametersByPath: func(in *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedErr: errors.New("get parameters under path /archer/phonetool/test/secrets/: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			s := Service{
+				ssm: mockSSM{mockGetParametersByPath: tc.mockGetParametersByPath},
+			}
+
+			secrets, err := s.ListSecrets("/archer/phonetool/test/secrets/")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSecrets, secrets)
+		})
+	}
+}
+
+func TestService_DeleteSecret(t *testing.T) {
+	testCases := map[string]struct {
+		mockDeleteParameter func(*ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
+
+		wantedErr error
+	}{
+		"deletes the parameter": {
+			mockDeleteParameter: func(in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				require.Equal(t, "/archer/phonetool/test/secrets/A", aws.StringValue(in.Name))
+				return &ssm.DeleteParameterOutput{}, nil
+			},
+		},
+		"returns ErrSecretNotFound if the parameter doesn't exist": {
+			mockDeleteParameter: func(in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				return nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil)
+			},
+			wantedErr: &ErrSecretNotFound{Name: "/archer/phonetool/test/secrets/A"},
+		},
+		"wraps other errors": {
+			mockDeleteParameter: func(in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedErr: errors.New("delete parameter /archer/phonetool/test/secrets/A: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			s := Service{
+				ssm: mockSSM{mockDeleteParameter: tc.mockDeleteParameter},
+			}
+
+			err := s.DeleteSecret("/archer/phonetool/test/secrets/A")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -12,46 +12,123 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 )
 
-// Default returns a session configured against the "default" AWS profile.
-func Default() (*session.Session, error) {
-	return session.NewSessionWithOptions(session.Options{
-		Config: aws.Config{
-			CredentialsChainVerboseErrors: aws.Bool(true),
-		},
-		SharedConfigState: session.SharedConfigEnable,
-	})
+// Provider creates sessions with the credentials chosen by the user.
+type Provider struct {
+	profile     string            // Named profile of the default credentials, empty for the default credential chain.
+	region      string            // Region overriding the region of the profile, empty to keep it.
+	envProfiles map[string]string // Named profiles assuming the manager role of environments, keyed by environment name.
 }
 
-// DefaultWithRegion returns a session configured against the "default" AWS profile and the input region.
-func DefaultWithRegion(region string) (*session.Session, error) {
-	return session.NewSession(&aws.Config{
-		Region: aws.String(region),
-	})
+// NewProvider returns a Provider creating default sessions from the named profile and region, and assuming
+// the manager role of an environment with the environment's profile if it has one.
+// Empty values fall back to the AWS SDK's defaults.
+func NewProvider(profile, region string, envProfiles map[string]string) *Provider {
+	return &Provider{
+		profile:     profile,
+		region:      region,
+		envProfiles: envProfiles,
+	}
 }
 
-// FromProfile returns a session configured against the input profile name.
-func FromProfile(name string) (*session.Session, error) {
-	return session.NewSessionWithOptions(session.Options{
-		Config: aws.Config{
-			CredentialsChainVerboseErrors: aws.Bool(true),
-		},
-		SharedConfigState: session.SharedConfigEnable,
-		Profile:           name,
-	})
+// defaultProvider creates the sessions of the package level functions.
+var defaultProvider = NewProvider("", "", nil)
+
+// SetDefaultProvider replaces the provider of the package level functions.
+func SetDefaultProvider(p *Provider) {
+	defaultProvider = p
 }
 
-// FromRole returns a session configured against the input role and region.
-func FromRole(roleARN string, region string) (*session.Session, error) {
-	defaultSession, err := Default()
+// DefaultProvider returns the provider of the package level functions.
+func DefaultProvider() *Provider {
+	return defaultProvider
+}
+
+// Default returns a session configured against the provider's profile, or the "default" AWS profile.
+func (p *Provider) Default() (*session.Session, error) {
+	return p.FromProfile("")
+}
+
+// DefaultWithRegion returns a session with the credentials of the default session configured against the input region.
+func (p *Provider) DefaultWithRegion(region string) (*session.Session, error) {
+	return p.fromProfile(p.profile, region)
+}
 
+// FromProfile returns a session configured against the input profile name.
+// An empty name returns a session with the default credentials.
+func (p *Provider) FromProfile(name string) (*session.Session, error) {
+	if name == "" {
+		name = p.profile
+	}
+	return p.fromProfile(name, p.region)
+}
+
+// FromRole returns a session configured against the input role and region, assumed with the default credentials.
+func (p *Provider) FromRole(roleARN string, region string) (*session.Session, error) {
+	defaultSession, err := p.Default()
 	if err != nil {
 		return nil, fmt.Errorf("error creating default session: %w", err)
 	}
+	return fromRole(defaultSession, roleARN, region)
+}
 
-	creds := stscreds.NewCredentials(defaultSession, roleARN)
+// FromEnvRole returns a session configured against the manager role and region of an environment.
+// The role is assumed with the credentials of the environment's profile, or the default credentials if it has none.
+func (p *Provider) FromEnvRole(envName, roleARN, region string) (*session.Session, error) {
+	profile, ok := p.envProfiles[envName]
+	if !ok {
+		return p.FromRole(roleARN, region)
+	}
+	profileSession, err := p.FromProfile(profile)
+	if err != nil {
+		return nil, fmt.Errorf("error creating session from profile %s of environment %s: %w", profile, envName, err)
+	}
+	return fromRole(profileSession, roleARN, region)
+}
+
+func (p *Provider) fromProfile(name, region string) (*session.Session, error) {
+	config := aws.Config{
+		CredentialsChainVerboseErrors: aws.Bool(true),
+	}
+	if region != "" {
+		config.Region = aws.String(region)
+	}
+	return session.NewSessionWithOptions(session.Options{
+		Config:            config,
+		SharedConfigState: session.SharedConfigEnable,
+		Profile:           name,
+	})
+}
+
+func fromRole(sess *session.Session, roleARN, region string) (*session.Session, error) {
+	creds := stscreds.NewCredentials(sess, roleARN)
 	return session.NewSession(&aws.Config{
 		CredentialsChainVerboseErrors: aws.Bool(true),
 		Credentials:                   creds,
 		Region:                        &region,
 	})
 }
+
+// Default returns a session configured against the default provider's profile, or the "default" AWS profile.
+func Default() (*session.Session, error) {
+	return defaultProvider.Default()
+}
+
+// DefaultWithRegion returns a session with the default credentials configured against the input region.
+func DefaultWithRegion(region string) (*session.Session, error) {
+	return defaultProvider.DefaultWithRegion(region)
+}
+
+// FromProfile returns a session configured against the input profile name.
+func FromProfile(name string) (*session.Session, error) {
+	return defaultProvider.FromProfile(name)
+}
+
+// FromRole returns a session configured against the input role and region.
+func FromRole(roleARN string, region string) (*session.Session, error) {
+	return defaultProvider.FromRole(roleARN, region)
+}
+
+// FromEnvRole returns a session configured against the manager role and region of an environment.
+func FromEnvRole(envName, roleARN, region string) (*session.Session, error) {
+	return defaultProvider.FromEnvRole(envName, roleARN, region)
+}
@@ -0,0 +1,80 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package session
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfig = `[default]
+region = us-west-2
+
+[profile prod-admin]
+region = eu-west-1
+`
+
+func TestProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "session")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	configFile := filepath.Join(dir, "config")
+	require.NoError(t, ioutil.WriteFile(configFile, []byte(testConfig), 0644))
+	for key, value := range map[string]string{
+		"AWS_CONFIG_FILE":             configFile,
+		"AWS_SHARED_CREDENTIALS_FILE": filepath.Join(dir, "credentials"),
+		"AWS_PROFILE":                 "",
+		"AWS_REGION":                  "",
+		"AWS_DEFAULT_REGION":          "",
+	} {
+		prev, ok := os.LookupEnv(key)
+		require.NoError(t, os.Setenv(key, value))
+		if ok {
+			defer os.Setenv(key, prev)
+		} else {
+			defer os.Unsetenv(key)
+		}
+	}
+
+	t.Run("uses the region of the default profile", func(t *testing.T) {
+		sess, err := NewProvider("", "", nil).Default()
+
+		require.NoError(t, err)
+		require.Equal(t, "us-west-2", aws.StringValue(sess.Config.Region))
+	})
+	t.Run("uses the region of the named profile", func(t *testing.T) {
+		sess, err := NewProvider("prod-admin", "", nil).Default()
+
+		require.NoError(t, err)
+		require.Equal(t, "eu-west-1", aws.StringValue(sess.Config.Region))
+	})
+	t.Run("overrides the region of the profile", func(t *testing.T) {
+		sess, err := NewProvider("prod-admin", "ap-south-1", nil).Default()
+
+		require.NoError(t, err)
+		require.Equal(t, "ap-south-1", aws.StringValue(sess.Config.Region))
+	})
+	t.Run("uses the input region over the provider's region", func(t *testing.T) {
+		sess, err := NewProvider("", "ap-south-1", nil).DefaultWithRegion("us-east-1")
+
+		require.NoError(t, err)
+		require.Equal(t, "us-east-1", aws.StringValue(sess.Config.Region))
+	})
+	t.Run("assumes the manager role of an environment in its region", func(t *testing.T) {
+		sess, err := NewProvider("", "", map[string]string{"prod": "prod-admin"}).FromEnvRole("prod", "arn:aws:iam::12345:role/manager", "eu-central-1")
+
+		require.NoError(t, err)
+		require.Equal(t, "eu-central-1", aws.StringValue(sess.Config.Region))
+	})
+	t.Run("falls back to the default credentials for environments without a profile", func(t *testing.T) {
+		_, err := NewProvider("", "", map[string]string{"prod": "prod-admin"}).FromEnvRole("test", "arn:aws:iam::12345:role/manager", "eu-central-1")
+
+		require.NoError(t, err)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"sort"
 	"strings"
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/build/ecr"
@@ -25,9 +26,20 @@ func (c command) standardInput(input string) {
 	c.cmd.Stdin = strings.NewReader(input)
 }
 
+func (c command) environment(env []string) {
+	c.cmd.Env = append(os.Environ(), env...)
+}
+
+func (c command) discardOutput() {
+	c.cmd.Stdout = nil
+	c.cmd.Stderr = nil
+}
+
 type runnable interface {
 	run() error
 	standardInput(string)
+	environment([]string)
+	discardOutput()
 }
 
 // Service exists for mockability.
@@ -85,6 +97,65 @@ func (s Service) Push(uri, imageTag string) error {
 	return nil
 }
 
+// RunInput holds the configuration of a container run locally.
+type RunInput struct {
+	Image         string
+	HostPort      int
+	ContainerPort int
+	Env           map[string]string // Environment variables of the container.
+}
+
+// Run will `os/exec` a `docker run` command of the input image and stream the logs of the container until it exits.
+// The environment variables are passed through the environment of the command so that their values, like secrets,
+// don't show up in the list of processes.
+func (s Service) Run(in *RunInput) error {
+	args := []string{"run", "--rm", "-p", fmt.Sprintf("%d:%d", in.HostPort, in.ContainerPort)}
+	var keys []string
+	for k := range in.Env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var env []string
+	for _, k := range keys {
+		args = append(args, "-e", k)
+		env = append(env, fmt.Sprintf("%s=%s", k, in.Env[k]))
+	}
+	args = append(args, in.Image)
+
+	cmd := s.createCommand("docker", args...)
+	cmd.environment(env)
+
+	if err := cmd.run(); err != nil {
+		return fmt.Errorf("run image %s: %w", in.Image, err)
+	}
+
+	return nil
+}
+
+// CheckEngineRunning will `os/exec` a `docker info` command to check that the Docker daemon is reachable.
+func (s Service) CheckEngineRunning() error {
+	cmd := s.createCommand("docker", "info")
+	cmd.discardOutput()
+
+	if err := cmd.run(); err != nil {
+		return fmt.Errorf("docker info: %w", err)
+	}
+
+	return nil
+}
+
+// CheckBuildx will `os/exec` a `docker buildx version` command to check that the buildx plugin is installed.
+func (s Service) CheckBuildx() error {
+	cmd := s.createCommand("docker", "buildx", "version")
+	cmd.discardOutput()
+
+	if err := cmd.run(); err != nil {
+		return fmt.Errorf("docker buildx version: %w", err)
+	}
+
+	return nil
+}
+
 func imageName(uri, tag string) string {
 	return fmt.Sprintf("%s:%s", uri, tag)
 }
@@ -14,6 +14,7 @@ type mockRunnable struct {
 
 	mockRun           func() error
 	mockStandardInput func(t *testing.T, input string)
+	mockEnvironment   func(t *testing.T, env []string)
 }
 
 func (mr mockRunnable) run() error {
@@ -24,6 +25,12 @@ func (mr mockRunnable) standardInput(input string) {
 	mr.mockStandardInput(mr.t, input)
 }
 
+func (mr mockRunnable) environment(env []string) {
+	mr.mockEnvironment(mr.t, env)
+}
+
+func (mr mockRunnable) discardOutput() {}
+
 func TestBuild(t *testing.T) {
 	mockError := errors.New("mockError")
 
@@ -198,3 +205,123 @@ func TestPush(t *testing.T) {
 		})
 	}
 }
+
+func TestRun(t *testing.T) {
+	mockError := errors.New("mockError")
+
+	mockInput := &RunInput{
+		Image:         "phonetool/frontend:local",
+		HostPort:      8080,
+		ContainerPort: 80,
+		Env: map[string]string{
+			"LOG_LEVEL":    "DEBUG",
+			"GITHUB_TOKEN": "secret",
+		},
+	}
+
+	tests := map[string]struct {
+		mockRun func() error
+
+		want error
+	}{
+		"wrap error returned from Run()": {
+			mockRun: func() error {
+				return mockError
+			},
+			want: fmt.Errorf("run image phonetool/frontend:local: %w", mockError),
+		},
+		"happy path": {
+			mockRun: func() error {
+				return nil
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := Service{
+				createCommand: func(name string, args ...string) runnable {
+					require.Equal(t, "docker", name)
+					require.Equal(t, []string{"run", "--rm", "-p", "8080:80", "-e", "GITHUB_TOKEN", "-e", "LOG_LEVEL", "phonetool/frontend:local"}, args)
+
+					return mockRunnable{
+						t:       t,
+						mockRun: test.mockRun,
+						mockEnvironment: func(t *testing.T, env []string) {
+							t.Helper()
+
+							require.Equal(t, []string{"GITHUB_TOKEN=secret", "LOG_LEVEL=DEBUG"}, env)
+						},
+					}
+				},
+			}
+
+			got := s.Run(mockInput)
+
+			require.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestChecks(t *testing.T) {
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		check   func(s Service) error
+		args    []string
+		mockRun func() error
+
+		want error
+	}{
+		"wrap error returned from docker info": {
+			check: Service.CheckEngineRunning,
+			args:  []string{"info"},
+			mockRun: func() error {
+				return mockError
+			},
+			want: fmt.Errorf("docker info: %w", mockError),
+		},
+		"docker engine is running": {
+			check: Service.CheckEngineRunning,
+			args:  []string{"info"},
+			mockRun: func() error {
+				return nil
+			},
+		},
+		"wrap error returned from docker buildx version": {
+			check: Service.CheckBuildx,
+			args:  []string{"buildx", "version"},
+			mockRun: func() error {
+				return mockError
+			},
+			want: fmt.Errorf("docker buildx version: %w", mockError),
+		},
+		"buildx is installed": {
+			check: Service.CheckBuildx,
+			args:  []string{"buildx", "version"},
+			mockRun: func() error {
+				return nil
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := Service{
+				createCommand: func(name string, args ...string) runnable {
+					require.Equal(t, "docker", name)
+					require.Equal(t, test.args, args)
+
+					return mockRunnable{
+						t:       t,
+						mockRun: test.mockRun,
+					}
+				},
+			}
+
+			got := test.check(s)
+
+			require.Equal(t, test.want, got)
+		})
+	}
+}
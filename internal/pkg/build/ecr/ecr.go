@@ -6,11 +6,13 @@ package ecr
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
@@ -76,3 +78,97 @@ func (s Service) GetRepository(name string) (string, error) {
 
 	return *repo.RepositoryUri, nil
 }
+
+// CreateRepository creates the ECR repository if it doesn't exist and returns its URI.
+// The accounts passed in are allowed to pull images from the repository, so that the tasks
+// of environments in other accounts can use them.
+func (s Service) CreateRepository(name string, pullAccountIDs ...string) (string, error) {
+	var uri string
+	resp, err := s.ecr.CreateRepository(&ecr.CreateRepositoryInput{
+		RepositoryName: aws.String(name),
+	})
+	if err != nil {
+		aerr, ok := err.(awserr.Error)
+		if !ok || aerr.Code() != ecr.ErrCodeRepositoryAlreadyExistsException {
+			return "", fmt.Errorf("create repository %s: %w", name, err)
+		}
+		if uri, err = s.GetRepository(name); err != nil {
+			return "", err
+		}
+	} else {
+		uri = aws.StringValue(resp.Repository.RepositoryUri)
+	}
+	if len(pullAccountIDs) == 0 {
+		return uri, nil
+	}
+
+	policy, err := pullPolicy(pullAccountIDs)
+	if err != nil {
+		return "", err
+	}
+	if _, err := s.ecr.SetRepositoryPolicy(&ecr.SetRepositoryPolicyInput{
+		RepositoryName: aws.String(name),
+		PolicyText:     aws.String(policy),
+	}); err != nil {
+		return "", fmt.Errorf("set policy of repository %s: %w", name, err)
+	}
+	return uri, nil
+}
+
+// pullPolicy returns a repository policy that allows the accounts to pull images.
+func pullPolicy(accountIDs []string) (string, error) {
+	var principals []string
+	for _, id := range accountIDs {
+		principals = append(principals, fmt.Sprintf("arn:aws:iam::%s:root", id))
+	}
+	policy := map[string]interface{}{
+		"Version": "2008-10-17",
+		"Statement": []map[string]interface{}{
+			{
+				"Sid":       "AllowPull",
+				"Effect":    "Allow",
+				"Principal": map[string][]string{"AWS": principals},
+				"Action": []string{
+					"ecr:GetDownloadUrlForLayer",
+					"ecr:BatchGetImage",
+					"ecr:BatchCheckLayerAvailability",
+				},
+			},
+		},
+	}
+	data, err := json.Marshal(policy)
+	if err != nil {
+		return "", fmt.Errorf("marshal repository policy: %w", err)
+	}
+	return string(data), nil
+}
+
+// ClearRepository deletes all the images in the ECR repository.
+// If the repository doesn't exist, it's a no-op.
+func (s Service) ClearRepository(name string) error {
+	in := &ecr.ListImagesInput{
+		RepositoryName: aws.String(name),
+	}
+	for {
+		resp, err := s.ecr.ListImages(in)
+		if err != nil {
+			if aerr, ok := err.(awserr.Error); ok && aerr.Code() == ecr.ErrCodeRepositoryNotFoundException {
+				return nil
+			}
+			return fmt.Errorf("list images in repository %s: %w", name, err)
+		}
+		// A page of ListImages has at most 100 images, which is the maximum that can be deleted in one batch.
+		if len(resp.ImageIds) > 0 {
+			if _, err := s.ecr.BatchDeleteImage(&ecr.BatchDeleteImageInput{
+				RepositoryName: aws.String(name),
+				ImageIds:       resp.ImageIds,
+			}); err != nil {
+				return fmt.Errorf("delete images in repository %s: %w", name, err)
+			}
+		}
+		if resp.NextToken == nil {
+			return nil
+		}
+		in.NextToken = resp.NextToken
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
 	"github.com/stretchr/testify/require"
@@ -21,6 +22,13 @@ type mockECR struct {
 	mockGetAuthorizationToken func(*ecr.GetAuthorizationTokenInput) (*ecr.GetAuthorizationTokenOutput, error)
 	mockCreateRepository      func(*ecr.CreateRepositoryInput) (*ecr.CreateRepositoryOutput, error)
 	mockDescribeRepositories  func(*ecr.DescribeRepositoriesInput) (*ecr.DescribeRepositoriesOutput, error)
+	mockListImages            func(*ecr.ListImagesInput) (*ecr.ListImagesOutput, error)
+	mockBatchDeleteImage      func(*ecr.BatchDeleteImageInput) (*ecr.BatchDeleteImageOutput, error)
+	mockSetRepositoryPolicy   func(*ecr.SetRepositoryPolicyInput) (*ecr.SetRepositoryPolicyOutput, error)
+}
+
+func (m mockECR) SetRepositoryPolicy(input *ecr.SetRepositoryPolicyInput) (*ecr.SetRepositoryPolicyOutput, error) {
+	return m.mockSetRepositoryPolicy(input)
 }
 
 func (m mockECR) GetAuthorizationToken(input *ecr.GetAuthorizationTokenInput) (*ecr.GetAuthorizationTokenOutput, error) {
@@ -35,6 +43,14 @@ func (m mockECR) DescribeRepositories(input *ecr.DescribeRepositoriesInput) (*ec
 	return m.mockDescribeRepositories(input)
 }
 
+func (m mockECR) ListImages(input *ecr.ListImagesInput) (*ecr.ListImagesOutput, error) {
+	return m.mockListImages(input)
+}
+
+func (m mockECR) BatchDeleteImage(input *ecr.BatchDeleteImageInput) (*ecr.BatchDeleteImageOutput, error) {
+	return m.mockBatchDeleteImage(input)
+}
+
 func TestGetECRAuth(t *testing.T) {
 	mockError := errors.New("error")
 
@@ -145,3 +161,151 @@ func TestGetRepository(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateRepository(t *testing.T) {
+	mockError := errors.New("some error")
+
+	testCases := map[string]struct {
+		inPullAccountIDs         []string
+		mockCreateRepository     func(*ecr.CreateRepositoryInput) (*ecr.CreateRepositoryOutput, error)
+		mockDescribeRepositories func(*ecr.DescribeRepositoriesInput) (*ecr.DescribeRepositoriesOutput, error)
+		mockSetRepositoryPolicy  func(*ecr.SetRepositoryPolicyInput) (*ecr.SetRepositoryPolicyOutput, error)
+
+		wantURI string
+		wantErr error
+	}{
+		"returns wrapped error if the repository cannot be created": {
+			mockCreateRepository: func(*ecr.CreateRepositoryInput) (*ecr.CreateRepositoryOutput, error) {
+				return nil, mockError
+			},
+			wantErr: fmt.Errorf("create repository phonetool/frontend-task: %w", mockError),
+		},
+		"returns the URI of an existing repository": {
+			mockCreateRepository: func(*ecr.CreateRepositoryInput) (*ecr.CreateRepositoryOutput, error) {
+				return nil, awserr.New(ecr.ErrCodeRepositoryAlreadyExistsException, "exists", nil)
+			},
+			mockDescribeRepositories: func(input *ecr.DescribeRepositoriesInput) (*ecr.DescribeRepositoriesOutput, error) {
+				return &ecr.DescribeRepositoriesOutput{
+					Repositories: []*ecr.Repository{
+						{RepositoryUri: aws.String("1111.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend-task")},
+					},
+				}, nil
+			},
+			wantURI: "1111.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend-task",
+		},
+		"allows the accounts to pull images": {
+			inPullAccountIDs: []string{"2222"},
+			mockCreateRepository: func(input *ecr.CreateRepositoryInput) (*ecr.CreateRepositoryOutput, error) {
+				require.Equal(t, "phonetool/frontend-task", *input.RepositoryName)
+				return &ecr.CreateRepositoryOutput{
+					Repository: &ecr.Repository{
+						RepositoryUri: aws.String("1111.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend-task"),
+					},
+				}, nil
+			},
+			mockSetRepositoryPolicy: func(input *ecr.SetRepositoryPolicyInput) (*ecr.SetRepositoryPolicyOutput, error) {
+				require.Equal(t, "phonetool/frontend-task", *input.RepositoryName)
+				require.Contains(t, *input.PolicyText, "arn:aws:iam::2222:root")
+				return &ecr.SetRepositoryPolicyOutput{}, nil
+			},
+			wantURI: "1111.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend-task",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			service := Service{
+				mockECR{
+					mockCreateRepository:     tc.mockCreateRepository,
+					mockDescribeRepositories: tc.mockDescribeRepositories,
+					mockSetRepositoryPolicy:  tc.mockSetRepositoryPolicy,
+				},
+			}
+
+			gotURI, gotErr := service.CreateRepository("phonetool/frontend-task", tc.inPullAccountIDs...)
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, tc.wantURI, gotURI)
+		})
+	}
+}
+
+func TestClearRepository(t *testing.T) {
+	mockError := errors.New("error")
+	mockRepoName := "mockRepoName"
+
+	testCases := map[string]struct {
+		mockListImages       func(*ecr.ListImagesInput) (*ecr.ListImagesOutput, error)
+		mockBatchDeleteImage func(*ecr.BatchDeleteImageInput) (*ecr.BatchDeleteImageOutput, error)
+
+		wantDeleted []string
+		wantErr     error
+	}{
+		"should return nil if the repository does not exist": {
+			mockListImages: func(*ecr.ListImagesInput) (*ecr.ListImagesOutput, error) {
+				return nil, awserr.New(ecr.ErrCodeRepositoryNotFoundException, "not found", nil)
+			},
+		},
+		"should return wrapped error given error returned from ListImages": {
+			mockListImages: func(*ecr.ListImagesInput) (*ecr.ListImagesOutput, error) {
+				return nil, mockError
+			},
+			wantErr: fmt.Errorf("list images in repository %s: %w", mockRepoName, mockError),
+		},
+		"should return wrapped error given error returned from BatchDeleteImage": {
+			mockListImages: func(*ecr.ListImagesInput) (*ecr.ListImagesOutput, error) {
+				return &ecr.ListImagesOutput{
+					ImageIds: []*ecr.ImageIdentifier{{ImageDigest: aws.String("sha256:1")}},
+				}, nil
+			},
+			mockBatchDeleteImage: func(*ecr.BatchDeleteImageInput) (*ecr.BatchDeleteImageOutput, error) {
+				return nil, mockError
+			},
+			wantErr: fmt.Errorf("delete images in repository %s: %w", mockRepoName, mockError),
+		},
+		"should delete the images of every page": {
+			mockListImages: func(in *ecr.ListImagesInput) (*ecr.ListImagesOutput, error) {
+				require.Equal(t, mockRepoName, aws.StringValue(in.RepositoryName))
+				if in.NextToken == nil {
+					return &ecr.ListImagesOutput{
+						ImageIds:  []*ecr.ImageIdentifier{{ImageDigest: aws.String("sha256:1")}},
+						NextToken: aws.String("next"),
+					}, nil
+				}
+				return &ecr.ListImagesOutput{
+					ImageIds: []*ecr.ImageIdentifier{{ImageDigest: aws.String("sha256:2")}},
+				}, nil
+			},
+			wantDeleted: []string{"sha256:1", "sha256:2"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var deleted []string
+			m := mockECR{
+				mockListImages:       tc.mockListImages,
+				mockBatchDeleteImage: tc.mockBatchDeleteImage,
+			}
+			if m.mockBatchDeleteImage == nil {
+				m.mockBatchDeleteImage = func(in *ecr.BatchDeleteImageInput) (*ecr.BatchDeleteImageOutput, error) {
+					require.Equal(t, mockRepoName, aws.StringValue(in.RepositoryName))
+					for _, id := range in.ImageIds {
+						deleted = append(deleted, aws.StringValue(id.ImageDigest))
+					}
+					return &ecr.BatchDeleteImageOutput{}, nil
+				}
+			}
+			service := Service{m}
+
+			gotErr := service.ClearRepository(mockRepoName)
+
+			require.Equal(t, tc.wantErr, gotErr)
+			require.Equal(t, tc.wantDeleted, deleted)
+		})
+	}
+}
@@ -28,6 +28,14 @@ An application represents an Amazon ECS service or task.`,
 	cmd.AddCommand(BuildAppInitCmd())
 	cmd.AddCommand(BuildAppPackageCmd())
 	cmd.AddCommand(BuildAppDeployCommand())
+	cmd.AddCommand(BuildAppRollbackCmd())
+	cmd.AddCommand(BuildAppHistoryCmd())
+	cmd.AddCommand(BuildAppLogsCmd())
+	cmd.AddCommand(BuildAppStatusCmd())
+	cmd.AddCommand(BuildAppShowCmd())
+	cmd.AddCommand(BuildAppDeleteCmd())
+	cmd.AddCommand(BuildAppExecCmd())
+	cmd.AddCommand(BuildAppRunCmd())
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
 		"group": group.Develop,
@@ -0,0 +1,287 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/build/ecr"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	termprogress "github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/progress"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	fmtDeleteAppPrompt = "Are you sure you want to delete application %s from project %s?"
+	deleteAppHelp      = "The application's stacks in every environment, its ECR repositories and its metadata are deleted."
+
+	fmtECRRepositoryName = "%s/%s" // Same as the repository name in the project StackSet template.
+)
+
+const (
+	fmtDeleteAppStackStart    = "Deleting application %s from environment %s."
+	fmtDeleteAppStackFailed   = "Failed to delete application %s from environment %s: %v."
+	fmtDeleteAppStackComplete = "Deleted application %s from environment %s."
+	fmtSkipAppStack           = "Application %s is not deployed in environment %s."
+
+	fmtClearRepoStart    = "Deleting the images of repository %s in region %s."
+	fmtClearRepoFailed   = "Failed to delete the images of repository %s in region %s: %v."
+	fmtClearRepoComplete = "Deleted the images of repository %s in region %s."
+
+	fmtRemoveAppStart    = "Deleting the ECR repositories of application %s."
+	fmtRemoveAppFailed   = "Failed to delete the ECR repositories of application %s: %v."
+	fmtRemoveAppComplete = "Deleted the ECR repositories of application %s."
+)
+
+type appStackDeleter interface {
+	DeleteApp(stackName, cfExecutionRole string) error
+}
+
+type appResourcesRemover interface {
+	RemoveAppFromProject(project *archer.Project, appName string) error
+	GetRegionalProjectResources(project *archer.Project) ([]*archer.ProjectRegionalResources, error)
+}
+
+type imageRemover interface {
+	ClearRepository(repoName string) error
+}
+
+// DeleteAppOpts holds the fields needed to delete an application.
+type DeleteAppOpts struct {
+	// Fields with matching flags.
+	AppName          string
+	SkipConfirmation bool
+	EmptyRepo        bool
+	DeleteManifest   bool
+
+	// Interfaces for dependencies.
+	store            projectService
+	ws               archer.Workspace
+	resourcesRemover appResourcesRemover
+	stackDeleters    map[string]appStackDeleter // Keyed by environment name, created from the environment's manager role.
+	imageRemovers    map[string]imageRemover    // Keyed by region.
+	prog             progress
+
+	*GlobalOpts
+}
+
+// Ask prompts for the application to delete if it's not passed in.
+func (opts *DeleteAppOpts) Ask() error {
+	if opts.AppName != "" {
+		return nil
+	}
+	name, err := selectApplication(opts.promptFor(nameFlag), opts.store, opts.ProjectName(),
+		"Which application would you like to delete?",
+		deleteAppHelp)
+	if err != nil {
+		return err
+	}
+	opts.AppName = name
+	return nil
+}
+
+// Validate returns an error if the project or the application do not exist.
+func (opts *DeleteAppOpts) Validate() error {
+	if opts.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	if opts.AppName != "" {
+		if _, err := opts.store.GetApplication(opts.ProjectName(), opts.AppName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Execute deletes the application's stack in every environment, then its ECR repositories from the project
+// and finally its metadata from the store.
+// It halts on the first failure so that the command can be re-run.
+func (opts *DeleteAppOpts) Execute() error {
+	shouldDelete, err := opts.shouldDelete()
+	if err != nil {
+		return err
+	}
+	if !shouldDelete {
+		return nil
+	}
+
+	proj, err := opts.store.GetProject(opts.ProjectName())
+	if err != nil {
+		return fmt.Errorf("get project %s: %w", opts.ProjectName(), err)
+	}
+	if err := opts.deleteStacks(); err != nil {
+		return err
+	}
+	if opts.EmptyRepo {
+		if err := opts.clearRepositories(proj); err != nil {
+			return err
+		}
+	}
+	opts.prog.Start(fmt.Sprintf(fmtRemoveAppStart, opts.AppName))
+	if err := opts.resourcesRemover.RemoveAppFromProject(proj, opts.AppName); err != nil {
+		opts.prog.Stop(log.Serrorf(fmtRemoveAppFailed, opts.AppName, err))
+		return fmt.Errorf("remove application %s from project %s: %w", opts.AppName, opts.ProjectName(), err)
+	}
+	opts.prog.Stop(log.Ssuccessf(fmtRemoveAppComplete, opts.AppName))
+
+	if err := opts.store.DeleteApplication(opts.ProjectName(), opts.AppName); err != nil {
+		return err
+	}
+	if opts.DeleteManifest {
+		if err := opts.ws.DeleteFile(opts.ws.AppManifestFileName(opts.AppName)); err != nil {
+			return fmt.Errorf("delete manifest of application %s: %w", opts.AppName, err)
+		}
+	}
+	log.Successf("Deleted application %s from project %s.\n", color.HighlightUserInput(opts.AppName), color.HighlightUserInput(opts.ProjectName()))
+	return nil
+}
+
+func (opts *DeleteAppOpts) shouldDelete() (bool, error) {
+	if opts.SkipConfirmation {
+		return true, nil
+	}
+	shouldDelete, err := opts.promptFor(yesFlag).Confirm(fmt.Sprintf(fmtDeleteAppPrompt, opts.AppName, opts.ProjectName()), deleteAppHelp)
+	if err != nil {
+		return false, fmt.Errorf("prompt for application deletion: %w", err)
+	}
+	return shouldDelete, nil
+}
+
+// deleteStacks deletes the application's stack in every environment with the environment's execution role.
+// Environments where the application isn't deployed are skipped.
+func (opts *DeleteAppOpts) deleteStacks() error {
+	envs, err := opts.store.ListEnvironments(opts.ProjectName())
+	if err != nil {
+		return fmt.Errorf("list environments in project %s: %w", opts.ProjectName(), err)
+	}
+	for _, env := range envs {
+		deleter, err := opts.stackDeleter(env)
+		if err != nil {
+			return err
+		}
+		opts.prog.Start(fmt.Sprintf(fmtDeleteAppStackStart, opts.AppName, env.Name))
+		err = deleter.DeleteApp(stack.NameForApp(env.Project, env.Name, opts.AppName), env.ExecutionRoleARN)
+		var stackNotFound *cloudformation.ErrStackNotFound
+		if errors.As(err, &stackNotFound) {
+			opts.prog.Stop(log.Ssuccessf(fmtSkipAppStack, opts.AppName, env.Name))
+			continue
+		}
+		if err != nil {
+			opts.prog.Stop(log.Serrorf(fmtDeleteAppStackFailed, opts.AppName, env.Name, err))
+			return fmt.Errorf("delete stack of application %s in environment %s: %w", opts.AppName, env.Name, err)
+		}
+		opts.prog.Stop(log.Ssuccessf(fmtDeleteAppStackComplete, opts.AppName, env.Name))
+	}
+	return nil
+}
+
+// clearRepositories deletes the images of the application's ECR repository in every region of the project.
+func (opts *DeleteAppOpts) clearRepositories(proj *archer.Project) error {
+	resources, err := opts.resourcesRemover.GetRegionalProjectResources(proj)
+	if err != nil {
+		return fmt.Errorf("get regional resources of project %s: %w", proj.Name, err)
+	}
+	repoName := fmt.Sprintf(fmtECRRepositoryName, proj.Name, opts.AppName)
+	for _, r := range resources {
+		remover, err := opts.imageRemover(r.Region)
+		if err != nil {
+			return err
+		}
+		opts.prog.Start(fmt.Sprintf(fmtClearRepoStart, repoName, r.Region))
+		if err := remover.ClearRepository(repoName); err != nil {
+			opts.prog.Stop(log.Serrorf(fmtClearRepoFailed, repoName, r.Region, err))
+			return fmt.Errorf("empty repository %s in region %s: %w", repoName, r.Region, err)
+		}
+		opts.prog.Stop(log.Ssuccessf(fmtClearRepoComplete, repoName, r.Region))
+	}
+	return nil
+}
+
+func (opts *DeleteAppOpts) stackDeleter(env *archer.Environment) (appStackDeleter, error) {
+	if deleter, ok := opts.stackDeleters[env.Name]; ok {
+		return deleter, nil
+	}
+	sess, err := opts.sessionProvider().FromEnvRole(env.Name, env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create session from role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	opts.stackDeleters[env.Name] = cloudformation.New(sess)
+	return opts.stackDeleters[env.Name], nil
+}
+
+func (opts *DeleteAppOpts) imageRemover(region string) (imageRemover, error) {
+	if remover, ok := opts.imageRemovers[region]; ok {
+		return remover, nil
+	}
+	sess, err := opts.sessionProvider().DefaultWithRegion(region)
+	if err != nil {
+		return nil, fmt.Errorf("create session in region %s: %w", region, err)
+	}
+	opts.imageRemovers[region] = ecr.New(sess)
+	return opts.imageRemovers[region], nil
+}
+
+// BuildAppDeleteCmd builds the command to delete an application.
+func BuildAppDeleteCmd() *cobra.Command {
+	opts := &DeleteAppOpts{
+		stackDeleters: make(map[string]appStackDeleter),
+		imageRemovers: make(map[string]imageRemover),
+		GlobalOpts:    NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Deletes an application from your project.",
+		Long: `Deletes an application from every environment of your project.
+The application's ECR repositories must be empty to be deleted, use --empty-repo to delete their images first.`,
+		Example: `
+  Delete the "frontend" application.
+  /code $ archer app delete --name frontend
+
+  Delete the "frontend" application, its images and its manifest without prompting.
+  /code $ archer app delete --name frontend --empty-repo --delete-manifest --yes`,
+		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			ws, err := workspace.New()
+			if err != nil {
+				return fmt.Errorf("new workspace: %w", err)
+			}
+			opts.ws = ws
+
+			store, err := store.New()
+			if err != nil {
+				return fmt.Errorf("connect to ecs-cli metadata store: %w", err)
+			}
+			opts.store = store
+
+			sess, err := opts.sessionProvider().Default()
+			if err != nil {
+				return fmt.Errorf("error retrieving default session: %w", err)
+			}
+			opts.resourcesRemover = cloudformation.New(sess)
+			opts.prog = termprogress.NewSpinner()
+			return opts.Validate()
+		}),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&opts.AppName, nameFlag, nameFlagShort, "", appFlagDescription)
+	markFlagCompletion(cmd.Flags(), nameFlag, completeApps)
+	cmd.Flags().BoolVar(&opts.SkipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&opts.EmptyRepo, emptyRepoFlag, false, emptyRepoFlagDescription)
+	cmd.Flags().BoolVar(&opts.DeleteManifest, deleteManifestFlag, false, deleteManifestFlagDescription)
+	return cmd
+}
@@ -0,0 +1,264 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
+	"github.com/aws/amazon-ecs-cli-v2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteAppOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inProjectName string
+		inAppName     string
+		mockStore     func(m *climocks.MockprojectService)
+
+		wantedErr error
+	}{
+		"returns error if there is no project": {
+			mockStore: func(m *climocks.MockprojectService) {},
+			wantedErr: errNoProjectInWorkspace,
+		},
+		"returns error if the application does not exist": {
+			inProjectName: "phonetool",
+			inAppName:     "frontend",
+			mockStore: func(m *climocks.MockprojectService) {
+				m.EXPECT().GetApplication("phonetool", "frontend").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"valid application": {
+			inProjectName: "phonetool",
+			inAppName:     "frontend",
+			mockStore: func(m *climocks.MockprojectService) {
+				m.EXPECT().GetApplication("phonetool", "frontend").Return(&archer.Application{Name: "frontend"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := climocks.NewMockprojectService(ctrl)
+			tc.mockStore(mockStore)
+
+			opts := &DeleteAppOpts{
+				AppName:    tc.inAppName,
+				store:      mockStore,
+				GlobalOpts: &GlobalOpts{projectName: tc.inProjectName},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDeleteAppOpts_Execute(t *testing.T) {
+	proj := &archer.Project{Name: "phonetool"}
+	testEnv := &archer.Environment{
+		Project:          "phonetool",
+		Name:             "test",
+		Region:           "us-west-2",
+		ExecutionRoleARN: "arn:aws:iam::1111:role/phonetool-test-CFNExecutionRole",
+	}
+	prodEnv := &archer.Environment{
+		Project:          "phonetool",
+		Name:             "prod",
+		Region:           "us-east-1",
+		ExecutionRoleARN: "arn:aws:iam::2222:role/phonetool-prod-CFNExecutionRole",
+	}
+	mockErr := errors.New("some error")
+
+	testCases := map[string]struct {
+		inSkipConfirmation bool
+		inEmptyRepo        bool
+		inDeleteManifest   bool
+
+		mockPrompt    func(m *climocks.Mockprompter)
+		mockStore     func(m *climocks.MockprojectService)
+		mockWorkspace func(m *mocks.MockWorkspace)
+		mockTest      func(m *climocks.MockappStackDeleter)
+		mockProd      func(m *climocks.MockappStackDeleter)
+		mockRemover   func(m *climocks.MockappResourcesRemover)
+		mockImages    func(m *climocks.MockimageRemover)
+
+		wantedErr error
+	}{
+		"does nothing if the user does not confirm": {
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().Confirm("Are you sure you want to delete application frontend from project phonetool?", gomock.Any()).Return(false, nil)
+			},
+			mockStore:     func(m *climocks.MockprojectService) {},
+			mockWorkspace: func(m *mocks.MockWorkspace) {},
+			mockTest:      func(m *climocks.MockappStackDeleter) {},
+			mockProd:      func(m *climocks.MockappStackDeleter) {},
+			mockRemover:   func(m *climocks.MockappResourcesRemover) {},
+			mockImages:    func(m *climocks.MockimageRemover) {},
+		},
+		"halts if a stack cannot be deleted": {
+			inSkipConfirmation: true,
+			mockPrompt:         func(m *climocks.Mockprompter) {},
+			mockStore: func(m *climocks.MockprojectService) {
+				m.EXPECT().GetProject("phonetool").Return(proj, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return([]*archer.Environment{testEnv, prodEnv}, nil)
+			},
+			mockWorkspace: func(m *mocks.MockWorkspace) {},
+			mockTest: func(m *climocks.MockappStackDeleter) {
+				m.EXPECT().DeleteApp("phonetool-test-frontend", testEnv.ExecutionRoleARN).Return(mockErr)
+			},
+			mockProd:    func(m *climocks.MockappStackDeleter) {},
+			mockRemover: func(m *climocks.MockappResourcesRemover) {},
+			mockImages:  func(m *climocks.MockimageRemover) {},
+			wantedErr:   errors.New("delete stack of application frontend in environment test: some error"),
+		},
+		"skips the environments where the application is not deployed": {
+			inSkipConfirmation: true,
+			mockPrompt:         func(m *climocks.Mockprompter) {},
+			mockStore: func(m *climocks.MockprojectService) {
+				m.EXPECT().GetProject("phonetool").Return(proj, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return([]*archer.Environment{testEnv, prodEnv}, nil)
+				m.EXPECT().DeleteApplication("phonetool", "frontend").Return(nil)
+			},
+			mockWorkspace: func(m *mocks.MockWorkspace) {},
+			mockTest: func(m *climocks.MockappStackDeleter) {
+				m.EXPECT().DeleteApp("phonetool-test-frontend", testEnv.ExecutionRoleARN).Return(&cloudformation.ErrStackNotFound{})
+			},
+			mockProd: func(m *climocks.MockappStackDeleter) {
+				m.EXPECT().DeleteApp("phonetool-prod-frontend", prodEnv.ExecutionRoleARN).Return(nil)
+			},
+			mockRemover: func(m *climocks.MockappResourcesRemover) {
+				m.EXPECT().RemoveAppFromProject(proj, "frontend").Return(nil)
+			},
+			mockImages: func(m *climocks.MockimageRemover) {},
+		},
+		"halts if the application cannot be removed from the project": {
+			inSkipConfirmation: true,
+			mockPrompt:         func(m *climocks.Mockprompter) {},
+			mockStore: func(m *climocks.MockprojectService) {
+				m.EXPECT().GetProject("phonetool").Return(proj, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return([]*archer.Environment{testEnv}, nil)
+			},
+			mockWorkspace: func(m *mocks.MockWorkspace) {},
+			mockTest: func(m *climocks.MockappStackDeleter) {
+				m.EXPECT().DeleteApp("phonetool-test-frontend", testEnv.ExecutionRoleARN).Return(nil)
+			},
+			mockProd: func(m *climocks.MockappStackDeleter) {},
+			mockRemover: func(m *climocks.MockappResourcesRemover) {
+				m.EXPECT().RemoveAppFromProject(proj, "frontend").Return(mockErr)
+			},
+			mockImages: func(m *climocks.MockimageRemover) {},
+			wantedErr:  errors.New("remove application frontend from project phonetool: some error"),
+		},
+		"deletes the application everywhere": {
+			inSkipConfirmation: true,
+			inEmptyRepo:        true,
+			inDeleteManifest:   true,
+			mockPrompt:         func(m *climocks.Mockprompter) {},
+			mockStore: func(m *climocks.MockprojectService) {
+				gomock.InOrder(
+					m.EXPECT().GetProject("phonetool").Return(proj, nil),
+					m.EXPECT().ListEnvironments("phonetool").Return([]*archer.Environment{testEnv, prodEnv}, nil),
+					m.EXPECT().DeleteApplication("phonetool", "frontend").Return(nil),
+				)
+			},
+			mockWorkspace: func(m *mocks.MockWorkspace) {
+				m.EXPECT().AppManifestFileName("frontend").Return("frontend-app.yml")
+				m.EXPECT().DeleteFile("frontend-app.yml").Return(nil)
+			},
+			mockTest: func(m *climocks.MockappStackDeleter) {
+				m.EXPECT().DeleteApp("phonetool-test-frontend", testEnv.ExecutionRoleARN).Return(nil)
+			},
+			mockProd: func(m *climocks.MockappStackDeleter) {
+				m.EXPECT().DeleteApp("phonetool-prod-frontend", prodEnv.ExecutionRoleARN).Return(nil)
+			},
+			mockRemover: func(m *climocks.MockappResourcesRemover) {
+				gomock.InOrder(
+					m.EXPECT().GetRegionalProjectResources(proj).Return([]*archer.ProjectRegionalResources{
+						{Region: "us-west-2"},
+						{Region: "us-east-1"},
+					}, nil),
+					m.EXPECT().RemoveAppFromProject(proj, "frontend").Return(nil),
+				)
+			},
+			mockImages: func(m *climocks.MockimageRemover) {
+				m.EXPECT().ClearRepository("phonetool/frontend").Return(nil).Times(2)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPrompt := climocks.NewMockprompter(ctrl)
+			mockStore := climocks.NewMockprojectService(ctrl)
+			mockWorkspace := mocks.NewMockWorkspace(ctrl)
+			mockTest := climocks.NewMockappStackDeleter(ctrl)
+			mockProd := climocks.NewMockappStackDeleter(ctrl)
+			mockRemover := climocks.NewMockappResourcesRemover(ctrl)
+			mockImages := climocks.NewMockimageRemover(ctrl)
+			mockProg := climocks.NewMockprogress(ctrl)
+			mockProg.EXPECT().Start(gomock.Any()).AnyTimes()
+			mockProg.EXPECT().Stop(gomock.Any()).AnyTimes()
+			tc.mockPrompt(mockPrompt)
+			tc.mockStore(mockStore)
+			tc.mockWorkspace(mockWorkspace)
+			tc.mockTest(mockTest)
+			tc.mockProd(mockProd)
+			tc.mockRemover(mockRemover)
+			tc.mockImages(mockImages)
+
+			opts := &DeleteAppOpts{
+				AppName:          "frontend",
+				SkipConfirmation: tc.inSkipConfirmation,
+				EmptyRepo:        tc.inEmptyRepo,
+				DeleteManifest:   tc.inDeleteManifest,
+				store:            mockStore,
+				ws:               mockWorkspace,
+				resourcesRemover: mockRemover,
+				stackDeleters: map[string]appStackDeleter{
+					"test": mockTest,
+					"prod": mockProd,
+				},
+				imageRemovers: map[string]imageRemover{
+					"us-west-2": mockImages,
+					"us-east-1": mockImages,
+				},
+				prog: mockProg,
+				GlobalOpts: &GlobalOpts{
+					projectName: "phonetool",
+					prompt:      mockPrompt,
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
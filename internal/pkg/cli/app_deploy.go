@@ -5,20 +5,27 @@ package cli
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"os"
 	"os/exec"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
 	"github.com/spf13/cobra"
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
-	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/session"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/identity"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/s3"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/build/docker"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/build/ecr"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
@@ -27,12 +34,21 @@ import (
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/workspace"
 )
 
+const (
+	fmtAppChangeSetStart    = "Computing the changes to deploy %s to %s."
+	fmtAppChangeSetFailed   = "Failed to compute the changes to deploy %s to %s."
+	fmtAppChangeSetComplete = "Computed the changes to deploy %s to %s."
+
+	fmtRevisionTemplateKey = "revisions/%s/%s/%x.yml" // app and env names, and SHA256 of the template.
+)
+
 // BuildAppDeployCommand builds the `app deploy` subcommand.
 func BuildAppDeployCommand() *cobra.Command {
 	input := &appDeployOpts{
 		GlobalOpts:    NewGlobalOpts(),
 		spinner:       termprogress.NewSpinner(),
 		dockerService: docker.New(),
+		w:             os.Stderr,
 	}
 
 	cmd := &cobra.Command{
@@ -41,7 +57,9 @@ func BuildAppDeployCommand() *cobra.Command {
 		Long:  `Deploy an application to an environment.`,
 		Example: `
   Deploy an application named "frontend" to a "test" environment.
-  /code $ archer app deploy --name frontend --env test`,
+  /code $ archer app deploy --name frontend --env test
+  Review the changes to the "frontend" application's stack before deploying them.
+  /code $ archer app deploy --name frontend --env prod --diff`,
 		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			if err := input.init(); err != nil {
 				return err
@@ -64,25 +82,39 @@ func BuildAppDeployCommand() *cobra.Command {
 
 	cmd.Flags().StringVarP(&input.app, nameFlag, nameFlagShort, "", appFlagDescription)
 	cmd.Flags().StringVarP(&input.env, envFlag, envFlagShort, "", envFlagDescription)
+	markFlagCompletion(cmd.Flags(), nameFlag, completeApps)
+	markFlagCompletion(cmd.Flags(), envFlag, completeEnvs)
 	cmd.Flags().StringVar(&input.imageTag, imageTagFlag, "", imageTagFlagDescription)
+	cmd.Flags().BoolVar(&input.diff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().BoolVar(&input.dryRun, dryRunFlag, false, dryRunFlagDescription)
+	cmd.Flags().BoolVar(&input.skipConfirmation, yesFlag, false, applyChangesFlagDescription)
 
 	return cmd
 }
 
 type appDeployOpts struct {
 	*GlobalOpts
-	app      string
-	env      string
-	imageTag string
+	app       string
+	env       string
+	imageTag  string
+	gitCommit string // Commit of the workspace recorded in the revision, if any.
+	diff      bool
+	dryRun    bool // Shows the changes without building the image or applying them.
+	// Applies the changes shown with --diff without prompting.
+	skipConfirmation bool
 
 	projectService     projectService
 	workspaceService   archer.Workspace
 	ecrService         ecrService
 	dockerService      dockerService
 	appPackageCfClient projectResourcesGetter
-	appDeployCfClient  cloudformation.CloudFormation
+	appDeployCfClient  appStackDeployer
+	revisionStore      archer.AppRevisionCreator
+	uploader           artifactUploader
+	identity           identityService
 
 	spinner progress
+	w       io.Writer
 
 	localProjectAppNames []string
 	projectEnvironments  []*archer.Environment
@@ -105,6 +137,17 @@ type ecrService interface {
 	GetECRAuth() (ecr.Auth, error)
 }
 
+type artifactUploader interface {
+	PutArtifact(bucket, key string, data io.Reader) (string, error)
+}
+
+type appStackDeployer interface {
+	DeployApp(template, stackName, changeSetName, cfExecutionRole string, tags map[string]string) error
+	CreateAppChangeSet(template, stackName, cfExecutionRole string, tags map[string]string) (*deploy.ChangeSet, error)
+	ExecuteChangeSet(cs *deploy.ChangeSet) error
+	DeleteChangeSet(cs *deploy.ChangeSet) error
+}
+
 type dockerService interface {
 	Build(uri, tag, path string) error
 	Login(uri string, auth ecr.Auth) error
@@ -117,6 +160,7 @@ func (opts *appDeployOpts) init() error {
 		return fmt.Errorf("create project service: %w", err)
 	}
 	opts.projectService = projectService
+	opts.revisionStore = projectService
 
 	workspaceService, err := workspace.New()
 	if err != nil {
@@ -150,6 +194,7 @@ func (opts *appDeployOpts) sourceInputs() error {
 	if err := opts.sourceImageTag(); err != nil {
 		return err
 	}
+	opts.sourceGitCommit()
 
 	return nil
 }
@@ -215,7 +260,7 @@ func (opts *appDeployOpts) sourceAppName() error {
 			return nil
 		}
 
-		selectedAppName, err := opts.prompt.SelectOne("Select an application", "", opts.localProjectAppNames)
+		selectedAppName, err := opts.promptFor(nameFlag).SelectOne("Select an application", "", opts.localProjectAppNames)
 
 		if err != nil {
 			return fmt.Errorf("select app name: %w", err)
@@ -249,7 +294,7 @@ func (opts *appDeployOpts) sourceTargetEnv() error {
 			envNames = append(envNames, env.Name)
 		}
 
-		selectedEnvName, err := opts.prompt.SelectOne("Select an environment", "", envNames)
+		selectedEnvName, err := opts.promptFor(envFlag).SelectOne("Select an environment", "", envNames)
 
 		if err != nil {
 			return fmt.Errorf("select env name: %w", err)
@@ -270,12 +315,12 @@ func (opts *appDeployOpts) sourceTargetEnv() error {
 }
 
 func (opts *appDeployOpts) configureClients() error {
-	defaultSessEnvRegion, err := session.DefaultWithRegion(opts.targetEnvironment.Region)
+	defaultSessEnvRegion, err := opts.sessionProvider().DefaultWithRegion(opts.targetEnvironment.Region)
 	if err != nil {
 		return fmt.Errorf("create ECR session with region %s: %w", opts.targetEnvironment.Region, err)
 	}
 
-	envSession, err := session.FromRole(opts.targetEnvironment.ManagerRoleARN, opts.targetEnvironment.Region)
+	envSession, err := opts.sessionProvider().FromEnvRole(opts.targetEnvironment.Name, opts.targetEnvironment.ManagerRoleARN, opts.targetEnvironment.Region)
 	if err != nil {
 		return fmt.Errorf("assuming environment manager role: %w", err)
 	}
@@ -283,11 +328,15 @@ func (opts *appDeployOpts) configureClients() error {
 	// ECR client against tools account profile AND target environment region
 	opts.ecrService = ecr.New(defaultSessEnvRegion)
 
+	// Deployed templates are uploaded to the project's bucket in the environment region so that they can be rolled back to.
+	opts.uploader = s3.New(defaultSessEnvRegion)
+	opts.identity = identity.New(defaultSessEnvRegion)
+
 	// app deploy CF client against env account profile AND target environment region
 	opts.appDeployCfClient = cloudformation.New(envSession)
 
 	// app package CF client against tools account
-	appPackageCfSess, err := session.Default()
+	appPackageCfSess, err := opts.sessionProvider().Default()
 	if err != nil {
 		return fmt.Errorf("create app package CF session: %w", err)
 	}
@@ -315,7 +364,65 @@ func (opts *appDeployOpts) sourceImageTag() error {
 	return nil
 }
 
+// sourceGitCommit records the commit of the workspace with a best effort, as it doesn't have to be a git repository.
+func (opts *appDeployOpts) sourceGitCommit() {
+	out, err := exec.Command("git", "rev-parse", "HEAD").Output()
+	if err != nil {
+		return
+	}
+	opts.gitCommit = strings.TrimSpace(string(out))
+}
+
 func (opts appDeployOpts) deployApp() error {
+	// Check the environment before pushing the image since the template can't be deployed to an outdated environment.
+	if err := validateEnvTemplateVersion(opts.targetEnvironment); err != nil {
+		return err
+	}
+	if !opts.dryRun {
+		if err := opts.pushImage(); err != nil {
+			return err
+		}
+	}
+
+	template, err := opts.getAppDeployTemplate()
+	if err != nil {
+		return err
+	}
+
+	// TODO move stack
+	stackName := stack.NameForApp(opts.ProjectName(), opts.targetEnvironment.Name, opts.app)
+	changeSetName := fmt.Sprintf("%s-%s", stackName, opts.imageTag)
+
+	// TODO Use the Tags() method defined in deploy/cloudformation/stack/lb_fargate_app.go
+	tags := map[string]string{
+		stack.ProjectTagKey: opts.ProjectName(),
+		stack.EnvTagKey:     opts.targetEnvironment.Name,
+		stack.AppTagKey:     opts.app,
+	}
+	if opts.diff || opts.dryRun {
+		return opts.deployWithReview(template, stackName, tags)
+	}
+
+	opts.spinner.Start(
+		fmt.Sprintf("Deploying %s to %s.",
+			fmt.Sprintf("%s:%s", color.HighlightUserInput(opts.app), color.HighlightUserInput(opts.imageTag)),
+			color.HighlightUserInput(opts.targetEnvironment.Name)))
+
+	if err := opts.applyAppDeployTemplate(template, stackName, changeSetName, opts.targetEnvironment.ExecutionRoleARN, tags); err != nil {
+		opts.spinner.Stop("Error!")
+		return err
+	}
+	opts.spinner.Stop("Done!")
+
+	log.Successf("Deployed %s to %s.\n",
+		fmt.Sprintf("%s:%s", color.HighlightUserInput(opts.app), color.HighlightUserInput(opts.imageTag)),
+		color.HighlightUserInput(opts.targetEnvironment.Name))
+	opts.recordRevision(template)
+
+	return nil
+}
+
+func (opts appDeployOpts) pushImage() error {
 	repoName := fmt.Sprintf("%s/%s", opts.projectName, opts.app)
 
 	uri, err := opts.ecrService.GetRepository(repoName)
@@ -338,44 +445,100 @@ func (opts appDeployOpts) deployApp() error {
 		return fmt.Errorf("get ECR auth data: %w", err)
 	}
 
-	opts.dockerService.Login(uri, auth)
+	if err := opts.dockerService.Login(uri, auth); err != nil {
+		return err
+	}
 
+	return opts.dockerService.Push(uri, opts.imageTag)
+}
+
+// deployWithReview creates a change set of the application's stack, renders its changes and applies them
+// only once the user confirms. In dry-run mode, the change set is deleted after its changes are rendered.
+func (opts appDeployOpts) deployWithReview(template, stackName string, tags map[string]string) error {
+	app := fmt.Sprintf("%s:%s", color.HighlightUserInput(opts.app), color.HighlightUserInput(opts.imageTag))
+	env := color.HighlightUserInput(opts.targetEnvironment.Name)
+
+	opts.spinner.Start(fmt.Sprintf(fmtAppChangeSetStart, app, env))
+	cs, err := opts.appDeployCfClient.CreateAppChangeSet(template, stackName, opts.targetEnvironment.ExecutionRoleARN, tags)
 	if err != nil {
-		return err
+		opts.spinner.Stop(log.Serrorf(fmtAppChangeSetFailed, app, env))
+		return fmt.Errorf("create change set of stack %s: %w", stackName, err)
+	}
+	opts.spinner.Stop(log.Ssuccessf(fmtAppChangeSetComplete, app, env))
+	if cs == nil {
+		log.Infof("No changes to deploy for %s in %s.\n", app, env)
+		return nil
 	}
 
-	if err = opts.dockerService.Push(uri, opts.imageTag); err != nil {
+	confirmed, err := reviewChangeSet(opts.w, opts.promptFor(yesFlag), opts.appDeployCfClient, cs,
+		fmt.Sprintf("Changes to %s", stackName),
+		fmt.Sprintf("Deploy these changes to %s?", env),
+		opts.dryRun, opts.skipConfirmation)
+	if err != nil {
 		return err
 	}
+	if !confirmed {
+		return nil
+	}
 
-	template, err := opts.getAppDeployTemplate()
-
-	// TODO move stack
-	stackName := fmt.Sprintf("%s-%s-%s", opts.ProjectName(), opts.targetEnvironment.Name, opts.app)
-	changeSetName := fmt.Sprintf("%s-%s", stackName, opts.imageTag)
-
-	opts.spinner.Start(
-		fmt.Sprintf("Deploying %s to %s.",
-			fmt.Sprintf("%s:%s", color.HighlightUserInput(opts.app), color.HighlightUserInput(opts.imageTag)),
-			color.HighlightUserInput(opts.targetEnvironment.Name)))
+	opts.spinner.Start(fmt.Sprintf("Deploying %s to %s.", app, env))
+	if err := opts.appDeployCfClient.ExecuteChangeSet(cs); err != nil {
+		opts.spinner.Stop(log.Serrorf("Failed to deploy %s to %s.", app, env))
+		return fmt.Errorf("deploy application: %w", err)
+	}
+	opts.spinner.Stop(log.Ssuccessf("Deployed %s to %s.", app, env))
+	opts.recordRevision(template)
+	return nil
+}
 
-	// TODO Use the Tags() method defined in deploy/cloudformation/stack/lb_fargate_app.go
-	tags := map[string]string{
-		stack.ProjectTagKey: opts.ProjectName(),
-		stack.EnvTagKey:     opts.targetEnvironment.Name,
-		stack.AppTagKey:     opts.app,
+// recordRevision records the deployment as a revision of the application in the environment, so that it can be
+// rolled back to. The deployment succeeded already, so a failure to record it is only reported.
+func (opts appDeployOpts) recordRevision(template string) {
+	rev, err := opts.newRevision(template)
+	if err == nil {
+		err = opts.revisionStore.CreateAppRevision(rev)
 	}
-	if err := opts.applyAppDeployTemplate(template, stackName, changeSetName, opts.targetEnvironment.ExecutionRoleARN, tags); err != nil {
-		opts.spinner.Stop("Error!")
-		return err
+	if err != nil {
+		log.Warningf("Couldn't record the deployment of %s, it can't be rolled back to: %v\n", color.HighlightUserInput(opts.app), err)
+		return
 	}
-	opts.spinner.Stop("Done!")
-
-	log.Successf("Deployed %s to %s.\n",
-		fmt.Sprintf("%s:%s", color.HighlightUserInput(opts.app), color.HighlightUserInput(opts.imageTag)),
-		color.HighlightUserInput(opts.targetEnvironment.Name))
+	log.Infof("Recorded the deployment as revision %s.\n", color.HighlightResource(strconv.Itoa(rev.Number)))
+}
 
-	return nil
+// newRevision uploads the template to the project's bucket and returns the revision describing the deployment.
+func (opts appDeployOpts) newRevision(template string) (*archer.AppRevision, error) {
+	project, err := opts.projectService.GetProject(opts.ProjectName())
+	if err != nil {
+		return nil, fmt.Errorf("get project %s: %w", opts.ProjectName(), err)
+	}
+	resources, err := opts.appPackageCfClient.GetProjectResourcesByRegion(project, opts.targetEnvironment.Region)
+	if err != nil {
+		return nil, fmt.Errorf("get project resources in region %s: %w", opts.targetEnvironment.Region, err)
+	}
+	key := fmt.Sprintf(fmtRevisionTemplateKey, opts.app, opts.targetEnvironment.Name, sha256.Sum256([]byte(template)))
+	url, err := opts.uploader.PutArtifact(resources.S3Bucket, key, strings.NewReader(template))
+	if err != nil {
+		return nil, fmt.Errorf("upload template: %w", err)
+	}
+	mf, err := opts.workspaceService.ReadFile(opts.workspaceService.AppManifestFileName(opts.app))
+	if err != nil {
+		return nil, fmt.Errorf("read manifest of application %s: %w", opts.app, err)
+	}
+	caller, err := opts.identity.Get()
+	if err != nil {
+		return nil, err
+	}
+	return &archer.AppRevision{
+		Project:      opts.ProjectName(),
+		App:          opts.app,
+		Env:          opts.targetEnvironment.Name,
+		ImageTag:     opts.imageTag,
+		ManifestHash: fmt.Sprintf("%x", sha256.Sum256(mf)),
+		GitCommit:    opts.gitCommit,
+		Actor:        caller.ARN,
+		DeployedAt:   time.Now().UTC(),
+		TemplateURL:  url,
+	}, nil
 }
 
 func (opts appDeployOpts) getAppDeployTemplate() (string, error) {
@@ -4,16 +4,20 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/identity"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/build/ecr"
 	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
 	"github.com/aws/amazon-ecs-cli-v2/mocks"
 )
 
@@ -297,14 +301,28 @@ func TestDeployApp(t *testing.T) {
 	tests := map[string]struct {
 		projectName string
 		app         string
+		envVersion  string
 
 		mockGetRepository func(t *testing.T, name string) (string, error)
 
 		want error
 	}{
+		"fails before pushing the image if the environment is outdated": {
+			projectName: mockProjectName,
+			app:         mockApp,
+			envVersion:  "v1.3.0",
+
+			mockGetRepository: func(t *testing.T, name string) (string, error) {
+				require.FailNow(t, "should not push the image")
+				return "", nil
+			},
+
+			want: &errOutdatedEnvTemplate{envName: "test", version: "v1.3.0"},
+		},
 		"wrap error returned from ECR GetRepository": {
 			projectName: mockProjectName,
 			app:         mockApp,
+			envVersion:  deploy.LatestEnvTemplateVersion,
 
 			mockGetRepository: func(t *testing.T, name string) (string, error) {
 				require.Equal(t, fmt.Sprintf("%s/%s", mockProjectName, mockApp), name)
@@ -322,7 +340,8 @@ func TestDeployApp(t *testing.T) {
 				GlobalOpts: &GlobalOpts{
 					projectName: test.projectName,
 				},
-				app: test.app,
+				app:               test.app,
+				targetEnvironment: &archer.Environment{Name: "test", TemplateVersion: test.envVersion},
 				ecrService: mockECRService{
 					t:                 t,
 					mockGetRepository: test.mockGetRepository,
@@ -335,3 +354,222 @@ func TestDeployApp(t *testing.T) {
 		})
 	}
 }
+
+func TestAppDeployOpts_deployWithReview(t *testing.T) {
+	mockError := errors.New("some error")
+	mockChangeSet := &deploy.ChangeSet{
+		StackName: "phonetool-test-api",
+		ID:        "changeset",
+		Changes: []*deploy.ResourceChange{
+			{
+				Resource: deploy.Resource{
+					LogicalName: "TaskDefinition",
+					Type:        "AWS::ECS::TaskDefinition",
+				},
+				Action:      "Modify",
+				Replacement: "True",
+				Properties:  []string{"Properties.ContainerDefinitions"},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		inDryRun           bool
+		inSkipConfirmation bool
+
+		setupMocks func(deployer *climocks.MockappStackDeployer, prompt *climocks.Mockprompter)
+
+		wantedErr    error
+		wantedOutput []string
+	}{
+		"wraps error from creating the change set": {
+			setupMocks: func(deployer *climocks.MockappStackDeployer, prompt *climocks.Mockprompter) {
+				deployer.EXPECT().CreateAppChangeSet("template", "phonetool-test-api", "execRole", gomock.Any()).Return(nil, mockError)
+			},
+			wantedErr: fmt.Errorf("create change set of stack phonetool-test-api: %w", mockError),
+		},
+		"does not prompt if there are no changes": {
+			setupMocks: func(deployer *climocks.MockappStackDeployer, prompt *climocks.Mockprompter) {
+				deployer.EXPECT().CreateAppChangeSet(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"executes the change set once confirmed": {
+			setupMocks: func(deployer *climocks.MockappStackDeployer, prompt *climocks.Mockprompter) {
+				gomock.InOrder(
+					deployer.EXPECT().CreateAppChangeSet(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockChangeSet, nil),
+					prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil),
+					deployer.EXPECT().ExecuteChangeSet(mockChangeSet).Return(nil),
+				)
+			},
+			wantedOutput: []string{"Modify", "TaskDefinition", "True", "Properties.ContainerDefinitions"},
+		},
+		"executes the change set without prompting if the confirmation is skipped": {
+			inSkipConfirmation: true,
+			setupMocks: func(deployer *climocks.MockappStackDeployer, prompt *climocks.Mockprompter) {
+				deployer.EXPECT().CreateAppChangeSet(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockChangeSet, nil)
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+				deployer.EXPECT().ExecuteChangeSet(mockChangeSet).Return(nil)
+			},
+			wantedOutput: []string{"AWS::ECS::TaskDefinition"},
+		},
+		"deletes the change set if the changes are declined": {
+			setupMocks: func(deployer *climocks.MockappStackDeployer, prompt *climocks.Mockprompter) {
+				gomock.InOrder(
+					deployer.EXPECT().CreateAppChangeSet(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockChangeSet, nil),
+					prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(false, nil),
+					deployer.EXPECT().DeleteChangeSet(mockChangeSet).Return(nil),
+				)
+				deployer.EXPECT().ExecuteChangeSet(gomock.Any()).Times(0)
+			},
+		},
+		"deletes the change set without prompting in dry-run mode": {
+			inDryRun: true,
+			setupMocks: func(deployer *climocks.MockappStackDeployer, prompt *climocks.Mockprompter) {
+				deployer.EXPECT().CreateAppChangeSet(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockChangeSet, nil)
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+				deployer.EXPECT().DeleteChangeSet(mockChangeSet).Return(nil)
+			},
+			wantedOutput: []string{"AWS::ECS::TaskDefinition"},
+		},
+		"wraps error from executing the change set": {
+			setupMocks: func(deployer *climocks.MockappStackDeployer, prompt *climocks.Mockprompter) {
+				deployer.EXPECT().CreateAppChangeSet(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockChangeSet, nil)
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil)
+				deployer.EXPECT().ExecuteChangeSet(mockChangeSet).Return(mockError)
+			},
+			wantedErr: fmt.Errorf("deploy application: %w", mockError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDeployer := climocks.NewMockappStackDeployer(ctrl)
+			mockPrompt := climocks.NewMockprompter(ctrl)
+			mockProjectService := climocks.NewMockprojectService(ctrl)
+			// Failing to record the revision of a successful deployment is only reported.
+			mockProjectService.EXPECT().GetProject("phonetool").Return(nil, mockError).AnyTimes()
+			mockSpinner := climocks.NewMockprogress(ctrl)
+			mockSpinner.EXPECT().Start(gomock.Any()).AnyTimes()
+			mockSpinner.EXPECT().Stop(gomock.Any()).AnyTimes()
+			tc.setupMocks(mockDeployer, mockPrompt)
+			b := &bytes.Buffer{}
+
+			opts := appDeployOpts{
+				GlobalOpts:        &GlobalOpts{projectName: "phonetool", prompt: mockPrompt},
+				app:               "api",
+				imageTag:          "latest",
+				dryRun:            tc.inDryRun,
+				skipConfirmation:  tc.inSkipConfirmation,
+				targetEnvironment: &archer.Environment{Name: "test", ExecutionRoleARN: "execRole"},
+				projectService:    mockProjectService,
+				appDeployCfClient: mockDeployer,
+				spinner:           mockSpinner,
+				w:                 b,
+			}
+
+			// WHEN
+			err := opts.deployWithReview("template", "phonetool-test-api", map[string]string{})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			for _, wanted := range tc.wantedOutput {
+				require.Contains(t, b.String(), wanted)
+			}
+		})
+	}
+}
+
+func TestAppDeployOpts_newRevision(t *testing.T) {
+	mockError := errors.New("some error")
+	project := &archer.Project{Name: "phonetool"}
+	resources := &archer.ProjectRegionalResources{Region: "us-west-2", S3Bucket: "phonetool-bucket"}
+
+	testCases := map[string]struct {
+		setupMocks func(ps *climocks.MockprojectService, rg *climocks.MockprojectResourcesGetter, u *climocks.MockartifactUploader,
+			ws *mocks.MockWorkspace, id *climocks.MockidentityService)
+
+		wantedRevision *archer.AppRevision
+		wantedErr      error
+	}{
+		"wraps error from uploading the template": {
+			setupMocks: func(ps *climocks.MockprojectService, rg *climocks.MockprojectResourcesGetter, u *climocks.MockartifactUploader,
+				ws *mocks.MockWorkspace, id *climocks.MockidentityService) {
+				ps.EXPECT().GetProject("phonetool").Return(project, nil)
+				rg.EXPECT().GetProjectResourcesByRegion(project, "us-west-2").Return(resources, nil)
+				u.EXPECT().PutArtifact("phonetool-bucket", gomock.Any(), gomock.Any()).Return("", mockError)
+			},
+			wantedErr: fmt.Errorf("upload template: %w", mockError),
+		},
+		"returns the revision of the deployment": {
+			setupMocks: func(ps *climocks.MockprojectService, rg *climocks.MockprojectResourcesGetter, u *climocks.MockartifactUploader,
+				ws *mocks.MockWorkspace, id *climocks.MockidentityService) {
+				ps.EXPECT().GetProject("phonetool").Return(project, nil)
+				rg.EXPECT().GetProjectResourcesByRegion(project, "us-west-2").Return(resources, nil)
+				u.EXPECT().PutArtifact("phonetool-bucket",
+					"revisions/api/test/5cde0f1298f41f7d1c8b907a36992a7a513225a2615bd6e307bf1a9149b06b40.yml", gomock.Any()).
+					Return("https://phonetool-bucket.s3-us-west-2.amazonaws.com/revisions/api/test/01ba.yml", nil)
+				ws.EXPECT().AppManifestFileName("api").Return("api-app.yml")
+				ws.EXPECT().ReadFile("api-app.yml").Return([]byte("name: api"), nil)
+				id.EXPECT().Get().Return(identity.Caller{ARN: "arn:aws:sts::123456789012:assumed-role/Admin/alice"}, nil)
+			},
+			wantedRevision: &archer.AppRevision{
+				Project:      "phonetool",
+				App:          "api",
+				Env:          "test",
+				ImageTag:     "v1.2.0",
+				ManifestHash: "6ced4ee40a5f34c8c36d290119fd1424f1aaaf6bfe945d85bb4850c25547ede7",
+				GitCommit:    "6b1b8e4",
+				Actor:        "arn:aws:sts::123456789012:assumed-role/Admin/alice",
+				TemplateURL:  "https://phonetool-bucket.s3-us-west-2.amazonaws.com/revisions/api/test/01ba.yml",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockProjectService := climocks.NewMockprojectService(ctrl)
+			mockResourcesGetter := climocks.NewMockprojectResourcesGetter(ctrl)
+			mockUploader := climocks.NewMockartifactUploader(ctrl)
+			mockWs := mocks.NewMockWorkspace(ctrl)
+			mockIdentity := climocks.NewMockidentityService(ctrl)
+			tc.setupMocks(mockProjectService, mockResourcesGetter, mockUploader, mockWs, mockIdentity)
+
+			opts := appDeployOpts{
+				GlobalOpts:         &GlobalOpts{projectName: "phonetool"},
+				app:                "api",
+				imageTag:           "v1.2.0",
+				gitCommit:          "6b1b8e4",
+				targetEnvironment:  &archer.Environment{Name: "test", Region: "us-west-2"},
+				projectService:     mockProjectService,
+				workspaceService:   mockWs,
+				appPackageCfClient: mockResourcesGetter,
+				uploader:           mockUploader,
+				identity:           mockIdentity,
+			}
+
+			// WHEN
+			rev, err := opts.newRevision("template")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.WithinDuration(t, time.Now(), rev.DeployedAt, time.Minute)
+			rev.DeployedAt = time.Time{}
+			require.Equal(t, tc.wantedRevision, rev)
+		})
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/ecs"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+const defaultExecCommand = "/bin/sh"
+
+type runningTaskLister interface {
+	RunningTasks(cluster, serviceName string) ([]*ecs.Task, error)
+}
+
+type commandExecutor interface {
+	ExecuteCommand(in *ecs.ExecuteCommandInput) error
+}
+
+// ExecAppOpts holds the configuration needed to start an interactive session in a task of an application.
+type ExecAppOpts struct {
+	// Fields with matching flags.
+	AppName   string
+	EnvName   string
+	TaskID    string
+	Container string
+	Command   string
+
+	// Interfaces to interact with dependencies.
+	appLister  archer.ApplicationLister
+	envStore   archer.EnvironmentStore
+	describer  appTaskDescriber
+	taskLister runningTaskLister
+	executor   commandExecutor
+
+	*GlobalOpts
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (opts *ExecAppOpts) Ask() error {
+	if opts.AppName == "" {
+		name, err := selectApplication(opts.promptFor(appFlag), opts.appLister, opts.ProjectName(),
+			"Which application would you like to start a session in?",
+			"The session starts in a running task of the application's service.")
+		if err != nil {
+			return err
+		}
+		opts.AppName = name
+	}
+	if opts.EnvName == "" {
+		name, err := selectEnvironment(opts.promptFor(envFlag), opts.envStore, opts.ProjectName(),
+			fmt.Sprintf("Which environment of %s is the task running in?", opts.AppName),
+			"ECS Exec must be enabled for the application in this environment.")
+		if err != nil {
+			return err
+		}
+		opts.EnvName = name
+	}
+	return nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (opts *ExecAppOpts) Validate() error {
+	if opts.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	return nil
+}
+
+// Execute starts an interactive session in the container of a running task, selecting the task if none is passed in.
+func (opts *ExecAppOpts) Execute() error {
+	env, err := opts.envStore.GetEnvironment(opts.ProjectName(), opts.EnvName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", opts.EnvName, err)
+	}
+	if err := opts.initClients(env); err != nil {
+		return err
+	}
+
+	appTask, err := opts.describer.Describe(opts.ProjectName(), opts.EnvName, opts.AppName)
+	if err != nil {
+		return fmt.Errorf("describe tasks of application %s in environment %s: %w", opts.AppName, opts.EnvName, err)
+	}
+	if opts.TaskID == "" {
+		id, err := opts.selectTask(appTask)
+		if err != nil {
+			return err
+		}
+		opts.TaskID = id
+	}
+	if opts.Container == "" {
+		opts.Container = opts.AppName
+	}
+	if opts.Command == "" {
+		opts.Command = defaultExecCommand
+	}
+
+	log.Infof("Starting a session in container %s of task %s.\n", color.HighlightUserInput(opts.Container), color.HighlightResource(opts.TaskID))
+	return opts.executor.ExecuteCommand(&ecs.ExecuteCommandInput{
+		Cluster:   appTask.Cluster,
+		Task:      opts.TaskID,
+		Container: opts.Container,
+		Command:   opts.Command,
+	})
+}
+
+// selectTask returns the ID of a running task of the application's service.
+// If the service runs a single task, it is selected without prompting.
+func (opts *ExecAppOpts) selectTask(appTask *describe.AppTask) (string, error) {
+	tasks, err := opts.taskLister.RunningTasks(appTask.Cluster, appTask.Service)
+	if err != nil {
+		return "", err
+	}
+	if len(tasks) == 0 {
+		return "", fmt.Errorf("no running tasks found for application %s in environment %s", opts.AppName, opts.EnvName)
+	}
+	if len(tasks) == 1 {
+		return tasks[0].ID, nil
+	}
+	var ids []string
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+	id, err := opts.promptFor(taskFlag).SelectOne(
+		fmt.Sprintf("Which task of %s would you like to start a session in?", color.HighlightUserInput(opts.AppName)),
+		"The IDs of the running tasks of the application's service.",
+		ids)
+	if err != nil {
+		return "", fmt.Errorf("select task: %w", err)
+	}
+	return id, nil
+}
+
+func (opts *ExecAppOpts) initClients(env *archer.Environment) error {
+	if opts.executor != nil {
+		// Tests mock the clients.
+		return nil
+	}
+	sess, err := opts.sessionProvider().FromEnvRole(env.Name, env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return fmt.Errorf("create session from role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	opts.describer = describe.NewAppTaskDescriber(sess)
+	opts.taskLister = ecs.New(sess)
+	opts.executor = ecs.NewCommandExecutor(sess)
+	return nil
+}
+
+// BuildAppExecCmd builds the command for starting an interactive session in a task of an application.
+func BuildAppExecCmd() *cobra.Command {
+	opts := &ExecAppOpts{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "exec",
+		Short: "Starts an interactive session in a running task of an application.",
+		Long: `Starts an interactive session in a container of a running task of an application with ECS Exec.
+ECS Exec is enabled with the "exec" field of the manifest, and is on by default in non-production environments.
+The AWS CLI and the Session Manager plugin must be installed.`,
+		Example: `
+  Opens a shell in a task of the "api" application in the "test" environment.
+  /code $ archer app exec --app api --env test
+  Runs a command in the sidecar container of a task.
+  /code $ archer app exec -a api -e test --task 8d3f0e4c2b --container proxy --command "cat /etc/envoy.yaml"`,
+		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			store, err := store.New()
+			if err != nil {
+				return fmt.Errorf("connect to ecs-cli metadata store: %w", err)
+			}
+			opts.appLister = store
+			opts.envStore = store
+			return opts.Validate()
+		}),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&opts.AppName, appFlag, appFlagShort, "", appFlagDescription)
+	cmd.Flags().StringVarP(&opts.EnvName, envFlag, envFlagShort, "", envFlagDescription)
+	markFlagCompletion(cmd.Flags(), appFlag, completeApps)
+	markFlagCompletion(cmd.Flags(), envFlag, completeEnvs)
+	cmd.Flags().StringVar(&opts.TaskID, taskFlag, "", execTaskFlagDescription)
+	cmd.Flags().StringVar(&opts.Container, containerFlag, "", containerFlagDescription)
+	cmd.Flags().StringVar(&opts.Command, commandFlag, "", execCommandFlagDescription)
+	return cmd
+}
@@ -0,0 +1,159 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/ecs"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/aws/amazon-ecs-cli-v2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecAppOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inProjectName string
+
+		wantedErr error
+	}{
+		"returns error if there is no project": {
+			wantedErr: errNoProjectInWorkspace,
+		},
+		"valid flags": {
+			inProjectName: "phonetool",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &ExecAppOpts{
+				GlobalOpts: &GlobalOpts{projectName: tc.inProjectName},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			require.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
+func TestExecAppOpts_Execute(t *testing.T) {
+	testEnv := &archer.Environment{Project: "phonetool", Name: "test", Region: "us-west-2"}
+	appTask := &describe.AppTask{
+		Cluster: "phonetool-test-Cluster",
+		Service: "phonetool-test-api-Service",
+	}
+	mockErr := errors.New("some error")
+
+	testCases := map[string]struct {
+		inTaskID    string
+		inContainer string
+		inCommand   string
+
+		setupMocks func(lister *climocks.MockrunningTaskLister, executor *climocks.MockcommandExecutor, prompt *climocks.Mockprompter)
+
+		wantedErr error
+	}{
+		"returns error if the service has no running tasks": {
+			setupMocks: func(lister *climocks.MockrunningTaskLister, executor *climocks.MockcommandExecutor, prompt *climocks.Mockprompter) {
+				lister.EXPECT().RunningTasks("phonetool-test-Cluster", "phonetool-test-api-Service").Return(nil, nil)
+				executor.EXPECT().ExecuteCommand(gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("no running tasks found for application api in environment test"),
+		},
+		"selects the only running task without prompting": {
+			setupMocks: func(lister *climocks.MockrunningTaskLister, executor *climocks.MockcommandExecutor, prompt *climocks.Mockprompter) {
+				lister.EXPECT().RunningTasks("phonetool-test-Cluster", "phonetool-test-api-Service").Return([]*ecs.Task{{ID: "abc123"}}, nil)
+				prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				executor.EXPECT().ExecuteCommand(&ecs.ExecuteCommandInput{
+					Cluster:   "phonetool-test-Cluster",
+					Task:      "abc123",
+					Container: "api",
+					Command:   "/bin/sh",
+				}).Return(nil)
+			},
+		},
+		"prompts for the task if several are running": {
+			setupMocks: func(lister *climocks.MockrunningTaskLister, executor *climocks.MockcommandExecutor, prompt *climocks.Mockprompter) {
+				lister.EXPECT().RunningTasks("phonetool-test-Cluster", "phonetool-test-api-Service").Return([]*ecs.Task{{ID: "abc123"}, {ID: "def456"}}, nil)
+				prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), []string{"abc123", "def456"}).Return("def456", nil)
+				executor.EXPECT().ExecuteCommand(&ecs.ExecuteCommandInput{
+					Cluster:   "phonetool-test-Cluster",
+					Task:      "def456",
+					Container: "api",
+					Command:   "/bin/sh",
+				}).Return(nil)
+			},
+		},
+		"wraps error from the prompt": {
+			setupMocks: func(lister *climocks.MockrunningTaskLister, executor *climocks.MockcommandExecutor, prompt *climocks.Mockprompter) {
+				lister.EXPECT().RunningTasks(gomock.Any(), gomock.Any()).Return([]*ecs.Task{{ID: "abc123"}, {ID: "def456"}}, nil)
+				prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).Return("", mockErr)
+			},
+			wantedErr: errors.New("select task: some error"),
+		},
+		"uses the task, container and command passed in": {
+			inTaskID:    "abc123",
+			inContainer: "proxy",
+			inCommand:   "cat /etc/envoy.yaml",
+			setupMocks: func(lister *climocks.MockrunningTaskLister, executor *climocks.MockcommandExecutor, prompt *climocks.Mockprompter) {
+				lister.EXPECT().RunningTasks(gomock.Any(), gomock.Any()).Times(0)
+				executor.EXPECT().ExecuteCommand(&ecs.ExecuteCommandInput{
+					Cluster:   "phonetool-test-Cluster",
+					Task:      "abc123",
+					Container: "proxy",
+					Command:   "cat /etc/envoy.yaml",
+				}).Return(mockErr)
+			},
+			wantedErr: mockErr,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockEnvStore := mocks.NewMockEnvironmentStore(ctrl)
+			mockEnvStore.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+			mockDescriber := climocks.NewMockappTaskDescriber(ctrl)
+			mockDescriber.EXPECT().Describe("phonetool", "test", "api").Return(appTask, nil)
+			mockLister := climocks.NewMockrunningTaskLister(ctrl)
+			mockExecutor := climocks.NewMockcommandExecutor(ctrl)
+			mockPrompt := climocks.NewMockprompter(ctrl)
+			tc.setupMocks(mockLister, mockExecutor, mockPrompt)
+
+			opts := &ExecAppOpts{
+				AppName:    "api",
+				EnvName:    "test",
+				TaskID:     tc.inTaskID,
+				Container:  tc.inContainer,
+				Command:    tc.inCommand,
+				envStore:   mockEnvStore,
+				describer:  mockDescriber,
+				taskLister: mockLister,
+				executor:   mockExecutor,
+				GlobalOpts: &GlobalOpts{projectName: "phonetool", prompt: mockPrompt},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/output"
+	"github.com/spf13/cobra"
+)
+
+// HistoryAppOpts holds the configuration needed to list the revisions of an application in an environment.
+type HistoryAppOpts struct {
+	// Fields with matching flags.
+	AppName          string
+	EnvName          string
+	ShouldOutputJSON bool
+	OutputFormat     string
+
+	// Interfaces to interact with dependencies.
+	appLister      archer.ApplicationLister
+	envLister      archer.EnvironmentLister
+	revisionLister archer.AppRevisionLister
+	w              io.Writer
+
+	*GlobalOpts
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (opts *HistoryAppOpts) Ask() error {
+	if opts.AppName == "" {
+		name, err := selectApplication(opts.promptFor(nameFlag), opts.appLister, opts.ProjectName(),
+			"Which application's deployments would you like to list?",
+			"The recent deployments of the application are listed.")
+		if err != nil {
+			return err
+		}
+		opts.AppName = name
+	}
+	if opts.EnvName == "" {
+		name, err := selectEnvironment(opts.promptFor(envFlag), opts.envLister, opts.ProjectName(),
+			fmt.Sprintf("Which environment's deployments of %s would you like to list?", opts.AppName),
+			"The revisions of the application in the environment are listed.")
+		if err != nil {
+			return err
+		}
+		opts.EnvName = name
+	}
+	return nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (opts *HistoryAppOpts) Validate() error {
+	if opts.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	if err := output.Validate(outputFormat(opts.OutputFormat, opts.ShouldOutputJSON)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Execute writes the recent revisions of the application in the environment, from the most recent.
+func (opts *HistoryAppOpts) Execute() error {
+	revisions, err := opts.revisionLister.ListAppRevisions(opts.ProjectName(), opts.EnvName, opts.AppName)
+	if err != nil {
+		return err
+	}
+	if err := output.Write(opts.w, outputFormat(opts.OutputFormat, opts.ShouldOutputJSON), revisionList{Revisions: revisions}); err != nil {
+		return fmt.Errorf("write revisions of application %s: %w", opts.AppName, err)
+	}
+	return nil
+}
+
+// revisionList is the output of "app history".
+// Its JSON and YAML schema is {"revisions": [...]} with the fields of archer.AppRevision.
+type revisionList struct {
+	Revisions []*archer.AppRevision `json:"revisions"`
+}
+
+// Header returns the columns of the revisions table.
+func (l revisionList) Header() []string {
+	return []string{"Revision", "Image Tag", "Git Commit", "Deployed By", "Deployed At", "Note"}
+}
+
+// Rows returns one row per revision.
+func (l revisionList) Rows() [][]string {
+	var rows [][]string
+	for _, rev := range l.Revisions {
+		note := "-"
+		if rev.RollbackOf != 0 {
+			note = fmt.Sprintf("Rollback to revision %d", rev.RollbackOf)
+		}
+		rows = append(rows, []string{strconv.Itoa(rev.Number), rev.ImageTag, valueOrDash(shortCommit(rev.GitCommit)),
+			rev.Actor, rev.DeployedAt.Local().Format(time.RFC3339), note})
+	}
+	return rows
+}
+
+func shortCommit(commit string) string {
+	const shortCommitLen = 7
+	if len(commit) > shortCommitLen {
+		return commit[:shortCommitLen]
+	}
+	return commit
+}
+
+// BuildAppHistoryCmd builds the command for listing the revisions of an application in an environment.
+func BuildAppHistoryCmd() *cobra.Command {
+	opts := &HistoryAppOpts{
+		w:          os.Stdout,
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Lists the recent deployments of an application.",
+		Long: `Lists the recent deployments of an application in an environment, from the most recent.
+Each successful deployment is recorded as a revision that the application can be rolled back to.`,
+		Example: `
+  Lists the deployments of the "frontend" application in the "prod" environment.
+  /code $ archer app history --name frontend --env prod`,
+		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			store, err := store.New()
+			if err != nil {
+				return fmt.Errorf("connect to ecs-cli metadata store: %w", err)
+			}
+			opts.appLister = store
+			opts.envLister = store
+			opts.revisionLister = store
+			return opts.Validate()
+		}),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&opts.AppName, nameFlag, nameFlagShort, "", appFlagDescription)
+	cmd.Flags().StringVarP(&opts.EnvName, envFlag, envFlagShort, "", envFlagDescription)
+	markFlagCompletion(cmd.Flags(), nameFlag, completeApps)
+	markFlagCompletion(cmd.Flags(), envFlag, completeEnvs)
+	addOutputFlags(cmd, &opts.OutputFormat, &opts.ShouldOutputJSON)
+	return cmd
+}
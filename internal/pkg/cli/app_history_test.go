@@ -0,0 +1,106 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryAppOpts_Execute(t *testing.T) {
+	revisions := []*archer.AppRevision{
+		{
+			Number:     4,
+			ImageTag:   "v1.1.0",
+			Actor:      "arn:aws:iam::123456789012:user/alice",
+			DeployedAt: time.Date(2020, 3, 2, 10, 0, 0, 0, time.UTC),
+			RollbackOf: 2,
+		},
+		{
+			Number:     3,
+			ImageTag:   "v1.2.0",
+			GitCommit:  "6b1b8e4f0c3a9d2e",
+			Actor:      "arn:aws:iam::123456789012:user/bob",
+			DeployedAt: time.Date(2020, 3, 1, 10, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := map[string]struct {
+		inJSON bool
+
+		setupMocks func(lister *mocks.MockAppRevisionLister)
+
+		wantedContent []string
+		wantedErr     error
+	}{
+		"writes the revisions as a table": {
+			setupMocks: func(lister *mocks.MockAppRevisionLister) {
+				lister.EXPECT().ListAppRevisions("phonetool", "prod", "api").Return(revisions, nil)
+			},
+			wantedContent: []string{
+				"Revision",
+				"v1.1.0",
+				"Rollback to revision 2",
+				"6b1b8e4 ",
+				"arn:aws:iam::123456789012:user/bob",
+			},
+		},
+		"writes the revisions in JSON": {
+			inJSON: true,
+			setupMocks: func(lister *mocks.MockAppRevisionLister) {
+				lister.EXPECT().ListAppRevisions("phonetool", "prod", "api").Return(revisions[1:], nil)
+			},
+			wantedContent: []string{
+				`{"revisions":[{"project":"","app":"","env":"","number":3,"imageTag":"v1.2.0"`,
+				`"gitCommit":"6b1b8e4f0c3a9d2e"`,
+			},
+		},
+		"returns error from listing the revisions": {
+			setupMocks: func(lister *mocks.MockAppRevisionLister) {
+				lister.EXPECT().ListAppRevisions("phonetool", "prod", "api").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockLister := mocks.NewMockAppRevisionLister(ctrl)
+			tc.setupMocks(mockLister)
+			b := &bytes.Buffer{}
+
+			opts := &HistoryAppOpts{
+				AppName:          "api",
+				EnvName:          "prod",
+				ShouldOutputJSON: tc.inJSON,
+				revisionLister:   mockLister,
+				w:                b,
+				GlobalOpts:       &GlobalOpts{projectName: "phonetool"},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			for _, wanted := range tc.wantedContent {
+				require.Contains(t, b.String(), wanted)
+			}
+		})
+	}
+}
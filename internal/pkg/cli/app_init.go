@@ -12,7 +12,6 @@ import (
 	"strings"
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
-	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/session"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
@@ -162,7 +161,7 @@ func (opts *InitAppOpts) createAppInProject(projectName string) error {
 }
 
 func (opts *InitAppOpts) askAppType() error {
-	t, err := opts.prompt.SelectOne(
+	t, err := opts.promptFor(appTypeFlag).SelectOne(
 		"Which type of infrastructure pattern best represents your application?",
 		`Your application's architecture. Most applications need additional AWS resources to run.
 To help setup the infrastructure resources, select what "kind" or "type" of application you want to build.`,
@@ -176,7 +175,7 @@ To help setup the infrastructure resources, select what "kind" or "type" of appl
 }
 
 func (opts *InitAppOpts) askAppName() error {
-	name, err := opts.prompt.Get(
+	name, err := opts.promptFor(nameFlag).Get(
 		fmt.Sprintf("What do you want to call this %s?", opts.AppType),
 		fmt.Sprintf(`The name will uniquely identify this application within your %s project.
 Deployed resources (such as your service, logs) will contain this app's name and be tagged with it.`, opts.ProjectName()),
@@ -197,7 +196,7 @@ func (opts *InitAppOpts) askDockerfile() error {
 		return err
 	}
 
-	sel, err := opts.prompt.SelectOne(
+	sel, err := opts.promptFor(dockerFileFlag).SelectOne(
 		fmt.Sprintf("Which Dockerfile would you like to use for %s app?", opts.AppName),
 		"Dockerfile to use for building your application's container image.",
 		dockerfiles,
@@ -302,6 +301,7 @@ This command is also run as part of "archer init".`,
   /code $ archer app init --name frontend --app-type "Load Balanced Web App" --dockerfile ./frontend/Dockerfile`,
 		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts.fs = &afero.Afero{Fs: afero.NewOsFs()}
+			opts.GlobalOpts = NewGlobalOpts()
 
 			store, err := store.New()
 			if err != nil {
@@ -316,14 +316,13 @@ This command is also run as part of "archer init".`,
 			}
 			opts.manifestWriter = ws
 
-			sess, err := session.Default()
+			sess, err := opts.sessionProvider().Default()
 			if err != nil {
 				return err
 			}
 			opts.projDeployer = cloudformation.New(sess)
 
 			opts.prog = termprogress.NewSpinner()
-			opts.GlobalOpts = NewGlobalOpts()
 			return opts.Validate()
 		}),
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
@@ -0,0 +1,242 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/output"
+	"github.com/spf13/cobra"
+)
+
+const (
+	fmtAppLogGroupName        = "/ecs/%s-%s-%s" // The log group of an application is named after its project, env and app.
+	fmtAppLogStreamNamePrefix = "ecs/%s/%s"     // The log streams of a task are prefixed with "ecs/<container name>/<task ID>".
+	logsFollowInterval        = 2 * time.Second
+	defaultLogsSince          = 10 * time.Minute // Bounds the events fetched from log groups with a long history.
+	shortTaskIDLength         = 8
+)
+
+type logEventsGetter interface {
+	LogEvents(in *cloudwatchlogs.LogEventsInput) ([]*cloudwatchlogs.Event, error)
+}
+
+// AppLogsOpts holds the configuration needed to show the logs of an application.
+type AppLogsOpts struct {
+	// Fields with matching flags.
+	AppName          string
+	EnvName          string
+	Follow           bool
+	Since            time.Duration
+	FilterPattern    string
+	TaskID           string
+	OutputFormat     string
+	ShouldOutputJSON bool
+
+	// Interfaces to interact with dependencies.
+	appLister archer.ApplicationLister
+	envStore  archer.EnvironmentStore
+	logsSvc   logEventsGetter
+	w         io.Writer
+
+	followInterval time.Duration
+	now            func() time.Time
+	taskIndexes    map[string]int // Order in which each task ID was first printed, to color its events.
+
+	*GlobalOpts
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (opts *AppLogsOpts) Ask() error {
+	if opts.AppName == "" {
+		if err := opts.askAppName(); err != nil {
+			return err
+		}
+	}
+	if opts.EnvName == "" {
+		if err := opts.askEnvName(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (opts *AppLogsOpts) Validate() error {
+	if opts.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	if opts.Since <= 0 {
+		return fmt.Errorf("--%s must be a positive duration", sinceFlag)
+	}
+	if err := output.Validate(outputFormat(opts.OutputFormat, opts.ShouldOutputJSON)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Execute prints the log events of the application in the environment.
+// If the follow flag is set, it keeps polling for new events until an error occurs.
+func (opts *AppLogsOpts) Execute() error {
+	env, err := opts.envStore.GetEnvironment(opts.ProjectName(), opts.EnvName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", opts.EnvName, err)
+	}
+	if opts.logsSvc == nil {
+		// Tests mock the client.
+		sess, err := opts.sessionProvider().FromEnvRole(env.Name, env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return fmt.Errorf("create session from role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		opts.logsSvc = cloudwatchlogs.New(sess)
+	}
+
+	in := &cloudwatchlogs.LogEventsInput{
+		LogGroupName:  fmt.Sprintf(fmtAppLogGroupName, opts.ProjectName(), opts.EnvName, opts.AppName),
+		FilterPattern: opts.FilterPattern,
+	}
+	if opts.TaskID != "" {
+		in.LogStreamNamePrefix = fmt.Sprintf(fmtAppLogStreamNamePrefix, opts.AppName, opts.TaskID)
+	}
+	in.StartTime = opts.now().Add(-opts.Since).UnixNano() / int64(time.Millisecond)
+
+	// IDs of the events already printed at the latest timestamp, since polling from
+	// that timestamp again returns them.
+	printed := make(map[string]bool)
+	for {
+		events, err := opts.logsSvc.LogEvents(in)
+		if err != nil {
+			return fmt.Errorf("get logs of application %s in environment %s: %w", opts.AppName, opts.EnvName, err)
+		}
+		for _, event := range events {
+			if printed[event.ID] {
+				continue
+			}
+			if err := opts.printEvent(event); err != nil {
+				return err
+			}
+			if event.Timestamp > in.StartTime {
+				in.StartTime = event.Timestamp
+				printed = make(map[string]bool)
+			}
+			printed[event.ID] = true
+		}
+		if !opts.Follow {
+			return nil
+		}
+		time.Sleep(opts.followInterval)
+	}
+}
+
+func (opts *AppLogsOpts) printEvent(event *cloudwatchlogs.Event) error {
+	switch format := outputFormat(opts.OutputFormat, opts.ShouldOutputJSON); format {
+	case output.TableFormat:
+		// Events are printed as they arrive, so the human format is one line per event instead of a table.
+	case output.YAMLFormat:
+		// Each event is written as an item of a list so that the printed events form a single YAML document.
+		return opts.writeEvent(format, []*cloudwatchlogs.Event{event})
+	default:
+		return opts.writeEvent(format, event)
+	}
+	taskID := event.TaskID
+	if len(taskID) > shortTaskIDLength {
+		taskID = taskID[:shortTaskIDLength]
+	}
+	fmt.Fprintf(opts.w, "%s %s\n", color.Rotate(opts.taskIndex(event.TaskID), fmt.Sprintf("%s/%s", opts.AppName, taskID)), event.Message)
+	return nil
+}
+
+func (opts *AppLogsOpts) writeEvent(format string, data interface{}) error {
+	if err := output.Write(opts.w, format, data); err != nil {
+		return fmt.Errorf("write log event: %w", err)
+	}
+	return nil
+}
+
+// taskIndex returns the order in which the task was first printed, assigning the next index to new tasks.
+func (opts *AppLogsOpts) taskIndex(taskID string) int {
+	if opts.taskIndexes == nil {
+		opts.taskIndexes = make(map[string]int)
+	}
+	i, ok := opts.taskIndexes[taskID]
+	if !ok {
+		i = len(opts.taskIndexes)
+		opts.taskIndexes[taskID] = i
+	}
+	return i
+}
+
+func (opts *AppLogsOpts) askAppName() error {
+	name, err := selectApplication(opts.promptFor(nameFlag), opts.appLister, opts.ProjectName(),
+		"Which application's logs would you like to show?",
+		"The logs of the application's tasks are shown.")
+	if err != nil {
+		return err
+	}
+	opts.AppName = name
+	return nil
+}
+
+func (opts *AppLogsOpts) askEnvName() error {
+	name, err := selectEnvironment(opts.promptFor(envFlag), opts.envStore, opts.ProjectName(),
+		fmt.Sprintf("Which environment of %s would you like to show logs from?", opts.AppName),
+		"The logs of the application's tasks running in this environment are shown.")
+	if err != nil {
+		return err
+	}
+	opts.EnvName = name
+	return nil
+}
+
+// BuildAppLogsCmd builds the command for showing the logs of an application.
+func BuildAppLogsCmd() *cobra.Command {
+	opts := &AppLogsOpts{
+		w:              os.Stdout,
+		followInterval: logsFollowInterval,
+		now:            time.Now,
+		GlobalOpts:     NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Displays logs of an application.",
+		Long:  "Displays the logs of the tasks of an application in an environment, from the last 10 minutes unless --since is set.",
+		Example: `
+  Displays the logs of the "frontend" application in the "test" environment.
+  /code $ archer app logs --name frontend --env test
+  Follows the errors of the last hour.
+  /code $ archer app logs --name frontend --env test --since 1h --filter ERROR --follow`,
+		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			store, err := store.New()
+			if err != nil {
+				return fmt.Errorf("couldn't connect to project datastore: %w", err)
+			}
+			opts.appLister = store
+			opts.envStore = store
+			return opts.Validate()
+		}),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&opts.AppName, nameFlag, nameFlagShort, "", appFlagDescription)
+	cmd.Flags().StringVarP(&opts.EnvName, envFlag, envFlagShort, "", envFlagDescription)
+	markFlagCompletion(cmd.Flags(), nameFlag, completeApps)
+	markFlagCompletion(cmd.Flags(), envFlag, completeEnvs)
+	cmd.Flags().BoolVar(&opts.Follow, followFlag, false, followFlagDescription)
+	cmd.Flags().DurationVar(&opts.Since, sinceFlag, defaultLogsSince, sinceFlagDescription)
+	cmd.Flags().StringVar(&opts.FilterPattern, filterFlag, "", filterFlagDescription)
+	cmd.Flags().StringVar(&opts.TaskID, taskFlag, "", taskFlagDescription)
+	addOutputFlags(cmd, &opts.OutputFormat, &opts.ShouldOutputJSON)
+	return cmd
+}
@@ -0,0 +1,302 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/cloudwatchlogs"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppLogsOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName string
+		inEnvName string
+
+		mockAppLister func(m *mocks.MockApplicationLister)
+		mockEnvStore  func(m *mocks.MockEnvironmentStore)
+		mockPrompt    func(m *climocks.Mockprompter)
+
+		wantedAppName string
+		wantedEnvName string
+		wantedErr     error
+	}{
+		"returns an error if there are no applications": {
+			mockAppLister: func(m *mocks.MockApplicationLister) {
+				m.EXPECT().ListApplications("phonetool").Return(nil, nil)
+			},
+			mockEnvStore: func(m *mocks.MockEnvironmentStore) {},
+			mockPrompt:   func(m *climocks.Mockprompter) {},
+			wantedErr:    errNoAppsInProject,
+		},
+		"selects the only application and environment": {
+			mockAppLister: func(m *mocks.MockApplicationLister) {
+				m.EXPECT().ListApplications("phonetool").Return([]*archer.Application{{Name: "frontend"}}, nil)
+			},
+			mockEnvStore: func(m *mocks.MockEnvironmentStore) {
+				m.EXPECT().ListEnvironments("phonetool").Return([]*archer.Environment{{Name: "test"}}, nil)
+			},
+			mockPrompt:    func(m *climocks.Mockprompter) {},
+			wantedAppName: "frontend",
+			wantedEnvName: "test",
+		},
+		"prompts for the application and environment": {
+			mockAppLister: func(m *mocks.MockApplicationLister) {
+				m.EXPECT().ListApplications("phonetool").Return([]*archer.Application{{Name: "frontend"}, {Name: "backend"}}, nil)
+			},
+			mockEnvStore: func(m *mocks.MockEnvironmentStore) {
+				m.EXPECT().ListEnvironments("phonetool").Return([]*archer.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+			},
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().SelectOne("Which application's logs would you like to show?", gomock.Any(), []string{"frontend", "backend"}).
+					Return("backend", nil)
+				m.EXPECT().SelectOne("Which environment of backend would you like to show logs from?", gomock.Any(), []string{"test", "prod"}).
+					Return("prod", nil)
+			},
+			wantedAppName: "backend",
+			wantedEnvName: "prod",
+		},
+		"wraps error if environments cannot be listed": {
+			inAppName:     "frontend",
+			mockAppLister: func(m *mocks.MockApplicationLister) {},
+			mockEnvStore: func(m *mocks.MockEnvironmentStore) {
+				m.EXPECT().ListEnvironments("phonetool").Return(nil, errors.New("some error"))
+			},
+			mockPrompt: func(m *climocks.Mockprompter) {},
+			wantedErr:  errors.New("list environments in project phonetool: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAppLister := mocks.NewMockApplicationLister(ctrl)
+			mockEnvStore := mocks.NewMockEnvironmentStore(ctrl)
+			mockPrompt := climocks.NewMockprompter(ctrl)
+			tc.mockAppLister(mockAppLister)
+			tc.mockEnvStore(mockEnvStore)
+			tc.mockPrompt(mockPrompt)
+
+			opts := &AppLogsOpts{
+				AppName:   tc.inAppName,
+				EnvName:   tc.inEnvName,
+				appLister: mockAppLister,
+				envStore:  mockEnvStore,
+				GlobalOpts: &GlobalOpts{
+					projectName: "phonetool",
+					prompt:      mockPrompt,
+				},
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedAppName, opts.AppName)
+			require.Equal(t, tc.wantedEnvName, opts.EnvName)
+		})
+	}
+}
+
+func TestAppLogsOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inProjectName string
+		inSince       time.Duration
+		inFormat      string
+
+		wantedErr error
+	}{
+		"no project in workspace": {
+			wantedErr: errNoProjectInWorkspace,
+		},
+		"negative duration": {
+			inProjectName: "phonetool",
+			inSince:       -time.Hour,
+			wantedErr:     errors.New("--since must be a positive duration"),
+		},
+		"zero duration": {
+			inProjectName: "phonetool",
+			wantedErr:     errors.New("--since must be a positive duration"),
+		},
+		"unsupported output format": {
+			inProjectName: "phonetool",
+			inSince:       time.Hour,
+			inFormat:      "xml",
+			wantedErr:     errors.New("output format xml is not supported, must be one of table, json, yaml"),
+		},
+		"valid flags": {
+			inProjectName: "phonetool",
+			inSince:       time.Hour,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &AppLogsOpts{
+				Since:        tc.inSince,
+				OutputFormat: tc.inFormat,
+				GlobalOpts:   &GlobalOpts{projectName: tc.inProjectName},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAppLogsOpts_Execute(t *testing.T) {
+	now := time.Unix(3600, 0)
+	firstEvents := []*cloudwatchlogs.Event{
+		{ID: "1", TaskID: "1234567890", LogStreamName: "ecs/frontend/1234567890", Timestamp: 3000010, Message: "hello"},
+		{ID: "2", TaskID: "abc", LogStreamName: "ecs/frontend/abc", Timestamp: 3000020, Message: "world"},
+	}
+
+	testCases := map[string]struct {
+		inFollow bool
+		inJSON   bool
+		inFormat string
+		inTaskID string
+
+		mockEnvStore func(m *mocks.MockEnvironmentStore)
+		mockLogs     func(m *climocks.MocklogEventsGetter)
+
+		wantedOutput string
+		wantedErr    error
+	}{
+		"wraps error if the environment cannot be retrieved": {
+			mockEnvStore: func(m *mocks.MockEnvironmentStore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			mockLogs:  func(m *climocks.MocklogEventsGetter) {},
+			wantedErr: errors.New("get environment test: some error"),
+		},
+		"prints events with the task of each event": {
+			inTaskID: "abc",
+			mockEnvStore: func(m *mocks.MockEnvironmentStore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&archer.Environment{Name: "test"}, nil)
+			},
+			mockLogs: func(m *climocks.MocklogEventsGetter) {
+				m.EXPECT().LogEvents(&cloudwatchlogs.LogEventsInput{
+					LogGroupName:        "/ecs/phonetool-test-frontend",
+					LogStreamNamePrefix: "ecs/frontend/abc",
+					StartTime:           now.Add(-10*time.Minute).UnixNano() / int64(time.Millisecond),
+				}).Return(firstEvents, nil)
+			},
+			wantedOutput: "frontend/12345678 hello\nfrontend/abc world\n",
+		},
+		"prints events in JSON": {
+			inJSON: true,
+			mockEnvStore: func(m *mocks.MockEnvironmentStore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&archer.Environment{Name: "test"}, nil)
+			},
+			mockLogs: func(m *climocks.MocklogEventsGetter) {
+				m.EXPECT().LogEvents(gomock.Any()).Return(firstEvents[:1], nil)
+			},
+			wantedOutput: `{"id":"1","taskID":"1234567890","logStreamName":"ecs/frontend/1234567890","timestamp":3000010,"message":"hello"}` + "\n",
+		},
+		"prints events as a YAML list": {
+			inFormat: "yaml",
+			mockEnvStore: func(m *mocks.MockEnvironmentStore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&archer.Environment{Name: "test"}, nil)
+			},
+			mockLogs: func(m *climocks.MocklogEventsGetter) {
+				m.EXPECT().LogEvents(gomock.Any()).Return(firstEvents, nil)
+			},
+			wantedOutput: `- id: "1"
+  taskID: "1234567890"
+  logStreamName: ecs/frontend/1234567890
+  timestamp: 3000010
+  message: hello
+- id: "2"
+  taskID: abc
+  logStreamName: ecs/frontend/abc
+  timestamp: 3000020
+  message: world
+`,
+		},
+		"follows new events until an error occurs": {
+			inFollow: true,
+			mockEnvStore: func(m *mocks.MockEnvironmentStore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&archer.Environment{Name: "test"}, nil)
+			},
+			mockLogs: func(m *climocks.MocklogEventsGetter) {
+				gomock.InOrder(
+					m.EXPECT().LogEvents(gomock.Any()).Return(firstEvents, nil),
+					m.EXPECT().LogEvents(&cloudwatchlogs.LogEventsInput{
+						LogGroupName: "/ecs/phonetool-test-frontend",
+						StartTime:    3000020,
+					}).Return([]*cloudwatchlogs.Event{
+						firstEvents[1],
+						{ID: "3", TaskID: "abc", Timestamp: 3000020, Message: "again"},
+					}, nil),
+					m.EXPECT().LogEvents(gomock.Any()).Return(nil, errors.New("some error")),
+				)
+			},
+			wantedOutput: "frontend/12345678 hello\nfrontend/abc world\nfrontend/abc again\n",
+			wantedErr:    errors.New("get logs of application frontend in environment test: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockEnvStore := mocks.NewMockEnvironmentStore(ctrl)
+			mockLogs := climocks.NewMocklogEventsGetter(ctrl)
+			tc.mockEnvStore(mockEnvStore)
+			tc.mockLogs(mockLogs)
+			b := &bytes.Buffer{}
+
+			opts := &AppLogsOpts{
+				AppName:          "frontend",
+				EnvName:          "test",
+				Follow:           tc.inFollow,
+				Since:            10 * time.Minute,
+				TaskID:           tc.inTaskID,
+				OutputFormat:     tc.inFormat,
+				ShouldOutputJSON: tc.inJSON,
+				envStore:         mockEnvStore,
+				logsSvc:          mockLogs,
+				w:                b,
+				now:              func() time.Time { return now },
+				GlobalOpts:       &GlobalOpts{projectName: "phonetool"},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			require.Equal(t, tc.wantedOutput, b.String())
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
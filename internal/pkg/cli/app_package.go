@@ -4,6 +4,8 @@
 package cli
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"io"
@@ -13,8 +15,9 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/addons"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
-	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/session"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/s3"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
@@ -31,6 +34,10 @@ const (
 	appPackageEnvNamePrompt = "Which environment would you like to create this stack for?"
 )
 
+const (
+	fmtAddonsTemplateKey = "addons/%s/%x.yml"
+)
+
 // PackageAppOpts holds the configuration needed to transform an application's manifest to CloudFormation.
 type PackageAppOpts struct {
 	// Fields with matching flags.
@@ -43,6 +50,7 @@ type PackageAppOpts struct {
 	ws           archer.Workspace
 	store        projectService
 	describer    projectResourcesGetter
+	uploader     artifactUploader
 	stackWriter  io.Writer
 	paramsWriter io.Writer
 	fs           afero.Fs
@@ -82,7 +90,7 @@ func (opts *PackageAppOpts) Ask() error {
 		if len(names) == 0 {
 			return errors.New("there are no applications in the workspace, run `archer init` first")
 		}
-		app, err := opts.prompt.SelectOne(appPackageAppNamePrompt, "", names)
+		app, err := opts.promptFor(nameFlag).SelectOne(appPackageAppNamePrompt, "", names)
 		if err != nil {
 			return fmt.Errorf("prompt application name: %w", err)
 		}
@@ -96,7 +104,7 @@ func (opts *PackageAppOpts) Ask() error {
 		if len(names) == 0 {
 			return fmt.Errorf("there are no environments in project %s", opts.ProjectName())
 		}
-		env, err := opts.prompt.SelectOne(appPackageEnvNamePrompt, "", names)
+		env, err := opts.promptFor(envFlag).SelectOne(appPackageEnvNamePrompt, "", names)
 		if err != nil {
 			return fmt.Errorf("prompt environment name: %w", err)
 		}
@@ -136,6 +144,9 @@ func (opts *PackageAppOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	if err := validateEnvTemplateVersion(env); err != nil {
+		return err
+	}
 
 	if opts.OutputDir != "" {
 		if err := opts.setFileWriters(); err != nil {
@@ -150,8 +161,13 @@ func (opts *PackageAppOpts) Execute() error {
 	if _, err = opts.stackWriter.Write([]byte(templates.stack)); err != nil {
 		return err
 	}
-	_, err = opts.paramsWriter.Write([]byte(templates.configuration))
-	return err
+	if _, err = opts.paramsWriter.Write([]byte(templates.configuration)); err != nil {
+		return err
+	}
+	if opts.OutputDir != "" && templates.addons != "" {
+		return opts.writeAddonsFile(templates.addons)
+	}
+	return nil
 }
 
 func (opts *PackageAppOpts) listAppNames() ([]string, error) {
@@ -165,6 +181,7 @@ func (opts *PackageAppOpts) listAppNames() ([]string, error) {
 type cfnTemplates struct {
 	stack         string
 	configuration string
+	addons        string
 }
 
 // getTemplates returns the CloudFormation stack's template and its parameters.
@@ -177,6 +194,9 @@ func (opts *PackageAppOpts) getTemplates(env *archer.Environment) (*cfnTemplates
 	if err != nil {
 		return nil, err
 	}
+	if lbMft, ok := mft.(*manifest.LBFargateManifest); ok && env.Private && lbMft.EnvConf(env.Name).IsPublic() {
+		return nil, &errPublicAppInPrivateEnv{appName: opts.AppName, envName: env.Name}
+	}
 
 	proj, err := opts.store.GetProject(opts.ProjectName())
 	if err != nil {
@@ -196,6 +216,11 @@ func (opts *PackageAppOpts) getTemplates(env *archer.Environment) (*cfnTemplates
 		}
 	}
 
+	addonsStack, addonsTemplate, err := opts.getAddons(resources)
+	if err != nil {
+		return nil, err
+	}
+
 	switch t := mft.(type) {
 	case *manifest.LBFargateManifest:
 		createLBAppInput := &deploy.CreateLBFargateAppInput{
@@ -203,6 +228,7 @@ func (opts *PackageAppOpts) getTemplates(env *archer.Environment) (*cfnTemplates
 			Env:          env,
 			ImageRepoURL: repoURL,
 			ImageTag:     opts.Tag,
+			Addons:       addonsStack,
 		}
 		var appStack *stack.LBFargateStackConfig
 		// If the project supports DNS Delegation, we'll also
@@ -221,12 +247,44 @@ func (opts *PackageAppOpts) getTemplates(env *archer.Environment) (*cfnTemplates
 		if err != nil {
 			return nil, err
 		}
-		return &cfnTemplates{stack: tpl, configuration: params}, nil
+		return &cfnTemplates{stack: tpl, configuration: params, addons: addonsTemplate}, nil
 	default:
 		return nil, fmt.Errorf("create CloudFormation template for manifest of type %T", t)
 	}
 }
 
+// getAddons merges the application's addons into a single template and uploads it to the project's
+// bucket in the environment's region, so that it can be referenced as a nested stack.
+// If the application doesn't have any addons, it returns a nil stack.
+func (opts *PackageAppOpts) getAddons(resources *archer.ProjectRegionalResources) (*deploy.AddonsStack, string, error) {
+	merged, err := addons.New(opts.AppName, opts.ws).Stack()
+	if err != nil {
+		var notFound *addons.ErrAddonsNotFound
+		if errors.As(err, &notFound) {
+			return nil, "", nil
+		}
+		return nil, "", err
+	}
+
+	if opts.uploader == nil {
+		// Tests mock the client.
+		sess, err := opts.sessionProvider().DefaultWithRegion(resources.Region)
+		if err != nil {
+			return nil, "", fmt.Errorf("create session with region %s: %w", resources.Region, err)
+		}
+		opts.uploader = s3.New(sess)
+	}
+	key := fmt.Sprintf(fmtAddonsTemplateKey, opts.AppName, sha256.Sum256([]byte(merged.Template)))
+	url, err := opts.uploader.PutArtifact(resources.S3Bucket, key, bytes.NewBufferString(merged.Template))
+	if err != nil {
+		return nil, "", fmt.Errorf("upload addons template for application %s: %w", opts.AppName, err)
+	}
+	return &deploy.AddonsStack{
+		TemplateURL: url,
+		Outputs:     merged.Outputs,
+	}, merged.Template, nil
+}
+
 // setFileWriters creates the output directory, and updates the template and param writers to file writers in the directory.
 func (opts *PackageAppOpts) setFileWriters() error {
 	if err := opts.fs.MkdirAll(opts.OutputDir, 0755); err != nil {
@@ -251,6 +309,16 @@ func (opts *PackageAppOpts) setFileWriters() error {
 	return nil
 }
 
+// writeAddonsFile writes the merged addons template to the output directory.
+func (opts *PackageAppOpts) writeAddonsFile(template string) error {
+	addonsPath := filepath.Join(opts.OutputDir,
+		fmt.Sprintf(archer.AddonsCfnTemplateNameFormat, opts.AppName))
+	if err := afero.WriteFile(opts.fs, addonsPath, []byte(template), 0644); err != nil {
+		return fmt.Errorf("write file %s: %w", addonsPath, err)
+	}
+	return nil
+}
+
 func contains(s string, items []string) bool {
 	for _, item := range items {
 		if s == item {
@@ -292,6 +360,54 @@ func (e *errRepoNotFound) Is(target error) bool {
 		e.projAccountID == t.projAccountID
 }
 
+type errPublicAppInPrivateEnv struct {
+	appName string
+	envName string
+}
+
+func (e *errPublicAppInPrivateEnv) Error() string {
+	return fmt.Sprintf(`application %s requires a public load balancer but environment %s is private: set "http.public: false" in its manifest to use the internal load balancer of the environment`, e.appName, e.envName)
+}
+
+func (e *errPublicAppInPrivateEnv) Is(target error) bool {
+	t, ok := target.(*errPublicAppInPrivateEnv)
+	if !ok {
+		return false
+	}
+	return e.appName == t.appName && e.envName == t.envName
+}
+
+// validateEnvTemplateVersion returns an error if the environment's stack doesn't export every value imported by the
+// application templates, so that applications aren't deployed to it until it's upgraded.
+func validateEnvTemplateVersion(env *archer.Environment) error {
+	if !deploy.IsOlderEnvTemplateVersion(env.TemplateVersion, deploy.MinAppEnvTemplateVersion) {
+		return nil
+	}
+	version := env.TemplateVersion
+	if version == "" {
+		version = deploy.LegacyEnvTemplateVersion
+	}
+	return &errOutdatedEnvTemplate{envName: env.Name, version: version}
+}
+
+type errOutdatedEnvTemplate struct {
+	envName string
+	version string
+}
+
+func (e *errOutdatedEnvTemplate) Error() string {
+	return fmt.Sprintf("environment %s is on template version %s but applications require %s or later: run `archer env upgrade %s` first",
+		e.envName, e.version, deploy.MinAppEnvTemplateVersion, e.envName)
+}
+
+func (e *errOutdatedEnvTemplate) Is(target error) bool {
+	t, ok := target.(*errOutdatedEnvTemplate)
+	if !ok {
+		return false
+	}
+	return e.envName == t.envName && e.version == t.version
+}
+
 // BuildAppPackageCmd builds the command for printing an application's CloudFormation template.
 func BuildAppPackageCmd() *cobra.Command {
 	opts := NewPackageAppOpts()
@@ -320,7 +436,7 @@ func BuildAppPackageCmd() *cobra.Command {
 			}
 			opts.store = store
 
-			sess, err := session.Default()
+			sess, err := opts.sessionProvider().Default()
 			if err != nil {
 				return fmt.Errorf("error retrieving default session: %w", err)
 			}
@@ -340,6 +456,8 @@ func BuildAppPackageCmd() *cobra.Command {
 	// Set the defaults to opts.{Field} otherwise cobra overrides the values set by the constructor.
 	cmd.Flags().StringVarP(&opts.AppName, nameFlag, nameFlagShort, opts.AppName, appFlagDescription)
 	cmd.Flags().StringVarP(&opts.EnvName, envFlag, envFlagShort, opts.EnvName, envFlagDescription)
+	markFlagCompletion(cmd.Flags(), nameFlag, completeApps)
+	markFlagCompletion(cmd.Flags(), envFlag, completeEnvs)
 	cmd.Flags().StringVar(&opts.Tag, imageTagFlag, opts.Tag, imageTagFlagDescription)
 	cmd.Flags().StringVar(&opts.OutputDir, stackOutputDirFlag, opts.OutputDir, stackOutputDirFlagDescription)
 	return cmd
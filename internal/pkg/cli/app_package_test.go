@@ -11,6 +11,7 @@ import (
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
 	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
@@ -328,6 +329,7 @@ func TestPackageAppOpts_Validate(t *testing.T) {
 }
 
 func TestPackageAppOpts_Execute(t *testing.T) {
+	mockUploadErr := errors.New("some error")
 	testCases := map[string]struct {
 		inProjectName string
 		inEnvName     string
@@ -338,6 +340,7 @@ func TestPackageAppOpts_Execute(t *testing.T) {
 		expectStore     func(m *climocks.MockprojectService)
 		expectWorkspace func(m *mocks.MockWorkspace)
 		expectDeployer  func(m *climocks.MockprojectResourcesGetter)
+		expectUploader  func(m *climocks.MockartifactUploader)
 		expectFS        func(t *testing.T, mockFS *afero.Afero)
 
 		wantedErr error
@@ -362,6 +365,25 @@ func TestPackageAppOpts_Execute(t *testing.T) {
 				EnvironmentName: "test",
 			},
 		},
+		"outdated environment": {
+			inProjectName: "phonetool",
+			inEnvName:     "test",
+			inAppName:     "frontend",
+
+			expectStore: func(m *climocks.MockprojectService) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&archer.Environment{
+					Project:         "phonetool",
+					Name:            "test",
+					TemplateVersion: "v1.3.0",
+				}, nil)
+			},
+			expectWorkspace: func(m *mocks.MockWorkspace) {
+				m.EXPECT().ReadFile(gomock.Any()).Times(0)
+			},
+			expectDeployer: func(m *climocks.MockprojectResourcesGetter) {},
+
+			wantedErr: &errOutdatedEnvTemplate{envName: "test", version: "v1.3.0"},
+		},
 		"invalid manifest file": {
 			inProjectName: "phonetool",
 			inEnvName:     "test",
@@ -369,8 +391,9 @@ func TestPackageAppOpts_Execute(t *testing.T) {
 
 			expectStore: func(m *climocks.MockprojectService) {
 				m.EXPECT().GetEnvironment("phonetool", "test").Return(&archer.Environment{
-					Project: "phonetool",
-					Name:    "test",
+					Project:         "phonetool",
+					Name:            "test",
+					TemplateVersion: deploy.LatestEnvTemplateVersion,
 				}, nil)
 			},
 			expectWorkspace: func(m *mocks.MockWorkspace) {
@@ -392,8 +415,9 @@ func TestPackageAppOpts_Execute(t *testing.T) {
 
 			expectStore: func(m *climocks.MockprojectService) {
 				m.EXPECT().GetEnvironment("phonetool", "test").Return(&archer.Environment{
-					Project: "phonetool",
-					Name:    "test",
+					Project:         "phonetool",
+					Name:            "test",
+					TemplateVersion: deploy.LatestEnvTemplateVersion,
 				}, nil)
 			},
 			expectWorkspace: func(m *mocks.MockWorkspace) {
@@ -404,6 +428,61 @@ func TestPackageAppOpts_Execute(t *testing.T) {
 
 			wantedErr: &manifest.ErrUnmarshalAppManifest{},
 		},
+		"public application in a private environment": {
+			inProjectName: "phonetool",
+			inEnvName:     "secure",
+			inAppName:     "frontend",
+
+			expectStore: func(m *climocks.MockprojectService) {
+				m.EXPECT().GetEnvironment("phonetool", "secure").Return(&archer.Environment{
+					Project:         "phonetool",
+					Name:            "secure",
+					TemplateVersion: deploy.LatestEnvTemplateVersion,
+					Private:         true,
+				}, nil)
+				m.EXPECT().GetProject(gomock.Any()).Times(0)
+			},
+			expectWorkspace: func(m *mocks.MockWorkspace) {
+				m.EXPECT().AppManifestFileName("frontend").Return("frontend-app.yml")
+				m.EXPECT().ReadFile("frontend-app.yml").Return([]byte(`name: frontend
+type: Load Balanced Web App
+environments:
+  test:
+    http:
+      public: false`), nil)
+			},
+			expectDeployer: func(m *climocks.MockprojectResourcesGetter) {},
+
+			wantedErr: &errPublicAppInPrivateEnv{appName: "frontend", envName: "secure"},
+		},
+		"internal application in a private environment": {
+			inProjectName: "phonetool",
+			inEnvName:     "secure",
+			inAppName:     "frontend",
+
+			expectStore: func(m *climocks.MockprojectService) {
+				m.EXPECT().GetEnvironment("phonetool", "secure").Return(&archer.Environment{
+					Project:         "phonetool",
+					Name:            "secure",
+					TemplateVersion: deploy.LatestEnvTemplateVersion,
+					Private:         true,
+				}, nil)
+				m.EXPECT().GetProject("phonetool").Return(nil, &store.ErrNoSuchProject{ProjectName: "phonetool"})
+			},
+			expectWorkspace: func(m *mocks.MockWorkspace) {
+				m.EXPECT().AppManifestFileName("frontend").Return("frontend-app.yml")
+				m.EXPECT().ReadFile("frontend-app.yml").Return([]byte(`name: frontend
+type: Load Balanced Web App
+environments:
+  secure:
+    http:
+      public: false`), nil)
+			},
+			expectDeployer: func(m *climocks.MockprojectResourcesGetter) {},
+
+			// Packaging goes on past the check.
+			wantedErr: &store.ErrNoSuchProject{ProjectName: "phonetool"},
+		},
 		"error while getting project from store": {
 			inProjectName: "phonetool",
 			inEnvName:     "test",
@@ -411,8 +490,9 @@ func TestPackageAppOpts_Execute(t *testing.T) {
 
 			expectStore: func(m *climocks.MockprojectService) {
 				m.EXPECT().GetEnvironment("phonetool", "test").Return(&archer.Environment{
-					Project: "phonetool",
-					Name:    "test",
+					Project:         "phonetool",
+					Name:            "test",
+					TemplateVersion: deploy.LatestEnvTemplateVersion,
 				}, nil)
 				m.EXPECT().GetProject("phonetool").Return(nil, &store.ErrNoSuchProject{ProjectName: "phonetool"})
 			},
@@ -432,10 +512,11 @@ type: Load Balanced Web App`), nil)
 
 			expectStore: func(m *climocks.MockprojectService) {
 				m.EXPECT().GetEnvironment("phonetool", "test").Return(&archer.Environment{
-					Project:   "phonetool",
-					Name:      "test",
-					Region:    "us-west-2",
-					AccountID: "1111",
+					Project:         "phonetool",
+					Name:            "test",
+					TemplateVersion: deploy.LatestEnvTemplateVersion,
+					Region:          "us-west-2",
+					AccountID:       "1111",
 				}, nil)
 				m.EXPECT().GetProject("phonetool").Return(&archer.Project{
 					Name:      "phonetool",
@@ -462,10 +543,11 @@ type: Load Balanced Web App`), nil)
 
 			expectStore: func(m *climocks.MockprojectService) {
 				m.EXPECT().GetEnvironment("phonetool", "test").Return(&archer.Environment{
-					Project:   "phonetool",
-					Name:      "test",
-					Region:    "us-west-2",
-					AccountID: "1111",
+					Project:         "phonetool",
+					Name:            "test",
+					TemplateVersion: deploy.LatestEnvTemplateVersion,
+					Region:          "us-west-2",
+					AccountID:       "1111",
 				}, nil)
 				m.EXPECT().GetProject("phonetool").Return(&archer.Project{
 					Name:      "phonetool",
@@ -499,10 +581,11 @@ type: Load Balanced Web App`), nil)
 
 			expectStore: func(m *climocks.MockprojectService) {
 				m.EXPECT().GetEnvironment("phonetool", "test").Return(&archer.Environment{
-					Project:   "phonetool",
-					Name:      "test",
-					AccountID: "1111",
-					Region:    "us-west-2",
+					Project:         "phonetool",
+					Name:            "test",
+					TemplateVersion: deploy.LatestEnvTemplateVersion,
+					AccountID:       "1111",
+					Region:          "us-west-2",
 				}, nil)
 				m.EXPECT().GetProject("phonetool").Return(&archer.Project{
 					Name:      "phonetool",
@@ -521,6 +604,7 @@ http:
 cpu: 256
 memory: 512
 count: 1`), nil)
+				m.EXPECT().ListAddonFiles("frontend").Return(nil, nil)
 			},
 			expectDeployer: func(m *climocks.MockprojectResourcesGetter) {
 				m.EXPECT().GetProjectResourcesByRegion(gomock.Any(), gomock.Any()).Return(&archer.ProjectRegionalResources{
@@ -538,10 +622,11 @@ count: 1`), nil)
 
 			expectStore: func(m *climocks.MockprojectService) {
 				m.EXPECT().GetEnvironment("phonetool", "test").Return(&archer.Environment{
-					Project:   "phonetool",
-					Name:      "test",
-					AccountID: "1111",
-					Region:    "us-west-2",
+					Project:         "phonetool",
+					Name:            "test",
+					TemplateVersion: deploy.LatestEnvTemplateVersion,
+					AccountID:       "1111",
+					Region:          "us-west-2",
 				}, nil)
 				m.EXPECT().GetProject("phonetool").Return(&archer.Project{
 					Name:      "phonetool",
@@ -561,6 +646,7 @@ http:
 cpu: 256
 memory: 512
 count: 1`), nil)
+				m.EXPECT().ListAddonFiles("frontend").Return(nil, nil)
 			},
 			expectDeployer: func(m *climocks.MockprojectResourcesGetter) {
 				m.EXPECT().GetProjectResourcesByRegion(gomock.Any(), gomock.Any()).Return(&archer.ProjectRegionalResources{
@@ -579,10 +665,11 @@ count: 1`), nil)
 
 			expectStore: func(m *climocks.MockprojectService) {
 				m.EXPECT().GetEnvironment("phonetool", "test").Return(&archer.Environment{
-					Project:   "phonetool",
-					Name:      "test",
-					AccountID: "1111",
-					Region:    "us-west-2",
+					Project:         "phonetool",
+					Name:            "test",
+					TemplateVersion: deploy.LatestEnvTemplateVersion,
+					AccountID:       "1111",
+					Region:          "us-west-2",
 				}, nil)
 				m.EXPECT().GetProject("phonetool").Return(&archer.Project{
 					Name:      "phonetool",
@@ -601,6 +688,7 @@ http:
 cpu: 256
 memory: 512
 count: 1`), nil)
+				m.EXPECT().ListAddonFiles("frontend").Return(nil, nil)
 			},
 			expectDeployer: func(m *climocks.MockprojectResourcesGetter) {
 				m.EXPECT().GetProjectResourcesByRegion(gomock.Any(), gomock.Any()).Return(&archer.ProjectRegionalResources{
@@ -619,6 +707,111 @@ count: 1`), nil)
 				require.True(t, paramsFileExists, "expected file %s to exists", paramsFileExists)
 			},
 		},
+		"wrap error if addons cannot be uploaded": {
+			inProjectName: "phonetool",
+			inEnvName:     "test",
+			inAppName:     "frontend",
+			inTagName:     "latest",
+
+			expectStore: func(m *climocks.MockprojectService) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&archer.Environment{
+					Project:         "phonetool",
+					Name:            "test",
+					TemplateVersion: deploy.LatestEnvTemplateVersion,
+					AccountID:       "1111",
+					Region:          "us-west-2",
+				}, nil)
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{
+					Name:      "phonetool",
+					AccountID: "1234",
+				}, nil)
+			},
+			expectWorkspace: func(m *mocks.MockWorkspace) {
+				m.EXPECT().AppManifestFileName("frontend").Return("frontend-app.yml")
+				m.EXPECT().ReadFile("frontend-app.yml").Return([]byte(`name: frontend
+type: Load Balanced Web App`), nil)
+				m.EXPECT().ListAddonFiles("frontend").Return([]string{"frontend/addons/topic.yml"}, nil)
+				m.EXPECT().ReadFile("frontend/addons/topic.yml").Return([]byte(`Resources:
+  MyTopic:
+    Type: AWS::SNS::Topic`), nil)
+			},
+			expectDeployer: func(m *climocks.MockprojectResourcesGetter) {
+				m.EXPECT().GetProjectResourcesByRegion(gomock.Any(), gomock.Any()).Return(&archer.ProjectRegionalResources{
+					Region:   "us-west-2",
+					S3Bucket: "bucket",
+					RepositoryURLs: map[string]string{
+						"frontend": "some url",
+					},
+				}, nil)
+			},
+			expectUploader: func(m *climocks.MockartifactUploader) {
+				m.EXPECT().PutArtifact("bucket", gomock.Any(), gomock.Any()).Return("", mockUploadErr)
+			},
+			wantedErr: mockUploadErr,
+		},
+		"with addons in output directory": {
+			inProjectName: "phonetool",
+			inEnvName:     "test",
+			inAppName:     "frontend",
+			inTagName:     "latest",
+			inOutputDir:   "./infrastructure",
+
+			expectStore: func(m *climocks.MockprojectService) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&archer.Environment{
+					Project:         "phonetool",
+					Name:            "test",
+					TemplateVersion: deploy.LatestEnvTemplateVersion,
+					AccountID:       "1111",
+					Region:          "us-west-2",
+				}, nil)
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{
+					Name:      "phonetool",
+					AccountID: "1234",
+				}, nil)
+			},
+			expectWorkspace: func(m *mocks.MockWorkspace) {
+				m.EXPECT().AppManifestFileName("frontend").Return("frontend-app.yml")
+				m.EXPECT().ReadFile("frontend-app.yml").Return([]byte(`name: frontend
+type: Load Balanced Web App
+image:
+  build: frontend/Dockerfile
+  port: 80
+http:
+  path: '*'
+cpu: 256
+memory: 512
+count: 1`), nil)
+				m.EXPECT().ListAddonFiles("frontend").Return([]string{"frontend/addons/topic.yml"}, nil)
+				m.EXPECT().ReadFile("frontend/addons/topic.yml").Return([]byte(`Resources:
+  MyTopic:
+    Type: AWS::SNS::Topic
+Outputs:
+  MyTopicArn:
+    Value: !Ref MyTopic`), nil)
+			},
+			expectDeployer: func(m *climocks.MockprojectResourcesGetter) {
+				m.EXPECT().GetProjectResourcesByRegion(gomock.Any(), gomock.Any()).Return(&archer.ProjectRegionalResources{
+					Region:   "us-west-2",
+					S3Bucket: "bucket",
+					RepositoryURLs: map[string]string{
+						"frontend": "some url",
+					},
+				}, nil)
+			},
+			expectUploader: func(m *climocks.MockartifactUploader) {
+				m.EXPECT().PutArtifact("bucket", gomock.Any(), gomock.Any()).Return("https://bucket.s3-us-west-2.amazonaws.com/addons/frontend/abc.yml", nil)
+			},
+			expectFS: func(t *testing.T, mockFS *afero.Afero) {
+				stackPath := filepath.Join("infrastructure", "frontend.stack.yml")
+				stack, _ := mockFS.ReadFile(stackPath)
+				require.Contains(t, string(stack), "AddonsStack")
+				require.Contains(t, string(stack), "MY_TOPIC_ARN")
+
+				addonsPath := filepath.Join("infrastructure", "frontend.addons.stack.yml")
+				addonsFileExists, _ := mockFS.Exists(addonsPath)
+				require.True(t, addonsFileExists, "expected file %s to exists", addonsPath)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -630,9 +823,13 @@ count: 1`), nil)
 			mockStore := climocks.NewMockprojectService(ctrl)
 			mockWorkspace := mocks.NewMockWorkspace(ctrl)
 			mockDeployer := climocks.NewMockprojectResourcesGetter(ctrl)
+			mockUploader := climocks.NewMockartifactUploader(ctrl)
 			tc.expectStore(mockStore)
 			tc.expectWorkspace(mockWorkspace)
 			tc.expectDeployer(mockDeployer)
+			if tc.expectUploader != nil {
+				tc.expectUploader(mockUploader)
+			}
 
 			templateBuf := &strings.Builder{}
 			paramsBuf := &strings.Builder{}
@@ -646,6 +843,7 @@ count: 1`), nil)
 				store:        mockStore,
 				ws:           mockWorkspace,
 				describer:    mockDeployer,
+				uploader:     mockUploader,
 				stackWriter:  templateBuf,
 				paramsWriter: paramsBuf,
 				fs:           mockFS,
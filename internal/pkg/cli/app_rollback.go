@@ -0,0 +1,218 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/identity"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	termprogress "github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/progress"
+	"github.com/spf13/cobra"
+)
+
+const (
+	fmtRollbackAppStart    = "Rolling back %s in %s to revision %s."
+	fmtRollbackAppFailed   = "Failed to roll back %s in %s to revision %s."
+	fmtRollbackAppComplete = "Rolled back %s in %s to revision %s."
+)
+
+type appRollbacker interface {
+	RollbackApp(templateURL, stackName, cfExecutionRole string, tags map[string]string) error
+}
+
+// RollbackAppOpts holds the configuration needed to redeploy a previous revision of an application.
+type RollbackAppOpts struct {
+	// Fields with matching flags.
+	AppName  string
+	EnvName  string
+	Revision int
+
+	// Interfaces to interact with dependencies.
+	appLister     archer.ApplicationLister
+	envStore      archer.EnvironmentStore
+	revisionStore archer.AppRevisionStore
+	deployer      appRollbacker
+	identity      identityService
+	spinner       progress
+
+	*GlobalOpts
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (opts *RollbackAppOpts) Ask() error {
+	if opts.AppName == "" {
+		name, err := selectApplication(opts.promptFor(nameFlag), opts.appLister, opts.ProjectName(),
+			"Which application would you like to roll back?",
+			"The application is redeployed with the template of a previous deployment.")
+		if err != nil {
+			return err
+		}
+		opts.AppName = name
+	}
+	if opts.EnvName == "" {
+		name, err := selectEnvironment(opts.promptFor(envFlag), opts.envStore, opts.ProjectName(),
+			fmt.Sprintf("Which environment would you like to roll back %s in?", opts.AppName),
+			"Only the application in this environment is rolled back.")
+		if err != nil {
+			return err
+		}
+		opts.EnvName = name
+	}
+	return nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (opts *RollbackAppOpts) Validate() error {
+	if opts.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	if opts.Revision < 0 {
+		return fmt.Errorf("revision %d must be a positive number", opts.Revision)
+	}
+	return nil
+}
+
+// Execute redeploys the template of the revision, or of the revision before the latest one if none is passed in,
+// and records the rollback as a new revision.
+func (opts *RollbackAppOpts) Execute() error {
+	env, err := opts.envStore.GetEnvironment(opts.ProjectName(), opts.EnvName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", opts.EnvName, err)
+	}
+	rev, err := opts.targetRevision()
+	if err != nil {
+		return err
+	}
+	if err := opts.initClients(env); err != nil {
+		return err
+	}
+
+	app := fmt.Sprintf("%s:%s", color.HighlightUserInput(opts.AppName), color.HighlightUserInput(rev.ImageTag))
+	number := color.HighlightResource(strconv.Itoa(rev.Number))
+	opts.spinner.Start(fmt.Sprintf(fmtRollbackAppStart, app, color.HighlightUserInput(opts.EnvName), number))
+	stackName := stack.NameForApp(opts.ProjectName(), opts.EnvName, opts.AppName)
+	tags := map[string]string{
+		stack.ProjectTagKey: opts.ProjectName(),
+		stack.EnvTagKey:     opts.EnvName,
+		stack.AppTagKey:     opts.AppName,
+	}
+	if err := opts.deployer.RollbackApp(rev.TemplateURL, stackName, env.ExecutionRoleARN, tags); err != nil {
+		opts.spinner.Stop(log.Serrorf(fmtRollbackAppFailed, app, color.HighlightUserInput(opts.EnvName), number))
+		return fmt.Errorf("roll back application %s to revision %d: %w", opts.AppName, rev.Number, err)
+	}
+	opts.spinner.Stop(log.Ssuccessf(fmtRollbackAppComplete, app, color.HighlightUserInput(opts.EnvName), number))
+
+	// The rollback succeeded already, so a failure to record it is only reported.
+	if err := opts.recordRollback(rev); err != nil {
+		log.Warningf("Couldn't record the rollback of %s: %v\n", color.HighlightUserInput(opts.AppName), err)
+	}
+	return nil
+}
+
+// targetRevision returns the revision to roll back to.
+func (opts *RollbackAppOpts) targetRevision() (*archer.AppRevision, error) {
+	if opts.Revision != 0 {
+		rev, err := opts.revisionStore.GetAppRevision(opts.ProjectName(), opts.EnvName, opts.AppName, opts.Revision)
+		if err != nil {
+			return nil, fmt.Errorf("get revision %d of application %s: %w", opts.Revision, opts.AppName, err)
+		}
+		return rev, nil
+	}
+	revisions, err := opts.revisionStore.ListAppRevisions(opts.ProjectName(), opts.EnvName, opts.AppName)
+	if err != nil {
+		return nil, err
+	}
+	if len(revisions) < 2 {
+		return nil, fmt.Errorf("no previous revision of application %s found in environment %s", opts.AppName, opts.EnvName)
+	}
+	return revisions[1], nil
+}
+
+// recordRollback records the redeployment of the revision as a new revision.
+func (opts *RollbackAppOpts) recordRollback(rev *archer.AppRevision) error {
+	caller, err := opts.identity.Get()
+	if err != nil {
+		return err
+	}
+	return opts.revisionStore.CreateAppRevision(&archer.AppRevision{
+		Project:      rev.Project,
+		App:          rev.App,
+		Env:          rev.Env,
+		ImageTag:     rev.ImageTag,
+		ManifestHash: rev.ManifestHash,
+		GitCommit:    rev.GitCommit,
+		Actor:        caller.ARN,
+		DeployedAt:   time.Now().UTC(),
+		TemplateURL:  rev.TemplateURL,
+		RollbackOf:   rev.Number,
+	})
+}
+
+func (opts *RollbackAppOpts) initClients(env *archer.Environment) error {
+	if opts.deployer != nil {
+		// Tests mock the clients.
+		return nil
+	}
+	sess, err := opts.sessionProvider().FromEnvRole(env.Name, env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return fmt.Errorf("create session from role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	opts.deployer = cloudformation.New(sess)
+	defaultSess, err := opts.sessionProvider().Default()
+	if err != nil {
+		return err
+	}
+	opts.identity = identity.New(defaultSess)
+	return nil
+}
+
+// BuildAppRollbackCmd builds the command for rolling back an application to a previous revision.
+func BuildAppRollbackCmd() *cobra.Command {
+	opts := &RollbackAppOpts{
+		spinner:    termprogress.NewSpinner(),
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Rolls back an application to a previous deployment.",
+		Long: `Rolls back an application in an environment to a previous deployment without rebuilding its image.
+The CloudFormation template of the revision is redeployed, and the rollback is recorded as a new revision.
+Run "archer app history" to list the revisions of the application.`,
+		Example: `
+  Rolls back the "frontend" application in the "prod" environment to the previous deployment.
+  /code $ archer app rollback --name frontend --env prod
+  Rolls back to revision 3.
+  /code $ archer app rollback --name frontend --env prod --to 3`,
+		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			store, err := store.New()
+			if err != nil {
+				return fmt.Errorf("connect to ecs-cli metadata store: %w", err)
+			}
+			opts.appLister = store
+			opts.envStore = store
+			opts.revisionStore = store
+			return opts.Validate()
+		}),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&opts.AppName, nameFlag, nameFlagShort, "", appFlagDescription)
+	cmd.Flags().StringVarP(&opts.EnvName, envFlag, envFlagShort, "", envFlagDescription)
+	markFlagCompletion(cmd.Flags(), nameFlag, completeApps)
+	markFlagCompletion(cmd.Flags(), envFlag, completeEnvs)
+	cmd.Flags().IntVar(&opts.Revision, toFlag, 0, toFlagDescription)
+	return cmd
+}
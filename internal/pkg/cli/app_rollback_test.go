@@ -0,0 +1,165 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/identity"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollbackAppOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inProjectName string
+		inRevision    int
+
+		wantedErr error
+	}{
+		"returns error if there is no project": {
+			wantedErr: errNoProjectInWorkspace,
+		},
+		"returns error if the revision is negative": {
+			inProjectName: "phonetool",
+			inRevision:    -1,
+			wantedErr:     errors.New("revision -1 must be a positive number"),
+		},
+		"valid flags": {
+			inProjectName: "phonetool",
+			inRevision:    3,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &RollbackAppOpts{
+				Revision:   tc.inRevision,
+				GlobalOpts: &GlobalOpts{projectName: tc.inProjectName},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRollbackAppOpts_Execute(t *testing.T) {
+	testEnv := &archer.Environment{Project: "phonetool", Name: "prod", ExecutionRoleARN: "execRole"}
+	rev2 := &archer.AppRevision{Project: "phonetool", Env: "prod", App: "api", Number: 2, ImageTag: "v1.1.0", TemplateURL: "https://bucket/v1.1.0.yml"}
+	rev3 := &archer.AppRevision{Project: "phonetool", Env: "prod", App: "api", Number: 3, ImageTag: "v1.2.0", TemplateURL: "https://bucket/v1.2.0.yml"}
+	wantedTags := map[string]string{
+		"ecs-project":     "phonetool",
+		"ecs-environment": "prod",
+		"ecs-application": "api",
+	}
+	mockErr := errors.New("some error")
+
+	testCases := map[string]struct {
+		inRevision int
+
+		setupMocks func(revs *mocks.MockAppRevisionStore, deployer *climocks.MockappRollbacker, id *climocks.MockidentityService)
+
+		wantedErr error
+	}{
+		"rolls back to the revision before the latest one by default": {
+			setupMocks: func(revs *mocks.MockAppRevisionStore, deployer *climocks.MockappRollbacker, id *climocks.MockidentityService) {
+				revs.EXPECT().ListAppRevisions("phonetool", "prod", "api").Return([]*archer.AppRevision{rev3, rev2}, nil)
+				deployer.EXPECT().RollbackApp("https://bucket/v1.1.0.yml", "phonetool-prod-api", "execRole", wantedTags).Return(nil)
+				id.EXPECT().Get().Return(identity.Caller{ARN: "arn:aws:iam::123456789012:user/alice"}, nil)
+				revs.EXPECT().CreateAppRevision(gomock.Any()).Do(func(rev *archer.AppRevision) {
+					require.Equal(t, 2, rev.RollbackOf)
+					require.Equal(t, "v1.1.0", rev.ImageTag)
+					require.Equal(t, "https://bucket/v1.1.0.yml", rev.TemplateURL)
+					require.Equal(t, "arn:aws:iam::123456789012:user/alice", rev.Actor)
+				}).Return(nil)
+			},
+		},
+		"returns error if there is no previous revision": {
+			setupMocks: func(revs *mocks.MockAppRevisionStore, deployer *climocks.MockappRollbacker, id *climocks.MockidentityService) {
+				revs.EXPECT().ListAppRevisions("phonetool", "prod", "api").Return([]*archer.AppRevision{rev3}, nil)
+				deployer.EXPECT().RollbackApp(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("no previous revision of application api found in environment prod"),
+		},
+		"rolls back to the revision passed in": {
+			inRevision: 3,
+			setupMocks: func(revs *mocks.MockAppRevisionStore, deployer *climocks.MockappRollbacker, id *climocks.MockidentityService) {
+				revs.EXPECT().GetAppRevision("phonetool", "prod", "api", 3).Return(rev3, nil)
+				deployer.EXPECT().RollbackApp("https://bucket/v1.2.0.yml", "phonetool-prod-api", "execRole", wantedTags).Return(nil)
+				id.EXPECT().Get().Return(identity.Caller{}, nil)
+				revs.EXPECT().CreateAppRevision(gomock.Any()).Return(nil)
+			},
+		},
+		"wraps error from rolling back": {
+			inRevision: 3,
+			setupMocks: func(revs *mocks.MockAppRevisionStore, deployer *climocks.MockappRollbacker, id *climocks.MockidentityService) {
+				revs.EXPECT().GetAppRevision("phonetool", "prod", "api", 3).Return(rev3, nil)
+				deployer.EXPECT().RollbackApp(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockErr)
+				revs.EXPECT().CreateAppRevision(gomock.Any()).Times(0)
+			},
+			wantedErr: fmt.Errorf("roll back application api to revision 3: %w", mockErr),
+		},
+		"does not fail if the rollback cannot be recorded": {
+			inRevision: 3,
+			setupMocks: func(revs *mocks.MockAppRevisionStore, deployer *climocks.MockappRollbacker, id *climocks.MockidentityService) {
+				revs.EXPECT().GetAppRevision("phonetool", "prod", "api", 3).Return(rev3, nil)
+				deployer.EXPECT().RollbackApp(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				id.EXPECT().Get().Return(identity.Caller{}, mockErr)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockEnvStore := mocks.NewMockEnvironmentStore(ctrl)
+			mockEnvStore.EXPECT().GetEnvironment("phonetool", "prod").Return(testEnv, nil)
+			mockRevisionStore := mocks.NewMockAppRevisionStore(ctrl)
+			mockDeployer := climocks.NewMockappRollbacker(ctrl)
+			mockIdentity := climocks.NewMockidentityService(ctrl)
+			mockSpinner := climocks.NewMockprogress(ctrl)
+			mockSpinner.EXPECT().Start(gomock.Any()).AnyTimes()
+			mockSpinner.EXPECT().Stop(gomock.Any()).AnyTimes()
+			tc.setupMocks(mockRevisionStore, mockDeployer, mockIdentity)
+
+			opts := &RollbackAppOpts{
+				AppName:       "api",
+				EnvName:       "prod",
+				Revision:      tc.inRevision,
+				envStore:      mockEnvStore,
+				revisionStore: mockRevisionStore,
+				deployer:      mockDeployer,
+				identity:      mockIdentity,
+				spinner:       mockSpinner,
+				GlobalOpts:    &GlobalOpts{projectName: "phonetool"},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// BuildAppRunCmd builds the command for running an application outside of its environments.
+func BuildAppRunCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Runs an application outside of its environments.",
+		Long:  `Runs an application with its manifest configuration without deploying it.`,
+	}
+	cmd.AddCommand(BuildAppRunLocalCmd())
+	return cmd
+}
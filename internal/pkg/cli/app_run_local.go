@@ -0,0 +1,300 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/secrets"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/build/docker"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultEnvFile     = ".env"
+	fmtLocalImageName  = "%s/%s" // Local images are named after the project and app.
+	localImageTag      = "local"
+	envFileCommentChar = "#"
+)
+
+type localAppWorkspace interface {
+	AppNames() ([]string, error)
+	ReadFile(filename string) ([]byte, error)
+	AppManifestFileName(appName string) string
+}
+
+type secretGetter interface {
+	Value(valueFrom string) (string, error)
+}
+
+type localDockerService interface {
+	Build(uri, tag, path string) error
+	Run(in *docker.RunInput) error
+}
+
+// RunLocalAppOpts holds the configuration needed to run an application locally.
+type RunLocalAppOpts struct {
+	// Fields with matching flags.
+	AppName string
+	EnvName string
+	EnvFile string
+	Port    int
+	Offline bool
+
+	// Interfaces to interact with dependencies.
+	ws            localAppWorkspace
+	envStore      archer.EnvironmentStore
+	secrets       secretGetter
+	dockerService localDockerService
+	readFile      func(filename string) ([]byte, error)
+
+	*GlobalOpts
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (opts *RunLocalAppOpts) Ask() error {
+	if opts.AppName == "" {
+		// Only applications with a manifest in the workspace can be built locally.
+		names, err := opts.ws.AppNames()
+		if err != nil {
+			return fmt.Errorf("list applications in the workspace: %w", err)
+		}
+		if len(names) == 0 {
+			return errors.New("no applications found in the workspace, please run `app init` first")
+		}
+		if len(names) == 1 {
+			opts.AppName = names[0]
+		} else {
+			name, err := opts.promptFor(appFlag).SelectOne("Which application would you like to run locally?", "", names)
+			if err != nil {
+				return fmt.Errorf("select application: %w", err)
+			}
+			opts.AppName = name
+		}
+	}
+	if opts.EnvName == "" && !opts.Offline {
+		name, err := selectEnvironment(opts.promptFor(envFlag), opts.envStore, opts.ProjectName(),
+			fmt.Sprintf("Which environment's configuration of %s would you like to run with?", opts.AppName),
+			"The variables, secrets and overrides of the environment in the manifest are used.")
+		if err != nil {
+			return err
+		}
+		opts.EnvName = name
+	}
+	return nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (opts *RunLocalAppOpts) Validate() error {
+	if opts.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	if opts.Port < 0 || opts.Port > 65535 {
+		return fmt.Errorf("port %d must be between 0 and 65535", opts.Port)
+	}
+	return nil
+}
+
+// Execute builds the image of the application and runs it with the configuration of the environment
+// until the container exits.
+func (opts *RunLocalAppOpts) Execute() error {
+	mf, err := opts.readManifest()
+	if err != nil {
+		return err
+	}
+	overrides, err := opts.envFileOverrides()
+	if err != nil {
+		return err
+	}
+	conf := mf.EnvConf(opts.EnvName)
+	env, err := opts.containerEnv(conf, overrides)
+	if err != nil {
+		return err
+	}
+
+	image := fmt.Sprintf(fmtLocalImageName, opts.ProjectName(), opts.AppName)
+	buildPath := filepath.Dir(mf.DockerfilePath())
+	if err := opts.dockerService.Build(image, localImageTag, buildPath); err != nil {
+		return fmt.Errorf("build Dockerfile at %s with tag %s: %w", buildPath, localImageTag, err)
+	}
+	hostPort := opts.Port
+	if hostPort == 0 {
+		hostPort = mf.Image.Port
+	}
+	log.Infof("Running %s on %s.\n", color.HighlightUserInput(opts.AppName), color.HighlightResource(fmt.Sprintf("http://localhost:%d", hostPort)))
+	return opts.dockerService.Run(&docker.RunInput{
+		Image:         fmt.Sprintf("%s:%s", image, localImageTag),
+		HostPort:      hostPort,
+		ContainerPort: mf.Image.Port,
+		Env:           env,
+	})
+}
+
+func (opts *RunLocalAppOpts) readManifest() (*manifest.LBFargateManifest, error) {
+	data, err := opts.ws.ReadFile(opts.ws.AppManifestFileName(opts.AppName))
+	if err != nil {
+		return nil, fmt.Errorf("read manifest of application %s: %w", opts.AppName, err)
+	}
+	mf, err := manifest.UnmarshalApp(data)
+	if err != nil {
+		return nil, err
+	}
+	lbMf, ok := mf.(*manifest.LBFargateManifest)
+	if !ok {
+		return nil, fmt.Errorf("application %s cannot be run locally", opts.AppName)
+	}
+	return lbMf, nil
+}
+
+// envFileOverrides returns the values of the env file. The default env file is optional.
+func (opts *RunLocalAppOpts) envFileOverrides() (map[string]string, error) {
+	data, err := opts.readFile(opts.EnvFile)
+	if err != nil {
+		if os.IsNotExist(err) && opts.EnvFile == defaultEnvFile {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read env file %s: %w", opts.EnvFile, err)
+	}
+	overrides, err := parseEnvFile(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse env file %s: %w", opts.EnvFile, err)
+	}
+	return overrides, nil
+}
+
+// containerEnv merges the variables and resolved secrets of the application with the overrides of the env file.
+func (opts *RunLocalAppOpts) containerEnv(conf manifest.LBFargateConfig, overrides map[string]string) (map[string]string, error) {
+	env := make(map[string]string)
+	for k, v := range conf.Variables {
+		env[k] = v
+	}
+	for name, valueFrom := range conf.SecretsValueFrom(opts.ProjectName(), opts.EnvName) {
+		if _, ok := overrides[name]; ok {
+			continue
+		}
+		if opts.Offline {
+			return nil, fmt.Errorf("secret %s must be set in the env file to run offline", name)
+		}
+		if err := opts.initSecrets(); err != nil {
+			return nil, err
+		}
+		value, err := opts.secrets.Value(valueFrom)
+		if err != nil {
+			return nil, fmt.Errorf("resolve secret %s: %w", name, err)
+		}
+		env[name] = value
+	}
+	for k, v := range overrides {
+		env[k] = v
+	}
+	return env, nil
+}
+
+// initSecrets creates the client to read the secrets of the environment with its manager role.
+func (opts *RunLocalAppOpts) initSecrets() error {
+	if opts.secrets != nil {
+		// Tests mock the client.
+		return nil
+	}
+	env, err := opts.envStore.GetEnvironment(opts.ProjectName(), opts.EnvName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", opts.EnvName, err)
+	}
+	sess, err := opts.sessionProvider().FromEnvRole(env.Name, env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return fmt.Errorf("create session from role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	opts.secrets = secrets.New(sess)
+	return nil
+}
+
+// parseEnvFile parses "KEY=VALUE" lines. Empty lines and lines starting with "#" are ignored,
+// and values can be surrounded by quotes.
+func parseEnvFile(data []byte) (map[string]string, error) {
+	values := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, envFileCommentChar) {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("line %d is not in the KEY=VALUE format", lineNumber)
+		}
+		value := strings.TrimSpace(parts[1])
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		values[strings.TrimSpace(parts[0])] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// BuildAppRunLocalCmd builds the command for running an application locally.
+func BuildAppRunLocalCmd() *cobra.Command {
+	opts := &RunLocalAppOpts{
+		dockerService: docker.New(),
+		readFile:      ioutil.ReadFile,
+		GlobalOpts:    NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "local",
+		Short: "Runs an application locally with Docker.",
+		Long: `Builds the image of an application and runs it locally with Docker, with the port,
+variables and secrets of its manifest for an environment. The logs of the container are streamed to the terminal.
+Secrets are read from SSM Parameter Store or Secrets Manager unless they are set in the env file.`,
+		Example: `
+  Runs the "api" application with the configuration of the "test" environment.
+  /code $ archer app run local --app api --env test
+  Runs the "api" application without calling AWS, with the secrets of a local file.
+  /code $ archer app run local --app api --offline --env-file api.env`,
+		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			ws, err := workspace.New()
+			if err != nil {
+				return fmt.Errorf("new workspace: %w", err)
+			}
+			opts.ws = ws
+			if !opts.Offline {
+				store, err := store.New()
+				if err != nil {
+					return fmt.Errorf("connect to ecs-cli metadata store: %w", err)
+				}
+				opts.envStore = store
+			}
+			return opts.Validate()
+		}),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&opts.AppName, appFlag, appFlagShort, "", appFlagDescription)
+	cmd.Flags().StringVarP(&opts.EnvName, envFlag, envFlagShort, "", localEnvFlagDescription)
+	markFlagCompletion(cmd.Flags(), appFlag, completeApps)
+	markFlagCompletion(cmd.Flags(), envFlag, completeEnvs)
+	cmd.Flags().StringVar(&opts.EnvFile, envFileFlag, defaultEnvFile, envFileFlagDescription)
+	cmd.Flags().IntVar(&opts.Port, portFlag, 0, portFlagDescription)
+	cmd.Flags().BoolVar(&opts.Offline, offlineFlag, false, offlineFlagDescription)
+	return cmd
+}
@@ -0,0 +1,204 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/build/docker"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const localAppManifest = `name: api
+type: Load Balanced Web App
+image:
+  build: api/Dockerfile
+  port: 8080
+http:
+  path: '*'
+cpu: 256
+memory: 512
+count: 1
+variables:
+  LOG_LEVEL: info
+secrets:
+  DB_PASSWORD: /phonetool/db-password
+environments:
+  test:
+    variables:
+      LOG_LEVEL: debug
+`
+
+// fakeSecrets resolves secrets from memory so that tests never call AWS.
+type fakeSecrets map[string]string
+
+func (s fakeSecrets) Value(valueFrom string) (string, error) {
+	v, ok := s[valueFrom]
+	if !ok {
+		return "", errors.New("not found")
+	}
+	return v, nil
+}
+
+func TestRunLocalAppOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inOffline bool
+
+		setupMocks func(ws *climocks.MocklocalAppWorkspace, prompt *climocks.Mockprompter)
+
+		wantedApp string
+		wantedErr error
+	}{
+		"returns error if there are no applications in the workspace": {
+			setupMocks: func(ws *climocks.MocklocalAppWorkspace, prompt *climocks.Mockprompter) {
+				ws.EXPECT().AppNames().Return(nil, nil)
+			},
+			wantedErr: errors.New("no applications found in the workspace, please run `app init` first"),
+		},
+		"prompts for the application and skips the environment when offline": {
+			inOffline: true,
+			setupMocks: func(ws *climocks.MocklocalAppWorkspace, prompt *climocks.Mockprompter) {
+				ws.EXPECT().AppNames().Return([]string{"api", "frontend"}, nil)
+				prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), []string{"api", "frontend"}).Return("frontend", nil)
+			},
+			wantedApp: "frontend",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWs := climocks.NewMocklocalAppWorkspace(ctrl)
+			mockPrompt := climocks.NewMockprompter(ctrl)
+			tc.setupMocks(mockWs, mockPrompt)
+			opts := &RunLocalAppOpts{
+				Offline:    tc.inOffline,
+				ws:         mockWs,
+				GlobalOpts: &GlobalOpts{projectName: "phonetool", prompt: mockPrompt},
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.AppName)
+		})
+	}
+}
+
+func TestRunLocalAppOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inEnvName string
+		inEnvFile string
+		inPort    int
+		inOffline bool
+		envFile   string
+
+		setupDocker func(d *climocks.MocklocalDockerService)
+
+		wantedErr error
+	}{
+		"runs the image with the environment's variables and resolved secrets": {
+			inEnvName: "test",
+			inEnvFile: defaultEnvFile,
+			setupDocker: func(d *climocks.MocklocalDockerService) {
+				gomock.InOrder(
+					d.EXPECT().Build("phonetool/api", "local", "api").Return(nil),
+					d.EXPECT().Run(&docker.RunInput{
+						Image:         "phonetool/api:local",
+						HostPort:      8080,
+						ContainerPort: 8080,
+						Env: map[string]string{
+							"LOG_LEVEL":   "debug",
+							"DB_PASSWORD": "from-ssm",
+						},
+					}).Return(nil),
+				)
+			},
+		},
+		"runs offline with the values of the env file": {
+			inEnvFile: "api.env",
+			inPort:    3000,
+			inOffline: true,
+			envFile:   "# Local overrides.\nDB_PASSWORD=\"local-password\"\nexport LOG_LEVEL=trace\n",
+			setupDocker: func(d *climocks.MocklocalDockerService) {
+				d.EXPECT().Build("phonetool/api", "local", "api").Return(nil)
+				d.EXPECT().Run(&docker.RunInput{
+					Image:         "phonetool/api:local",
+					HostPort:      3000,
+					ContainerPort: 8080,
+					Env: map[string]string{
+						"LOG_LEVEL":   "trace",
+						"DB_PASSWORD": "local-password",
+					},
+				}).Return(nil)
+			},
+		},
+		"returns error if a secret is missing from the env file when offline": {
+			inEnvFile:   defaultEnvFile,
+			inOffline:   true,
+			setupDocker: func(d *climocks.MocklocalDockerService) {},
+			wantedErr:   errors.New("secret DB_PASSWORD must be set in the env file to run offline"),
+		},
+		"returns error if the env file is malformed": {
+			inEnvFile:   "api.env",
+			envFile:     "DB_PASSWORD\n",
+			setupDocker: func(d *climocks.MocklocalDockerService) {},
+			wantedErr:   errors.New("parse env file api.env: line 1 is not in the KEY=VALUE format"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWs := climocks.NewMocklocalAppWorkspace(ctrl)
+			mockWs.EXPECT().AppManifestFileName("api").Return("api-app.yml")
+			mockWs.EXPECT().ReadFile("api-app.yml").Return([]byte(localAppManifest), nil)
+			mockDocker := climocks.NewMocklocalDockerService(ctrl)
+			tc.setupDocker(mockDocker)
+
+			opts := &RunLocalAppOpts{
+				AppName:       "api",
+				EnvName:       tc.inEnvName,
+				EnvFile:       tc.inEnvFile,
+				Port:          tc.inPort,
+				Offline:       tc.inOffline,
+				ws:            mockWs,
+				secrets:       fakeSecrets{"/phonetool/db-password": "from-ssm"},
+				dockerService: mockDocker,
+				readFile: func(filename string) ([]byte, error) {
+					if tc.envFile == "" {
+						return nil, os.ErrNotExist
+					}
+					require.Equal(t, tc.inEnvFile, filename)
+					return []byte(tc.envFile), nil
+				},
+				GlobalOpts: &GlobalOpts{projectName: "phonetool"},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
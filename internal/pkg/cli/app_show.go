@@ -0,0 +1,182 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/output"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+type webAppDescriber interface {
+	Describe(env *archer.Environment, appName, rulePath string) (*describe.WebAppEnvironment, error)
+}
+
+// ShowAppOpts holds the configuration needed to show an application across environments.
+type ShowAppOpts struct {
+	// Fields with matching flags.
+	AppName          string
+	ShouldOutputJSON bool
+	OutputFormat     string
+
+	// Interfaces to interact with dependencies.
+	ws              archer.Workspace
+	store           projectService
+	resourcesGetter projectResourcesGetter
+	describer       webAppDescriber
+	w               io.Writer
+
+	*GlobalOpts
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (opts *ShowAppOpts) Ask() error {
+	if opts.AppName != "" {
+		return nil
+	}
+	name, err := selectApplication(opts.promptFor(nameFlag), opts.store, opts.ProjectName(),
+		"Which application would you like to show?",
+		"The URL, stack status and configuration of the application in each environment are shown.")
+	if err != nil {
+		return err
+	}
+	opts.AppName = name
+	return nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (opts *ShowAppOpts) Validate() error {
+	if opts.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	if err := output.Validate(outputFormat(opts.OutputFormat, opts.ShouldOutputJSON)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Execute prints the endpoints and configuration of the application in every environment of the project.
+func (opts *ShowAppOpts) Execute() error {
+	mft, err := opts.manifest()
+	if err != nil {
+		return err
+	}
+	proj, err := opts.store.GetProject(opts.ProjectName())
+	if err != nil {
+		return fmt.Errorf("get project %s: %w", opts.ProjectName(), err)
+	}
+	envs, err := opts.store.ListEnvironments(opts.ProjectName())
+	if err != nil {
+		return fmt.Errorf("list environments in project %s: %w", opts.ProjectName(), err)
+	}
+
+	app := &describe.WebApp{
+		Name:           opts.AppName,
+		Project:        opts.ProjectName(),
+		RepositoryURLs: make(map[string]string),
+	}
+	for _, env := range envs {
+		if _, ok := app.RepositoryURLs[env.Region]; !ok {
+			resources, err := opts.resourcesGetter.GetProjectResourcesByRegion(proj, env.Region)
+			if err != nil {
+				return fmt.Errorf("get project resources in region %s: %w", env.Region, err)
+			}
+			app.RepositoryURLs[env.Region] = resources.RepositoryURLs[opts.AppName]
+		}
+
+		conf := mft.EnvConf(env.Name)
+		desc, err := opts.describer.Describe(env, opts.AppName, conf.Path)
+		if err != nil {
+			return fmt.Errorf("describe application %s in environment %s: %w", opts.AppName, env.Name, err)
+		}
+		desc.Config = &describe.WebAppConfig{
+			Path:      conf.Path,
+			CPU:       conf.CPU,
+			Memory:    conf.Memory,
+			Count:     conf.Count,
+			Variables: conf.Variables,
+			Secrets:   conf.Secrets,
+		}
+		app.Environments = append(app.Environments, desc)
+	}
+
+	if err := output.Write(opts.w, outputFormat(opts.OutputFormat, opts.ShouldOutputJSON), app); err != nil {
+		return fmt.Errorf("write application %s: %w", opts.AppName, err)
+	}
+	return nil
+}
+
+func (opts *ShowAppOpts) manifest() (*manifest.LBFargateManifest, error) {
+	raw, err := opts.ws.ReadFile(opts.ws.AppManifestFileName(opts.AppName))
+	if err != nil {
+		return nil, fmt.Errorf("read manifest for application %s: %w", opts.AppName, err)
+	}
+	mft, err := manifest.UnmarshalApp(raw)
+	if err != nil {
+		return nil, err
+	}
+	lbMft, ok := mft.(*manifest.LBFargateManifest)
+	if !ok {
+		return nil, fmt.Errorf("application %s is not a %s", opts.AppName, manifest.LoadBalancedWebApplication)
+	}
+	return lbMft, nil
+}
+
+// BuildAppShowCmd builds the command for showing an application.
+func BuildAppShowCmd() *cobra.Command {
+	opts := &ShowAppOpts{
+		w:          os.Stdout,
+		describer:  describe.NewWebAppDescriber(),
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Shows info about an application.",
+		Long: `Shows the URL, stack status, last deployed image tag and configuration
+of an application in each environment of the project.
+Secrets are shown by the name of their parameter, not their value.`,
+		Example: `
+  Shows info about the "frontend" application.
+  /code $ archer app show --name frontend`,
+		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			ws, err := workspace.New()
+			if err != nil {
+				return fmt.Errorf("new workspace: %w", err)
+			}
+			opts.ws = ws
+
+			store, err := store.New()
+			if err != nil {
+				return fmt.Errorf("couldn't connect to project datastore: %w", err)
+			}
+			opts.store = store
+
+			sess, err := opts.sessionProvider().Default()
+			if err != nil {
+				return fmt.Errorf("error retrieving default session: %w", err)
+			}
+			opts.resourcesGetter = cloudformation.New(sess)
+			return opts.Validate()
+		}),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&opts.AppName, nameFlag, nameFlagShort, "", appFlagDescription)
+	markFlagCompletion(cmd.Flags(), nameFlag, completeApps)
+	addOutputFlags(cmd, &opts.OutputFormat, &opts.ShouldOutputJSON)
+	return cmd
+}
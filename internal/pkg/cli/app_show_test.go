@@ -0,0 +1,226 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/aws/amazon-ecs-cli-v2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShowAppOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName string
+
+		mockStore  func(m *climocks.MockprojectService)
+		mockPrompt func(m *climocks.Mockprompter)
+
+		wantedAppName string
+		wantedErr     error
+	}{
+		"skips prompt if the flag is set": {
+			inAppName:     "frontend",
+			mockStore:     func(m *climocks.MockprojectService) {},
+			mockPrompt:    func(m *climocks.Mockprompter) {},
+			wantedAppName: "frontend",
+		},
+		"prompts for the application": {
+			mockStore: func(m *climocks.MockprojectService) {
+				m.EXPECT().ListApplications("phonetool").Return([]*archer.Application{{Name: "frontend"}, {Name: "backend"}}, nil)
+			},
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().SelectOne("Which application would you like to show?", gomock.Any(), []string{"frontend", "backend"}).
+					Return("backend", nil)
+			},
+			wantedAppName: "backend",
+		},
+		"returns error if there are no applications": {
+			mockStore: func(m *climocks.MockprojectService) {
+				m.EXPECT().ListApplications("phonetool").Return(nil, nil)
+			},
+			mockPrompt: func(m *climocks.Mockprompter) {},
+			wantedErr:  errNoAppsInProject,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := climocks.NewMockprojectService(ctrl)
+			mockPrompt := climocks.NewMockprompter(ctrl)
+			tc.mockStore(mockStore)
+			tc.mockPrompt(mockPrompt)
+
+			opts := &ShowAppOpts{
+				AppName: tc.inAppName,
+				store:   mockStore,
+				GlobalOpts: &GlobalOpts{
+					projectName: "phonetool",
+					prompt:      mockPrompt,
+				},
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedAppName, opts.AppName)
+		})
+	}
+}
+
+func TestShowAppOpts_Execute(t *testing.T) {
+	const manifest = `name: frontend
+type: 'Load Balanced Web App'
+image:
+  build: frontend/Dockerfile
+  port: 80
+http:
+  path: '*'
+cpu: 256
+memory: 512
+count: 1
+variables:
+  LOG_LEVEL: info
+secrets:
+  GITHUB_TOKEN: GH_TOKEN_SECRET
+environments:
+  prod:
+    count: 3
+    variables:
+      LOG_LEVEL: warn
+`
+	proj := &archer.Project{Name: "phonetool"}
+	testEnv := &archer.Environment{Project: "phonetool", Name: "test", Region: "us-west-2"}
+	prodEnv := &archer.Environment{Project: "phonetool", Name: "prod", Region: "us-west-2"}
+	mockErr := errors.New("some error")
+
+	testCases := map[string]struct {
+		inJSON bool
+
+		mockWorkspace func(m *mocks.MockWorkspace)
+		mockStore     func(m *climocks.MockprojectService)
+		mockResources func(m *climocks.MockprojectResourcesGetter)
+		mockDescriber func(m *climocks.MockwebAppDescriber)
+
+		wantedOutput string
+		wantedErr    error
+	}{
+		"wraps error if the manifest cannot be read": {
+			mockWorkspace: func(m *mocks.MockWorkspace) {
+				m.EXPECT().AppManifestFileName("frontend").Return("frontend-app.yml")
+				m.EXPECT().ReadFile("frontend-app.yml").Return(nil, mockErr)
+			},
+			mockStore:     func(m *climocks.MockprojectService) {},
+			mockResources: func(m *climocks.MockprojectResourcesGetter) {},
+			mockDescriber: func(m *climocks.MockwebAppDescriber) {},
+			wantedErr:     errors.New("read manifest for application frontend: some error"),
+		},
+		"wraps error if the application cannot be described": {
+			mockWorkspace: func(m *mocks.MockWorkspace) {
+				m.EXPECT().AppManifestFileName("frontend").Return("frontend-app.yml")
+				m.EXPECT().ReadFile("frontend-app.yml").Return([]byte(manifest), nil)
+			},
+			mockStore: func(m *climocks.MockprojectService) {
+				m.EXPECT().GetProject("phonetool").Return(proj, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return([]*archer.Environment{testEnv}, nil)
+			},
+			mockResources: func(m *climocks.MockprojectResourcesGetter) {
+				m.EXPECT().GetProjectResourcesByRegion(proj, "us-west-2").Return(&archer.ProjectRegionalResources{}, nil)
+			},
+			mockDescriber: func(m *climocks.MockwebAppDescriber) {
+				m.EXPECT().Describe(testEnv, "frontend", "*").Return(nil, mockErr)
+			},
+			wantedErr: errors.New("describe application frontend in environment test: some error"),
+		},
+		"prints the application in JSON": {
+			inJSON: true,
+			mockWorkspace: func(m *mocks.MockWorkspace) {
+				m.EXPECT().AppManifestFileName("frontend").Return("frontend-app.yml")
+				m.EXPECT().ReadFile("frontend-app.yml").Return([]byte(manifest), nil)
+			},
+			mockStore: func(m *climocks.MockprojectService) {
+				m.EXPECT().GetProject("phonetool").Return(proj, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return([]*archer.Environment{testEnv, prodEnv}, nil)
+			},
+			mockResources: func(m *climocks.MockprojectResourcesGetter) {
+				m.EXPECT().GetProjectResourcesByRegion(proj, "us-west-2").Return(&archer.ProjectRegionalResources{
+					RepositoryURLs: map[string]string{
+						"frontend": "1234567890.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend",
+					},
+				}, nil).Times(1)
+			},
+			mockDescriber: func(m *climocks.MockwebAppDescriber) {
+				m.EXPECT().Describe(testEnv, "frontend", "*").Return(&describe.WebAppEnvironment{
+					Name:        "test",
+					URL:         "http://abc.us-west-2.elb.amazonaws.com",
+					StackStatus: "UPDATE_COMPLETE",
+					ImageTag:    "manual-bf3678c",
+				}, nil)
+				m.EXPECT().Describe(prodEnv, "frontend", "*").Return(&describe.WebAppEnvironment{
+					Name:        "prod",
+					StackStatus: describe.StackStatusNotDeployed,
+				}, nil)
+			},
+			wantedOutput: `{"name":"frontend","project":"phonetool","repositoryURLs":{"us-west-2":"1234567890.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend"},` +
+				`"environments":[{"name":"test","url":"http://abc.us-west-2.elb.amazonaws.com","stackStatus":"UPDATE_COMPLETE","imageTag":"manual-bf3678c",` +
+				`"config":{"path":"*","cpu":256,"memory":512,"count":1,"variables":{"LOG_LEVEL":"info"},"secrets":{"GITHUB_TOKEN":"GH_TOKEN_SECRET"}}},` +
+				`{"name":"prod","url":"","stackStatus":"NOT_DEPLOYED","imageTag":"",` +
+				`"config":{"path":"*","cpu":256,"memory":512,"count":3,"variables":{"LOG_LEVEL":"warn"},"secrets":{"GITHUB_TOKEN":"GH_TOKEN_SECRET"}}}]}` + "\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWorkspace := mocks.NewMockWorkspace(ctrl)
+			mockStore := climocks.NewMockprojectService(ctrl)
+			mockResources := climocks.NewMockprojectResourcesGetter(ctrl)
+			mockDescriber := climocks.NewMockwebAppDescriber(ctrl)
+			tc.mockWorkspace(mockWorkspace)
+			tc.mockStore(mockStore)
+			tc.mockResources(mockResources)
+			tc.mockDescriber(mockDescriber)
+			b := &bytes.Buffer{}
+
+			opts := &ShowAppOpts{
+				AppName:          "frontend",
+				ShouldOutputJSON: tc.inJSON,
+				ws:               mockWorkspace,
+				store:            mockStore,
+				resourcesGetter:  mockResources,
+				describer:        mockDescriber,
+				w:                b,
+				GlobalOpts:       &GlobalOpts{projectName: "phonetool"},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
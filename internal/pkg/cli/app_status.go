@@ -0,0 +1,135 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/output"
+	"github.com/spf13/cobra"
+)
+
+type appStatusDescriber interface {
+	Describe(projectName, envName, appName string) (*describe.AppStatus, error)
+}
+
+// AppStatusOpts holds the configuration needed to show the status of an application.
+type AppStatusOpts struct {
+	// Fields with matching flags.
+	AppName          string
+	EnvName          string
+	ShouldOutputJSON bool
+	OutputFormat     string
+
+	// Interfaces to interact with dependencies.
+	appLister archer.ApplicationLister
+	envStore  archer.EnvironmentStore
+	describer appStatusDescriber
+	w         io.Writer
+
+	*GlobalOpts
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (opts *AppStatusOpts) Ask() error {
+	if opts.AppName == "" {
+		name, err := selectApplication(opts.promptFor(nameFlag), opts.appLister, opts.ProjectName(),
+			"Which application's status would you like to show?",
+			"The status of the application's service is shown.")
+		if err != nil {
+			return err
+		}
+		opts.AppName = name
+	}
+	if opts.EnvName == "" {
+		name, err := selectEnvironment(opts.promptFor(envFlag), opts.envStore, opts.ProjectName(),
+			fmt.Sprintf("Which environment of %s would you like to show the status of?", opts.AppName),
+			"The status of the application's service running in this environment is shown.")
+		if err != nil {
+			return err
+		}
+		opts.EnvName = name
+	}
+	return nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (opts *AppStatusOpts) Validate() error {
+	if opts.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	if err := output.Validate(outputFormat(opts.OutputFormat, opts.ShouldOutputJSON)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Execute prints the status of the application's service in the environment.
+func (opts *AppStatusOpts) Execute() error {
+	env, err := opts.envStore.GetEnvironment(opts.ProjectName(), opts.EnvName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", opts.EnvName, err)
+	}
+	if opts.describer == nil {
+		// Tests mock the client.
+		sess, err := opts.sessionProvider().FromEnvRole(env.Name, env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return fmt.Errorf("create session from role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		opts.describer = describe.NewAppStatusDescriber(sess)
+	}
+
+	status, err := opts.describer.Describe(opts.ProjectName(), opts.EnvName, opts.AppName)
+	if err != nil {
+		return fmt.Errorf("describe status of application %s in environment %s: %w", opts.AppName, opts.EnvName, err)
+	}
+	if err := output.Write(opts.w, outputFormat(opts.OutputFormat, opts.ShouldOutputJSON), status); err != nil {
+		return fmt.Errorf("write status of application %s: %w", opts.AppName, err)
+	}
+	return nil
+}
+
+// BuildAppStatusCmd builds the command for showing the status of an application.
+func BuildAppStatusCmd() *cobra.Command {
+	opts := &AppStatusOpts{
+		w:          os.Stdout,
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Shows the status of an application.",
+		Long: `Shows the status of an application's service in an environment.
+The status includes the number of tasks, the deployments, the health of the tasks in the load balancer,
+the alarms in the ALARM state and the latest service events.`,
+		Example: `
+  Shows the status of the "frontend" application in the "test" environment.
+  /code $ archer app status --name frontend --env test`,
+		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			store, err := store.New()
+			if err != nil {
+				return fmt.Errorf("couldn't connect to project datastore: %w", err)
+			}
+			opts.appLister = store
+			opts.envStore = store
+			return opts.Validate()
+		}),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&opts.AppName, nameFlag, nameFlagShort, "", appFlagDescription)
+	cmd.Flags().StringVarP(&opts.EnvName, envFlag, envFlagShort, "", envFlagDescription)
+	markFlagCompletion(cmd.Flags(), nameFlag, completeApps)
+	markFlagCompletion(cmd.Flags(), envFlag, completeEnvs)
+	addOutputFlags(cmd, &opts.OutputFormat, &opts.ShouldOutputJSON)
+	return cmd
+}
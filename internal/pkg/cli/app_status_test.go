@@ -0,0 +1,198 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/aws/amazon-ecs-cli-v2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppStatusOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName string
+		inEnvName string
+
+		mockAppLister func(m *mocks.MockApplicationLister)
+		mockEnvStore  func(m *mocks.MockEnvironmentStore)
+		mockPrompt    func(m *climocks.Mockprompter)
+
+		wantedAppName string
+		wantedEnvName string
+		wantedErr     error
+	}{
+		"skips prompts if flags are set": {
+			inAppName:     "frontend",
+			inEnvName:     "test",
+			mockAppLister: func(m *mocks.MockApplicationLister) {},
+			mockEnvStore:  func(m *mocks.MockEnvironmentStore) {},
+			mockPrompt:    func(m *climocks.Mockprompter) {},
+			wantedAppName: "frontend",
+			wantedEnvName: "test",
+		},
+		"prompts for the application and environment": {
+			mockAppLister: func(m *mocks.MockApplicationLister) {
+				m.EXPECT().ListApplications("phonetool").Return([]*archer.Application{{Name: "frontend"}, {Name: "backend"}}, nil)
+			},
+			mockEnvStore: func(m *mocks.MockEnvironmentStore) {
+				m.EXPECT().ListEnvironments("phonetool").Return([]*archer.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+			},
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().SelectOne("Which application's status would you like to show?", gomock.Any(), []string{"frontend", "backend"}).
+					Return("frontend", nil)
+				m.EXPECT().SelectOne("Which environment of frontend would you like to show the status of?", gomock.Any(), []string{"test", "prod"}).
+					Return("prod", nil)
+			},
+			wantedAppName: "frontend",
+			wantedEnvName: "prod",
+		},
+		"wraps prompt error": {
+			mockAppLister: func(m *mocks.MockApplicationLister) {
+				m.EXPECT().ListApplications("phonetool").Return([]*archer.Application{{Name: "frontend"}, {Name: "backend"}}, nil)
+			},
+			mockEnvStore: func(m *mocks.MockEnvironmentStore) {},
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("failed to select application: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAppLister := mocks.NewMockApplicationLister(ctrl)
+			mockEnvStore := mocks.NewMockEnvironmentStore(ctrl)
+			mockPrompt := climocks.NewMockprompter(ctrl)
+			tc.mockAppLister(mockAppLister)
+			tc.mockEnvStore(mockEnvStore)
+			tc.mockPrompt(mockPrompt)
+
+			opts := &AppStatusOpts{
+				AppName:   tc.inAppName,
+				EnvName:   tc.inEnvName,
+				appLister: mockAppLister,
+				envStore:  mockEnvStore,
+				GlobalOpts: &GlobalOpts{
+					projectName: "phonetool",
+					prompt:      mockPrompt,
+				},
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedAppName, opts.AppName)
+			require.Equal(t, tc.wantedEnvName, opts.EnvName)
+		})
+	}
+}
+
+func TestAppStatusOpts_Execute(t *testing.T) {
+	status := &describe.AppStatus{
+		Service: describe.ServiceStatus{
+			Name:                   "phonetool-test-frontend-Service",
+			Status:                 "ACTIVE",
+			DesiredCount:           1,
+			RunningCount:           1,
+			TaskDefinitionRevision: 3,
+			ImageTag:               "manual-bf3678c",
+		},
+	}
+
+	testCases := map[string]struct {
+		inJSON bool
+
+		mockEnvStore  func(m *mocks.MockEnvironmentStore)
+		mockDescriber func(m *climocks.MockappStatusDescriber)
+
+		wantedOutput string
+		wantedErr    error
+	}{
+		"wraps error if the environment cannot be retrieved": {
+			mockEnvStore: func(m *mocks.MockEnvironmentStore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			mockDescriber: func(m *climocks.MockappStatusDescriber) {},
+			wantedErr:     errors.New("get environment test: some error"),
+		},
+		"wraps error if the status cannot be described": {
+			mockEnvStore: func(m *mocks.MockEnvironmentStore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&archer.Environment{Name: "test"}, nil)
+			},
+			mockDescriber: func(m *climocks.MockappStatusDescriber) {
+				m.EXPECT().Describe("phonetool", "test", "frontend").Return(nil, &describe.ErrServiceNotFound{AppName: "frontend", EnvName: "test"})
+			},
+			wantedErr: errors.New("describe status of application frontend in environment test: couldn't find a service for application frontend in environment test, has it been deployed?"),
+		},
+		"prints the status in JSON": {
+			inJSON: true,
+			mockEnvStore: func(m *mocks.MockEnvironmentStore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&archer.Environment{Name: "test"}, nil)
+			},
+			mockDescriber: func(m *climocks.MockappStatusDescriber) {
+				m.EXPECT().Describe("phonetool", "test", "frontend").Return(status, nil)
+			},
+			wantedOutput: `{"service":{"name":"phonetool-test-frontend-Service","status":"ACTIVE","desiredCount":1,"runningCount":1,"pendingCount":0,"taskDefinitionRevision":3,"imageTag":"manual-bf3678c"},"deployments":null,"targets":null,"alarms":null,"events":null}` + "\n",
+		},
+		"prints the status for humans": {
+			mockEnvStore: func(m *mocks.MockEnvironmentStore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&archer.Environment{Name: "test"}, nil)
+			},
+			mockDescriber: func(m *climocks.MockappStatusDescriber) {
+				m.EXPECT().Describe("phonetool", "test", "frontend").Return(status, nil)
+			},
+			wantedOutput: status.HumanString(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockEnvStore := mocks.NewMockEnvironmentStore(ctrl)
+			mockDescriber := climocks.NewMockappStatusDescriber(ctrl)
+			tc.mockEnvStore(mockEnvStore)
+			tc.mockDescriber(mockDescriber)
+			b := &bytes.Buffer{}
+
+			opts := &AppStatusOpts{
+				AppName:          "frontend",
+				EnvName:          "test",
+				ShouldOutputJSON: tc.inJSON,
+				envStore:         mockEnvStore,
+				describer:        mockDescriber,
+				w:                b,
+				GlobalOpts:       &GlobalOpts{projectName: "phonetool"},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
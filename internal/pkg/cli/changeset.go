@@ -0,0 +1,52 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	termprogress "github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/progress"
+)
+
+// reviewChangeSet renders the changes of the change set and returns true if the user confirms that they should be applied,
+// or if the confirmation is skipped. Otherwise, or in dry-run mode, the change set is deleted so that it's never applied.
+func reviewChangeSet(w io.Writer, p prompter, executor changeSetExecutor, cs *deploy.ChangeSet, title, confirmMsg string, dryRun, skipConfirmation bool) (bool, error) {
+	renderChanges(w, title, cs.Changes)
+	if !dryRun {
+		if skipConfirmation {
+			return true, nil
+		}
+		confirmed, err := p.Confirm(confirmMsg, "The stack is updated with the changes above.")
+		if err != nil {
+			return false, fmt.Errorf("confirm changes to stack %s: %w", cs.StackName, err)
+		}
+		if confirmed {
+			return true, nil
+		}
+	}
+	if err := executor.DeleteChangeSet(cs); err != nil {
+		return false, fmt.Errorf("delete change set of stack %s: %w", cs.StackName, err)
+	}
+	log.Infof("No changes were applied to stack %s.\n", color.HighlightResource(cs.StackName))
+	return false, nil
+}
+
+// renderChanges writes the resource changes of a deployment as a table under the title, so that they can be
+// reviewed before the deployment is confirmed.
+func renderChanges(w io.Writer, title string, changes []*deploy.ResourceChange) {
+	writer := termprogress.NewTabWriter(w)
+	fmt.Fprintf(writer, "\n%s\n", color.HighlightResource(title))
+	fmt.Fprintf(writer, "  Action\tLogical ID\tType\tReplacement\tProperties\n")
+	for _, change := range changes {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", change.Action, change.LogicalName, change.Type,
+			valueOrDash(change.Replacement), valueOrDash(strings.Join(change.Properties, ", ")))
+	}
+	fmt.Fprintln(writer)
+	writer.Flush()
+}
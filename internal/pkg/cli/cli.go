@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/session"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/prompt"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/workspace"
+	awssession "github.com/aws/aws-sdk-go/aws/session"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -20,10 +22,20 @@ func init() {
 	bindProjectName()
 }
 
+// sessionProvider creates the AWS sessions of commands with the credentials chosen by the user.
+type sessionProvider interface {
+	Default() (*awssession.Session, error)
+	DefaultWithRegion(region string) (*awssession.Session, error)
+	FromProfile(name string) (*awssession.Session, error)
+	FromEnvRole(envName, roleARN, region string) (*awssession.Session, error)
+}
+
 // GlobalOpts holds fields that are used across multiple commands.
 type GlobalOpts struct {
-	projectName string
-	prompt      prompter
+	projectName  string
+	prompt       prompter
+	noPrompt     bool            // true means prompts are disabled even if the --no-prompt flag isn't set.
+	sessProvider sessionProvider // nil means sessions are created by the provider configured with the global flags.
 }
 
 // NewGlobalOpts returns a GlobalOpts with the project name retrieved from viper.
@@ -44,6 +56,88 @@ func (o *GlobalOpts) ProjectName() string {
 	return o.projectName
 }
 
+// sessionProvider returns the provider of the AWS sessions of the command.
+// It can only be called once the flags are parsed, since the global flags configure the credentials.
+func (o *GlobalOpts) sessionProvider() sessionProvider {
+	if o.sessProvider != nil {
+		return o.sessProvider
+	}
+	return session.DefaultProvider()
+}
+
+// interactive returns true if the command can prompt for the values of missing flags.
+func (o *GlobalOpts) interactive() bool {
+	return !o.noPrompt && !promptsDisabled()
+}
+
+// promptFor returns the prompter to ask for the value of a flag that wasn't set.
+// In non-interactive mode, the prompter fails with an error naming the flag instead.
+func (o *GlobalOpts) promptFor(flag string) prompter {
+	if !o.interactive() {
+		return &noPrompter{err: &ErrMissingFlag{Flag: flag}}
+	}
+	return o.prompt
+}
+
+// promptForArg is like promptFor for a positional argument of the command.
+func (o *GlobalOpts) promptForArg(arg string) prompter {
+	if !o.interactive() {
+		return &noPrompter{err: &ErrMissingArg{Arg: arg}}
+	}
+	return o.prompt
+}
+
+// AddGlobalFlags adds the flags shared by every command to the root command.
+func AddGlobalFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().Bool(noPromptFlag, !isTerminalSession(), noPromptFlagDescription)
+	viper.BindPFlag(noPromptFlag, cmd.PersistentFlags().Lookup(noPromptFlag))
+	cmd.PersistentFlags().String(profileFlag, "", profileFlagDescription)
+	viper.BindPFlag(profileFlag, cmd.PersistentFlags().Lookup(profileFlag))
+	cmd.PersistentFlags().String(regionFlag, "", regionFlagDescription)
+	viper.BindPFlag(regionFlag, cmd.PersistentFlags().Lookup(regionFlag))
+
+	// Sessions are configured once the flags are parsed, before any command creates its clients.
+	cobra.OnInitialize(configureSessions)
+}
+
+// configureSessions sets the provider of AWS sessions from the --profile and --region flags,
+// and the profiles of environments recorded in the workspace.
+func configureSessions() {
+	session.SetDefaultProvider(session.NewProvider(viper.GetString(profileFlag), viper.GetString(regionFlag), loadEnvProfiles()))
+}
+
+// loadEnvProfiles returns the AWS profiles of environments recorded in the workspace.
+// If there is an error, we swallow the error and environments are managed with the default credentials.
+func loadEnvProfiles() map[string]string {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil
+	}
+	profiles, err := ws.EnvProfiles()
+	if err != nil {
+		return nil
+	}
+	return profiles
+}
+
+// promptsDisabled returns true if the --no-prompt flag is set, or defaulted to true because
+// the CLI doesn't run in a terminal.
+func promptsDisabled() bool {
+	return viper.GetBool(noPromptFlag)
+}
+
+// isTerminalSession returns false if the CLI runs in CI or if its standard input isn't a terminal.
+func isTerminalSession() bool {
+	if os.Getenv("CI") == "true" {
+		return false
+	}
+	info, err := os.Stdin.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
 // actionCommand is the interface that every command that creates a resource implements.
 type actionCommand interface {
 	Ask() error
@@ -0,0 +1,354 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// Kinds of values completed dynamically from the workspace or the store.
+const (
+	completeApps     = "apps"
+	completeEnvs     = "envs"
+	completeProjects = "projects"
+)
+
+const (
+	completeCmdName = "__complete"
+	// completionAnnotation is the flag or command annotation holding the kind of values of the flag or positional argument.
+	completionAnnotation = "archer_completion"
+	// bashCompleteFunc is the function of the bash completion script completing flag values with the complete command.
+	bashCompleteFunc = "__archer_complete"
+
+	// completionCacheTTL is how long the values fetched from the store are reused between key presses.
+	completionCacheTTL = 30 * time.Second
+)
+
+type appNamesLister interface {
+	AppNames() ([]string, error)
+}
+
+type completionStore interface {
+	archer.EnvironmentLister
+	archer.ProjectLister
+}
+
+// markFlagCompletion sets the kind of values to complete for a flag of the flag set.
+func markFlagCompletion(flags *pflag.FlagSet, name, kind string) {
+	flags.SetAnnotation(name, completionAnnotation, []string{kind})
+	flags.SetAnnotation(name, cobra.BashCompCustom, []string{bashCompleteFunc})
+}
+
+// markArgsCompletion sets the kind of values to complete for the positional argument of a command.
+func markArgsCompletion(cmd *cobra.Command, kind string) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = make(map[string]string)
+	}
+	cmd.Annotations[completionAnnotation] = kind
+}
+
+// CompleteOpts contains the fields needed to complete a command line.
+type CompleteOpts struct {
+	Words []string // Words following the root command, the last one is the word being completed.
+
+	root  *cobra.Command
+	w     io.Writer
+	ws    appNamesLister  // nil outside of a workspace.
+	store completionStore // nil if there is no AWS session.
+	cache *completionCache
+}
+
+type completion struct {
+	value       string
+	description string
+}
+
+// Execute writes the completions of the last word to the writer, one per line.
+// A completion is followed by a tab and its description if it has one.
+func (opts *CompleteOpts) Execute() error {
+	if len(opts.Words) == 0 {
+		return nil
+	}
+	toComplete := opts.Words[len(opts.Words)-1]
+	previous := opts.Words[:len(opts.Words)-1]
+	cmd, args, err := opts.root.Find(previous)
+	if err != nil {
+		// There is nothing to complete after an unknown command.
+		return nil
+	}
+	completions, err := opts.complete(cmd, args, toComplete)
+	if err != nil {
+		return err
+	}
+	for _, c := range completions {
+		if !strings.HasPrefix(c.value, toComplete) {
+			continue
+		}
+		if c.description == "" {
+			fmt.Fprintln(opts.w, c.value)
+			continue
+		}
+		fmt.Fprintf(opts.w, "%s\t%s\n", c.value, c.description)
+	}
+	return nil
+}
+
+func (opts *CompleteOpts) complete(cmd *cobra.Command, args []string, toComplete string) ([]completion, error) {
+	flags := allFlags(cmd)
+	if len(args) > 0 {
+		last := args[len(args)-1]
+		if flag := lookupFlag(flags, last); flag != nil && flag.NoOptDefVal == "" && !strings.Contains(last, "=") {
+			return opts.flagValues(flag, "")
+		}
+	}
+	if strings.HasPrefix(toComplete, "-") {
+		if i := strings.Index(toComplete, "="); i != -1 {
+			flag := lookupFlag(flags, toComplete[:i])
+			if flag == nil {
+				return nil, nil
+			}
+			return opts.flagValues(flag, toComplete[:i+1])
+		}
+		return flagNames(flags), nil
+	}
+	if cmd.HasAvailableSubCommands() {
+		return subcommandNames(cmd), nil
+	}
+	kind, ok := cmd.Annotations[completionAnnotation]
+	if !ok || len(positionalArgs(flags, args)) > 0 {
+		return nil, nil
+	}
+	return opts.values(kind, "")
+}
+
+func (opts *CompleteOpts) flagValues(flag *pflag.Flag, prefix string) ([]completion, error) {
+	kinds := flag.Annotations[completionAnnotation]
+	if len(kinds) == 0 {
+		return nil, nil
+	}
+	return opts.values(kinds[0], prefix)
+}
+
+func (opts *CompleteOpts) values(kind, prefix string) ([]completion, error) {
+	var names []string
+	var err error
+	switch kind {
+	case completeApps:
+		names, err = opts.appNames()
+	case completeEnvs:
+		names, err = opts.envNames()
+	case completeProjects:
+		names, err = opts.projectNames()
+	}
+	if err != nil {
+		return nil, err
+	}
+	var completions []completion
+	for _, name := range names {
+		completions = append(completions, completion{value: prefix + name})
+	}
+	return completions, nil
+}
+
+// appNames reads the app names from the local manifests, they're not cached.
+func (opts *CompleteOpts) appNames() ([]string, error) {
+	if opts.ws == nil {
+		return nil, nil
+	}
+	names, err := opts.ws.AppNames()
+	if err != nil {
+		return nil, fmt.Errorf("list applications in workspace: %w", err)
+	}
+	return names, nil
+}
+
+func (opts *CompleteOpts) envNames() ([]string, error) {
+	project := opts.projectName()
+	if opts.store == nil || project == "" {
+		return nil, nil
+	}
+	return opts.cache.get(completeEnvs+"-"+project, func() ([]string, error) {
+		envs, err := opts.store.ListEnvironments(project)
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, env := range envs {
+			names = append(names, env.Name)
+		}
+		return names, nil
+	})
+}
+
+func (opts *CompleteOpts) projectNames() ([]string, error) {
+	if opts.store == nil {
+		return nil, nil
+	}
+	return opts.cache.get(completeProjects, func() ([]string, error) {
+		projects, err := opts.store.ListProjects()
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, project := range projects {
+			names = append(names, project.Name)
+		}
+		return names, nil
+	})
+}
+
+// projectName returns the value of the project flag in the command line, or the project of the workspace.
+func (opts *CompleteOpts) projectName() string {
+	for i, word := range opts.Words[:len(opts.Words)-1] {
+		switch {
+		case word == "--"+projectFlag || word == "-"+projectFlagShort:
+			if i+1 < len(opts.Words)-1 {
+				return opts.Words[i+1]
+			}
+		case strings.HasPrefix(word, "--"+projectFlag+"="):
+			return strings.TrimPrefix(word, "--"+projectFlag+"=")
+		}
+	}
+	return viper.GetString(projectFlag)
+}
+
+// allFlags returns the local and inherited flags of the command.
+func allFlags(cmd *cobra.Command) *pflag.FlagSet {
+	flags := pflag.NewFlagSet(cmd.Name(), pflag.ContinueOnError)
+	flags.AddFlagSet(cmd.LocalFlags())
+	flags.AddFlagSet(cmd.InheritedFlags())
+	return flags
+}
+
+// lookupFlag returns the flag named by a word of the command line such as "--env", "-e" or "--env=test".
+func lookupFlag(flags *pflag.FlagSet, word string) *pflag.Flag {
+	name := strings.SplitN(word, "=", 2)[0]
+	switch {
+	case strings.HasPrefix(name, "--"):
+		return flags.Lookup(name[2:])
+	case strings.HasPrefix(name, "-") && len(name) == 2:
+		return flags.ShorthandLookup(name[1:])
+	}
+	return nil
+}
+
+func flagNames(flags *pflag.FlagSet) []completion {
+	var completions []completion
+	flags.VisitAll(func(flag *pflag.Flag) {
+		if flag.Hidden || flag.Deprecated != "" {
+			return
+		}
+		completions = append(completions, completion{value: "--" + flag.Name, description: flag.Usage})
+	})
+	return completions
+}
+
+func subcommandNames(cmd *cobra.Command) []completion {
+	var completions []completion
+	for _, sub := range cmd.Commands() {
+		if !sub.IsAvailableCommand() {
+			continue
+		}
+		completions = append(completions, completion{value: sub.Name(), description: sub.Short})
+	}
+	return completions
+}
+
+// positionalArgs returns the arguments that are neither flags nor flag values.
+func positionalArgs(flags *pflag.FlagSet, args []string) []string {
+	var positional []string
+	for i := 0; i < len(args); i++ {
+		if !strings.HasPrefix(args[i], "-") {
+			positional = append(positional, args[i])
+			continue
+		}
+		if flag := lookupFlag(flags, args[i]); flag != nil && flag.NoOptDefVal == "" && !strings.Contains(args[i], "=") {
+			i++ // Skip the flag value.
+		}
+	}
+	return positional
+}
+
+// completionCache stores completion values in files so that consecutive key presses don't call the store again.
+type completionCache struct {
+	fs  afero.Fs
+	dir string
+	ttl time.Duration
+	now func() time.Time
+}
+
+func newCompletionCache() *completionCache {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return &completionCache{
+		fs:  afero.NewOsFs(),
+		dir: filepath.Join(dir, "archer", "completion"),
+		ttl: completionCacheTTL,
+		now: time.Now,
+	}
+}
+
+// get returns the values cached under the key if they're recent enough, otherwise it fetches and caches them.
+func (c *completionCache) get(key string, fetch func() ([]string, error)) ([]string, error) {
+	path := filepath.Join(c.dir, key)
+	if info, err := c.fs.Stat(path); err == nil && c.now().Sub(info.ModTime()) < c.ttl {
+		if content, err := afero.ReadFile(c.fs, path); err == nil {
+			if len(content) == 0 {
+				return nil, nil
+			}
+			return strings.Split(string(content), "\n"), nil
+		}
+	}
+	values, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	// Failing to cache the values only makes the next completion slower.
+	if err := c.fs.MkdirAll(c.dir, 0755); err == nil {
+		_ = afero.WriteFile(c.fs, path, []byte(strings.Join(values, "\n")), 0644)
+	}
+	return values, nil
+}
+
+// BuildCompleteCmd returns the hidden command called by the completion scripts to complete a command line.
+func BuildCompleteCmd(rootCmd *cobra.Command) *cobra.Command {
+	opts := &CompleteOpts{}
+	cmd := &cobra.Command{
+		Use:    completeCmdName + " [words]",
+		Short:  "Output the completions of the last word of a command line.",
+		Hidden: true,
+		// The words are the command line to complete, not flags of this command.
+		DisableFlagParsing: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Words = args
+			opts.root = rootCmd
+			opts.w = os.Stdout
+			// Static completions work outside of a workspace and without AWS credentials.
+			if ws, err := workspace.New(); err == nil {
+				opts.ws = ws
+			}
+			if store, err := store.New(); err == nil {
+				opts.store = store
+			}
+			opts.cache = newCompletionCache()
+			return opts.Execute()
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,202 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func newCompletionTestCmd() *cobra.Command {
+	root := &cobra.Command{Use: "archer"}
+	root.PersistentFlags().Bool(noPromptFlag, false, noPromptFlagDescription)
+
+	env := &cobra.Command{Use: "env", Short: "Environment commands."}
+	env.PersistentFlags().StringP(projectFlag, projectFlagShort, "", projectFlagDescription)
+	markFlagCompletion(env.PersistentFlags(), projectFlag, completeProjects)
+	envDelete := &cobra.Command{Use: "delete [name]", Short: "Deletes an environment.", Run: func(*cobra.Command, []string) {}}
+	envDelete.Flags().Bool(yesFlag, false, yesFlagDescription)
+	markArgsCompletion(envDelete, completeEnvs)
+	env.AddCommand(envDelete)
+
+	app := &cobra.Command{Use: "app", Short: "Application commands."}
+	appShow := &cobra.Command{Use: "show", Short: "Shows info about an application.", Run: func(*cobra.Command, []string) {}}
+	appShow.Flags().StringP(nameFlag, nameFlagShort, "", appFlagDescription)
+	markFlagCompletion(appShow.Flags(), nameFlag, completeApps)
+	app.AddCommand(appShow)
+
+	hidden := &cobra.Command{Use: completeCmdName, Hidden: true, Run: func(*cobra.Command, []string) {}}
+	root.AddCommand(env, app, hidden)
+	return root
+}
+
+func TestCompleteOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inWords      []string
+		mockWs       func(m *mocks.MockappNamesLister)
+		mockStore    func(m *mocks.MockcompletionStore)
+		noWorkspace  bool
+		cachedValues map[string]string
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"completes subcommands with their description": {
+			inWords:       []string{""},
+			wantedContent: "app\tApplication commands.\nenv\tEnvironment commands.\n",
+		},
+		"completes subcommands starting with the word": {
+			inWords:       []string{"env", "de"},
+			wantedContent: "delete\tDeletes an environment.\n",
+		},
+		"completes local and inherited flags": {
+			inWords:       []string{"env", "delete", "--"},
+			wantedContent: "--no-prompt\t" + noPromptFlagDescription + "\n--project\t" + projectFlagDescription + "\n--yes\t" + yesFlagDescription + "\n",
+		},
+		"completes app names of the workspace": {
+			inWords: []string{"app", "show", "--name", "f"},
+			mockWs: func(m *mocks.MockappNamesLister) {
+				m.EXPECT().AppNames().Return([]string{"frontend", "backend"}, nil)
+			},
+			wantedContent: "frontend\n",
+		},
+		"completes app names after the equal sign": {
+			inWords: []string{"app", "show", "--name="},
+			mockWs: func(m *mocks.MockappNamesLister) {
+				m.EXPECT().AppNames().Return([]string{"frontend", "backend"}, nil)
+			},
+			wantedContent: "--name=frontend\n--name=backend\n",
+		},
+		"completes no app names outside of a workspace": {
+			inWords:       []string{"app", "show", "-n", ""},
+			noWorkspace:   true,
+			wantedContent: "",
+		},
+		"completes project names of the store": {
+			inWords: []string{"env", "delete", "-p", ""},
+			mockStore: func(m *mocks.MockcompletionStore) {
+				m.EXPECT().ListProjects().Return([]*archer.Project{{Name: "chicken"}, {Name: "goose"}}, nil)
+			},
+			wantedContent: "chicken\ngoose\n",
+		},
+		"completes environment names of the project flag": {
+			inWords: []string{"env", "delete", "--project", "chicken", ""},
+			mockStore: func(m *mocks.MockcompletionStore) {
+				m.EXPECT().ListEnvironments("chicken").Return([]*archer.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+			},
+			wantedContent: "test\nprod\n",
+		},
+		"completes cached environment names": {
+			inWords: []string{"env", "delete", "--project=chicken", "p"},
+			mockStore: func(m *mocks.MockcompletionStore) {
+				m.EXPECT().ListEnvironments(gomock.Any()).Times(0)
+			},
+			cachedValues:  map[string]string{"envs-chicken": "test\nprod"},
+			wantedContent: "prod\n",
+		},
+		"completes a single positional argument": {
+			inWords:       []string{"env", "delete", "test", ""},
+			wantedContent: "",
+		},
+		"completes nothing after an unknown command": {
+			inWords:       []string{"chicken", ""},
+			wantedContent: "",
+		},
+		"returns the error of the store": {
+			inWords: []string{"env", "delete", "-p", "chicken", "--yes", ""},
+			mockStore: func(m *mocks.MockcompletionStore) {
+				m.EXPECT().ListEnvironments("chicken").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWs := mocks.NewMockappNamesLister(ctrl)
+			mockStore := mocks.NewMockcompletionStore(ctrl)
+			if tc.mockWs != nil {
+				tc.mockWs(mockWs)
+			}
+			if tc.mockStore != nil {
+				tc.mockStore(mockStore)
+			}
+			fs := afero.NewMemMapFs()
+			for key, content := range tc.cachedValues {
+				require.NoError(t, afero.WriteFile(fs, "/cache/"+key, []byte(content), 0644))
+			}
+			b := &bytes.Buffer{}
+			opts := &CompleteOpts{
+				Words: tc.inWords,
+				root:  newCompletionTestCmd(),
+				w:     b,
+				ws:    mockWs,
+				store: mockStore,
+				cache: &completionCache{
+					fs:  fs,
+					dir: "/cache",
+					ttl: time.Hour,
+					now: time.Now,
+				},
+			}
+			if tc.noWorkspace {
+				opts.ws = nil
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
+
+func TestCompletionCache_Get(t *testing.T) {
+	// GIVEN
+	now := time.Now()
+	cache := &completionCache{
+		fs:  afero.NewMemMapFs(),
+		dir: "/cache",
+		ttl: time.Minute,
+		now: func() time.Time { return now },
+	}
+	calls := 0
+	fetch := func() ([]string, error) {
+		calls++
+		return []string{"test", "prod"}, nil
+	}
+
+	// WHEN
+	first, err := cache.get("envs-chicken", fetch)
+	require.NoError(t, err)
+	second, err := cache.get("envs-chicken", fetch)
+	require.NoError(t, err)
+	now = now.Add(2 * time.Minute)
+	expired, err := cache.get("envs-chicken", fetch)
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, []string{"test", "prod"}, first)
+	require.Equal(t, first, second)
+	require.Equal(t, first, expired)
+	require.Equal(t, 2, calls, "values should be fetched again once expired")
+}
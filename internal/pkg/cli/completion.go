@@ -17,44 +17,50 @@ import (
 type shellCompleter interface {
 	GenBashCompletion(w io.Writer) error
 	GenZshCompletion(w io.Writer) error
+	GenFishCompletion(w io.Writer) error
+	GenPowerShellCompletion(w io.Writer) error
 }
 
 // CompletionOpts contains the fields needed to generate completion scripts.
 type CompletionOpts struct {
-	Shell string // must be "bash" or "zsh"
+	Shell string // must be "bash", "zsh", "fish" or "powershell"
 
 	w         io.Writer
 	completer shellCompleter
 }
 
-// Validate returns an error if the shell is not "bash" or "zsh".
+// Validate returns an error if the shell is not "bash", "zsh", "fish" or "powershell".
 func (opts *CompletionOpts) Validate() error {
-	if opts.Shell == "bash" {
+	switch opts.Shell {
+	case "bash", "zsh", "fish", "powershell":
 		return nil
 	}
-	if opts.Shell == "zsh" {
-		return nil
-	}
-	return errors.New("shell must be bash or zsh")
+	return errors.New("shell must be bash, zsh, fish or powershell")
 }
 
 // Execute writes the completion code to the writer.
 // This method assumes that Validate() was called prior to invocation.
 func (opts *CompletionOpts) Execute() error {
-	if opts.Shell == "bash" {
+	switch opts.Shell {
+	case "bash":
 		return opts.completer.GenBashCompletion(opts.w)
+	case "zsh":
+		return opts.completer.GenZshCompletion(opts.w)
+	case "fish":
+		return opts.completer.GenFishCompletion(opts.w)
 	}
-	return opts.completer.GenZshCompletion(opts.w)
+	return opts.completer.GenPowerShellCompletion(opts.w)
 }
 
-// BuildCompletionCmd returns the command to output shell completion code for the specified shell (bash or zsh).
+// BuildCompletionCmd returns the command to output shell completion code for the specified shell (bash, zsh, fish or powershell).
 func BuildCompletionCmd(rootCmd *cobra.Command) *cobra.Command {
 	opts := &CompletionOpts{}
 	cmd := &cobra.Command{
 		Use:   "completion [shell]",
 		Short: "Output shell completion code.",
-		Long: `Output shell completion code for bash or zsh.
-The code must be evaluated to provide interactive completion of commands.`,
+		Long: `Output shell completion code for bash, zsh, fish or powershell.
+The code must be evaluated to provide interactive completion of commands.
+Application, environment and project names are completed from your workspace and project.`,
 		Example: `
   Install zsh completion
   /code $ source <(archer completion zsh)
@@ -68,10 +74,16 @@ The code must be evaluated to provide interactive completion of commands.`,
   Install bash completion on linux
   /code $ source <(archer completion bash)
   /code $ archer completion bash > archer.sh
-  /code $ sudo mv archer.sh /etc/bash_completion.d/archer`,
+  /code $ sudo mv archer.sh /etc/bash_completion.d/archer
+
+  Install fish completion
+  /code $ archer completion fish > ~/.config/fish/completions/archer.fish
+
+  Install powershell completion
+  /code $ archer completion powershell | Out-String | Invoke-Expression`,
 		Args: func(cmd *cobra.Command, args []string) error {
 			if len(args) != 1 {
-				return errors.New("requires a single shell argument (bash or zsh)")
+				return errors.New("requires a single shell argument (bash, zsh, fish or powershell)")
 			}
 			return nil
 		},
@@ -81,7 +93,7 @@ The code must be evaluated to provide interactive completion of commands.`,
 		}),
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts.w = os.Stdout
-			opts.completer = rootCmd
+			opts.completer = &completionScripts{root: rootCmd}
 			return opts.Execute()
 		}),
 	}
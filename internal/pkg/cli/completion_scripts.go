@@ -0,0 +1,114 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+)
+
+// The completion scripts call the hidden complete command for the values that are only known at runtime.
+// Bash uses cobra's generated script with a custom function for flag values and positional arguments,
+// the other shells delegate the whole command line to the complete command.
+const (
+	bashCompletionFunctions = `__%[1]s_complete()
+{
+    local IFS=$'\n'
+    local completions
+    # Flag values are completed without their "--flag=" prefix, cobra already removed it from cur.
+    completions=$(%[1]s %[2]s "${words[@]:1:${cword}}" 2>/dev/null | cut -f1 | sed 's/^--[^=]*=//')
+    COMPREPLY=( $(compgen -W "${completions}" -- "${cur}") )
+}
+
+__%[1]s_custom_func()
+{
+    __%[1]s_complete
+}
+`
+
+	zshCompletionScript = `#compdef %[1]s
+
+_%[1]s()
+{
+    local -a completions
+    local line value description
+    for line in "${(@f)$(%[1]s %[2]s "${(@)words[2,$CURRENT]}" 2>/dev/null)}"; do
+        [[ -z "${line}" ]] && continue
+        value="${line%%%%$'\t'*}"
+        description=""
+        [[ "${line}" == *$'\t'* ]] && description="${line#*$'\t'}"
+        completions+=("${value//:/\\:}${description:+:${description}}")
+    done
+    _describe '%[1]s' completions
+}
+
+if [[ "${funcstack[1]}" == "_%[1]s" ]]; then
+    _%[1]s "$@"
+else
+    compdef _%[1]s %[1]s
+fi
+`
+
+	fishCompletionScript = `function __%[1]s_complete
+    set -l tokens (commandline -opc)
+    set -e tokens[1]
+    %[1]s %[2]s $tokens (commandline -ct) 2>/dev/null
+end
+
+complete -c %[1]s -f -a '(__%[1]s_complete)'
+`
+
+	powerShellCompletionScript = `Register-ArgumentCompleter -Native -CommandName '%[1]s' -ScriptBlock {
+    param($wordToComplete, $commandAst, $cursorPosition)
+
+    $words = @($commandAst.CommandElements | Select-Object -Skip 1 | ForEach-Object { $_.ToString() })
+    if ($wordToComplete -eq '') {
+        # An empty argument is dropped when calling a native command, pass an empty quoted string instead.
+        $words += '""'
+    }
+    & '%[1]s' '%[2]s' @words 2>$null | Where-Object { $_ } | ForEach-Object {
+        $value, $description = $_ -split "` + "`" + `t", 2
+        if (-not $description) {
+            $description = $value
+        }
+        [System.Management.Automation.CompletionResult]::new($value, $value, 'ParameterValue', $description)
+    }
+}
+`
+)
+
+// completionScripts generates the completion scripts of the root command.
+type completionScripts struct {
+	root *cobra.Command
+}
+
+// GenBashCompletion writes cobra's bash completion script, extended to complete values with the complete command.
+func (s *completionScripts) GenBashCompletion(w io.Writer) error {
+	s.root.BashCompletionFunction = s.script(bashCompletionFunctions)
+	return s.root.GenBashCompletion(w)
+}
+
+// GenZshCompletion writes the zsh completion script.
+func (s *completionScripts) GenZshCompletion(w io.Writer) error {
+	_, err := io.WriteString(w, s.script(zshCompletionScript))
+	return err
+}
+
+// GenFishCompletion writes the fish completion script.
+func (s *completionScripts) GenFishCompletion(w io.Writer) error {
+	_, err := io.WriteString(w, s.script(fishCompletionScript))
+	return err
+}
+
+// GenPowerShellCompletion writes the PowerShell completion script.
+func (s *completionScripts) GenPowerShellCompletion(w io.Writer) error {
+	_, err := io.WriteString(w, s.script(powerShellCompletionScript))
+	return err
+}
+
+func (s *completionScripts) script(format string) string {
+	return fmt.Sprintf(format, s.root.Name(), completeCmdName)
+}
@@ -25,9 +25,17 @@ func TestCompletionOpts_Validate(t *testing.T) {
 			inputShell:  "bash",
 			wantedError: nil,
 		},
+		"fish": {
+			inputShell:  "fish",
+			wantedError: nil,
+		},
+		"powershell": {
+			inputShell:  "powershell",
+			wantedError: nil,
+		},
 		"invalid shell": {
 			inputShell:  "chicken",
-			wantedError: errors.New("shell must be bash or zsh"),
+			wantedError: errors.New("shell must be bash, zsh, fish or powershell"),
 		},
 	}
 
@@ -72,6 +80,20 @@ func TestCompletionOpts_Execute(t *testing.T) {
 				mock.EXPECT().GenZshCompletion(gomock.Any()).Times(1)
 			},
 		},
+		"fish": {
+			inputShell: "fish",
+			mocking: func(mock *mocks.MockshellCompleter) {
+				mock.EXPECT().GenFishCompletion(gomock.Any()).Times(1)
+				mock.EXPECT().GenPowerShellCompletion(gomock.Any()).Times(0)
+			},
+		},
+		"powershell": {
+			inputShell: "powershell",
+			mocking: func(mock *mocks.MockshellCompleter) {
+				mock.EXPECT().GenFishCompletion(gomock.Any()).Times(0)
+				mock.EXPECT().GenPowerShellCompletion(gomock.Any()).Times(1)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
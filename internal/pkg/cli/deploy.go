@@ -17,9 +17,11 @@ type environmentDeployer interface {
 
 type pipelineDeployer interface {
 	DeployPipeline(env *deploy.CreatePipelineInput) error
+	StreamPipelineCreation(env *deploy.CreatePipelineInput) (<-chan []deploy.ResourceEvent, <-chan deploy.CreatePipelineResponse)
 	AddPipelineResourcesToProject(project *archer.Project, region string) error
+	CreatePipelineChangeSet(in *deploy.CreatePipelineInput) (*deploy.ChangeSet, error)
+	changeSetExecutor
 	projectResourcesGetter
-	// TODO: Add StreamPipelineCreation method
 }
 
 type projectDeployer interface {
@@ -29,6 +31,11 @@ type projectDeployer interface {
 	DelegateDNSPermissions(project *archer.Project, accountID string) error
 }
 
+type changeSetExecutor interface {
+	ExecuteChangeSet(cs *deploy.ChangeSet) error
+	DeleteChangeSet(cs *deploy.ChangeSet) error
+}
+
 type projectResourcesGetter interface {
 	GetProjectResourcesByRegion(project *archer.Project, region string) (*archer.ProjectRegionalResources, error)
 	GetRegionalProjectResources(project *archer.Project) ([]*archer.ProjectRegionalResources, error)
@@ -0,0 +1,409 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+
+	"github.com/aws/amazon-ecs-cli-v2/cmd/archer/template"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/identity"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/build/docker"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/build/ecr"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/group"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/workspace"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/spf13/cobra"
+)
+
+type dockerChecker interface {
+	CheckEngineRunning() error
+	CheckBuildx() error
+}
+
+type doctorWorkspace interface {
+	ManifestDirectoryPath() (string, error)
+	Summary() (*archer.WorkspaceSummary, error)
+	ListManifestFiles() ([]string, error)
+	ReadFile(filename string) ([]byte, error)
+}
+
+type doctorStore interface {
+	archer.ProjectGetter
+	archer.EnvironmentLister
+	archer.ApplicationLister
+}
+
+type projectStacksDescriber interface {
+	DescribeProjectStacks(project *archer.Project) (*cloudformation.ProjectStacks, error)
+	GetRegionalProjectResources(project *archer.Project) ([]*archer.ProjectRegionalResources, error)
+}
+
+type envStackDescriber interface {
+	Describe(env *archer.Environment, withResources bool) (*describe.Env, error)
+}
+
+type repositoryGetter interface {
+	GetRepository(name string) (string, error)
+}
+
+// errDoctorChecksFailed occurs when at least one of the checks of the doctor command failed.
+type errDoctorChecksFailed struct {
+	failures int
+}
+
+func (e *errDoctorChecksFailed) Error() string {
+	if e.failures == 1 {
+		return "1 check failed"
+	}
+	return fmt.Sprintf("%d checks failed", e.failures)
+}
+
+// DoctorOpts holds the configuration needed to diagnose the local setup and the health of a project.
+type DoctorOpts struct {
+	region string // Region of the default session.
+
+	identity      identityService
+	docker        dockerChecker
+	ws            doctorWorkspace
+	store         doctorStore
+	projectStacks projectStacksDescriber
+
+	// Environments can be in other accounts and project resources in other regions, so these clients are created per check.
+	initEnvDescriber     func(env *archer.Environment) (envStackDescriber, error)
+	initRepositoryGetter func(region string) (repositoryGetter, error)
+
+	failures int
+
+	*GlobalOpts
+}
+
+// Execute runs every check and prints how to fix the ones that fail.
+// Checks that depend on a failed check are skipped.
+func (opts *DoctorOpts) Execute() error {
+	hasCredentials := opts.checkCredentials()
+	opts.checkDocker()
+	if opts.checkWorkspace() {
+		opts.checkManifests()
+	}
+	switch {
+	case !hasCredentials:
+		log.Warningln("Skipped the project checks because the AWS credentials can't be used.")
+	case opts.ProjectName() == "":
+		log.Warningf("Skipped the project checks because there is no project in the workspace, pass one with %s.\n", color.HighlightCode("--project"))
+	default:
+		opts.checkProject(opts.ProjectName())
+	}
+	if opts.failures > 0 {
+		return &errDoctorChecksFailed{failures: opts.failures}
+	}
+	log.Successln("Everything looks good!")
+	return nil
+}
+
+func (opts *DoctorOpts) pass(format string, args ...interface{}) {
+	log.Successf(format+"\n", args...)
+}
+
+func (opts *DoctorOpts) fail(fix, format string, args ...interface{}) {
+	opts.failures++
+	log.Errorf(format+"\n", args...)
+	log.Infof("  To fix it: %s\n", fix)
+}
+
+func (opts *DoctorOpts) checkCredentials() bool {
+	if opts.region == "" {
+		opts.fail(fmt.Sprintf("Pass the %s flag, set the %s environment variable or run %s to choose a default region.",
+			color.HighlightCode("--region"), color.HighlightCode("AWS_REGION"), color.HighlightCode("aws configure")),
+			"No AWS region is configured.")
+		return false
+	}
+	caller, err := opts.identity.Get()
+	if err != nil {
+		opts.fail(fmt.Sprintf("Run %s, or pass the %s flag or set the %s environment variable to a profile with valid credentials.",
+			color.HighlightCode("aws configure"), color.HighlightCode("--profile"), color.HighlightCode("AWS_PROFILE")),
+			"Couldn't use the AWS credentials: %v.", err)
+		return false
+	}
+	opts.pass("Using the AWS credentials of %s in %s.", caller.ARN, opts.region)
+	return true
+}
+
+func (opts *DoctorOpts) checkDocker() {
+	if err := opts.docker.CheckEngineRunning(); err != nil {
+		var execErr *exec.Error
+		if errors.As(err, &execErr) {
+			opts.fail("Install Docker from https://docs.docker.com/install/.", "Docker isn't installed: %v.", err)
+			return
+		}
+		opts.fail(fmt.Sprintf("Start Docker and check that %s succeeds.", color.HighlightCode("docker info")),
+			"Couldn't reach the Docker daemon: %v.", err)
+		return
+	}
+	opts.pass("The Docker daemon is running.")
+	if err := opts.docker.CheckBuildx(); err != nil {
+		opts.fail("Update Docker or install the buildx plugin from https://github.com/docker/buildx.",
+			"Docker buildx isn't available: %v.", err)
+		return
+	}
+	opts.pass("Docker buildx is available.")
+}
+
+// checkWorkspace returns true if the manifest directory was found.
+func (opts *DoctorOpts) checkWorkspace() bool {
+	path, err := opts.ws.ManifestDirectoryPath()
+	if err != nil {
+		var notFound *workspace.ErrWorkspaceNotFound
+		if errors.As(err, &notFound) {
+			opts.fail(fmt.Sprintf("Run archer at most %d levels below the directory containing %s, or run %s to create a workspace.",
+				notFound.NumberOfLevelsChecked-1, notFound.ManifestDirectoryName, color.HighlightCode("archer init")),
+				"Couldn't find a workspace: %v.", err)
+			return false
+		}
+		opts.fail("Check the permissions of the working directory and its parent directories.",
+			"Couldn't search for a workspace: %v.", err)
+		return false
+	}
+	summary, err := opts.ws.Summary()
+	if err != nil {
+		opts.fail(fmt.Sprintf("Run %s in the repository to associate the workspace with a project.", color.HighlightCode("archer init")),
+			"Couldn't read the project of the workspace at %s: %v.", path, err)
+		return true
+	}
+	opts.pass("Found the workspace of project %s at %s.", summary.ProjectName, path)
+	return true
+}
+
+func (opts *DoctorOpts) checkManifests() {
+	files, err := opts.ws.ListManifestFiles()
+	if err != nil {
+		opts.fail("Check the permissions of the workspace directory.", "Couldn't list the manifests: %v.", err)
+		return
+	}
+	for _, file := range files {
+		raw, err := opts.ws.ReadFile(file)
+		if err == nil {
+			_, err = manifest.UnmarshalApp(raw)
+		}
+		if err != nil {
+			opts.fail(fmt.Sprintf("Fix the manifest, or delete it and run %s to generate it again.", color.HighlightCode("archer app init")),
+				"Couldn't parse the manifest %s: %v.", file, err)
+			continue
+		}
+		opts.pass("Parsed the manifest %s.", file)
+	}
+	raw, err := opts.ws.ReadFile(workspace.PipelineFileName)
+	var notFound *workspace.ErrManifestNotFound
+	if errors.As(err, &notFound) {
+		return
+	}
+	if err == nil {
+		_, err = manifest.UnmarshalPipeline(raw)
+	}
+	if err != nil {
+		opts.fail(fmt.Sprintf("Fix the manifest, or delete it and run %s to generate it again.", color.HighlightCode("archer pipeline init")),
+			"Couldn't parse the manifest %s: %v.", workspace.PipelineFileName, err)
+		return
+	}
+	opts.pass("Parsed the manifest %s.", workspace.PipelineFileName)
+}
+
+func (opts *DoctorOpts) checkProject(name string) {
+	project, err := opts.store.GetProject(name)
+	if err != nil {
+		var notFound *store.ErrNoSuchProject
+		if errors.As(err, &notFound) {
+			opts.fail(fmt.Sprintf("Run %s to create it, or pass an existing project with %s.",
+				color.HighlightCode("archer init"), color.HighlightCode("--project")),
+				"Project %s doesn't exist in the account of your credentials.", name)
+			return
+		}
+		opts.fail("Check that your credentials can read SSM parameters.", "Couldn't get project %s: %v.", name, err)
+		return
+	}
+	opts.pass("Found project %s.", name)
+
+	stacks, err := opts.projectStacks.DescribeProjectStacks(project)
+	if err != nil {
+		opts.fail("Check that your credentials can describe CloudFormation stacks and StackSets.",
+			"Couldn't describe the stacks of project %s: %v.", name, err)
+	} else {
+		opts.checkProjectStacks(project, stacks)
+	}
+	opts.checkEnvironments(project)
+	opts.checkRepositories(project)
+}
+
+func (opts *DoctorOpts) checkProjectStacks(project *archer.Project, stacks *cloudformation.ProjectStacks) {
+	redeploy := fmt.Sprintf("Check the stack events in the CloudFormation console, then run %s to update the project.",
+		color.HighlightCode("archer env init"))
+	opts.checkStackStatus(fmt.Sprintf("stack of project %s", project.Name), stacks.StackStatus, redeploy)
+	for _, instance := range stacks.Instances {
+		name := fmt.Sprintf("StackSet instance of project %s in %s", project.Name, instance.Region)
+		if instance.Status != "CURRENT" {
+			opts.fail(fmt.Sprintf("Check the operations of the StackSet in the CloudFormation console, then run %s to update it.",
+				color.HighlightCode("archer env init")),
+				"The %s is %s: %s.", name, instance.Status, instance.StatusReason)
+			continue
+		}
+		opts.checkStackStatus(name, instance.StackStatus, redeploy)
+	}
+}
+
+func (opts *DoctorOpts) checkEnvironments(project *archer.Project) {
+	envs, err := opts.store.ListEnvironments(project.Name)
+	if err != nil {
+		opts.fail("Check that your credentials can read SSM parameters.", "Couldn't list the environments of project %s: %v.", project.Name, err)
+		return
+	}
+	for _, env := range envs {
+		describer, err := opts.initEnvDescriber(env)
+		if err == nil {
+			var desc *describe.Env
+			desc, err = describer.Describe(env, false)
+			if err == nil {
+				opts.checkEnvStacks(desc)
+				continue
+			}
+		}
+		opts.fail(fmt.Sprintf("Check that your credentials can assume the role %s, or delete the environment with %s and create it again.",
+			env.ManagerRoleARN, color.HighlightCode("archer env delete "+env.Name)),
+			"Couldn't describe environment %s: %v.", env.Name, err)
+	}
+}
+
+func (opts *DoctorOpts) checkEnvStacks(env *describe.Env) {
+	opts.checkStackStatus(fmt.Sprintf("stack of environment %s", env.Name), cloudformation.StackStatus(env.StackStatus),
+		fmt.Sprintf("Check the stack events in the CloudFormation console, then run %s.", color.HighlightCode("archer env upgrade "+env.Name)))
+	for _, app := range env.Apps {
+		opts.checkStackStatus(fmt.Sprintf("stack of application %s in environment %s", app.Name, env.Name), cloudformation.StackStatus(app.StackStatus),
+			fmt.Sprintf("Check the stack events in the CloudFormation console, then run %s.",
+				color.HighlightCode(fmt.Sprintf("archer app deploy --name %s --env %s", app.Name, env.Name))))
+	}
+}
+
+// checkRepositories checks that every application of the project has an ECR repository in each region of the project.
+func (opts *DoctorOpts) checkRepositories(project *archer.Project) {
+	apps, err := opts.store.ListApplications(project.Name)
+	if err != nil {
+		opts.fail("Check that your credentials can read SSM parameters.", "Couldn't list the applications of project %s: %v.", project.Name, err)
+		return
+	}
+	resources, err := opts.projectStacks.GetRegionalProjectResources(project)
+	if err != nil {
+		opts.fail("Check that your credentials can describe CloudFormation stacks and StackSets.",
+			"Couldn't get the regional resources of project %s: %v.", project.Name, err)
+		return
+	}
+	for _, regional := range resources {
+		repositories, err := opts.initRepositoryGetter(regional.Region)
+		if err != nil {
+			opts.fail("Check your AWS configuration.", "Couldn't connect to ECR in %s: %v.", regional.Region, err)
+			continue
+		}
+		for _, app := range apps {
+			readd := fmt.Sprintf("Run %s to add the repository to the project again.",
+				color.HighlightCode(fmt.Sprintf("archer app init --name %s", app.Name)))
+			if _, ok := regional.RepositoryURLs[app.Name]; !ok {
+				opts.fail(readd, "Application %s has no ECR repository in %s.", app.Name, regional.Region)
+				continue
+			}
+			repoName := fmt.Sprintf("%s/%s", project.Name, app.Name)
+			if _, err := repositories.GetRepository(repoName); err != nil {
+				opts.fail(readd, "Couldn't find the ECR repository of application %s in %s: %v.", app.Name, regional.Region, err)
+				continue
+			}
+			opts.pass("Found the ECR repository %s in %s.", repoName, regional.Region)
+		}
+	}
+}
+
+// checkStackStatus checks that a stack exists and that it isn't being updated or rolled back.
+func (opts *DoctorOpts) checkStackStatus(name string, status cloudformation.StackStatus, fix string) {
+	switch {
+	case status == "":
+		opts.fail(fix, "The %s doesn't exist.", name)
+	case status.InProgress():
+		opts.fail(fmt.Sprintf("Wait for the operation to complete, then run %s again.", color.HighlightCode("archer doctor")),
+			"The %s is %s.", name, status)
+	case status.RolledBack():
+		opts.fail(fix, "The %s is %s, its last operation failed.", name, status)
+	default:
+		opts.pass("The %s is %s.", name, status)
+	}
+}
+
+// BuildDoctorCmd builds the command to diagnose the local setup and the health of a project.
+func BuildDoctorCmd() *cobra.Command {
+	opts := &DoctorOpts{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Diagnose your local setup and the health of your project.",
+		Long: `Diagnose your local setup and the health of your project.
+Checks your AWS credentials and region, Docker, the workspace and its manifests,
+and that the stacks of the project, its environments and applications are stable.
+Prints how to fix the failed checks and exits with a non-zero code if any check fails.`,
+		Example: `
+  Check your setup and the project of the workspace.
+  /code $ archer doctor
+
+  Check the project "my-project" from outside of its workspace.
+  /code $ archer doctor --project my-project`,
+		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			sess, err := opts.sessionProvider().Default()
+			if err != nil {
+				return fmt.Errorf("create default session: %w", err)
+			}
+			opts.region = aws.StringValue(sess.Config.Region)
+			opts.identity = identity.New(sess)
+			opts.docker = docker.New()
+			ws, err := workspace.New()
+			if err != nil {
+				return fmt.Errorf("new workspace: %w", err)
+			}
+			opts.ws = ws
+			store, err := store.New()
+			if err != nil {
+				return fmt.Errorf("connect to ecs-cli metadata store: %w", err)
+			}
+			opts.store = store
+			opts.projectStacks = cloudformation.New(sess)
+			opts.initEnvDescriber = func(env *archer.Environment) (envStackDescriber, error) {
+				sess, err := opts.sessionProvider().FromEnvRole(env.Name, env.ManagerRoleARN, env.Region)
+				if err != nil {
+					return nil, fmt.Errorf("create session from role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+				}
+				return describe.NewEnvDescriber(sess), nil
+			}
+			opts.initRepositoryGetter = func(region string) (repositoryGetter, error) {
+				sess, err := opts.sessionProvider().DefaultWithRegion(region)
+				if err != nil {
+					return nil, fmt.Errorf("create session in region %s: %w", region, err)
+				}
+				return ecr.New(sess), nil
+			}
+			return nil
+		}),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			return opts.Execute()
+		}),
+		Annotations: map[string]string{
+			"group": group.Settings,
+		},
+	}
+	cmd.Flags().StringVarP(&opts.projectName, projectFlag, projectFlagShort, opts.projectName, projectFlagDescription)
+	markFlagCompletion(cmd.Flags(), projectFlag, completeProjects)
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
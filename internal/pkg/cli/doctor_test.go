@@ -0,0 +1,211 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/identity"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type doctorMocks struct {
+	identity      *climocks.MockidentityService
+	docker        *climocks.MockdockerChecker
+	ws            *climocks.MockdoctorWorkspace
+	store         *climocks.MockdoctorStore
+	projectStacks *climocks.MockprojectStacksDescriber
+	envDescriber  *climocks.MockenvStackDescriber
+	repositories  *climocks.MockrepositoryGetter
+}
+
+const mockAppManifest = `name: frontend
+type: Load Balanced Web App
+image:
+  build: frontend/Dockerfile
+  port: 80
+http:
+  path: '*'
+`
+
+func TestDoctorOpts_Execute(t *testing.T) {
+	mockProject := &archer.Project{Name: "chicken", AccountID: "12345"}
+	mockEnv := &archer.Environment{Project: "chicken", Name: "test", Region: "us-west-2", ManagerRoleARN: "arn:aws:iam::12345:role/manager"}
+
+	healthyLocalSetup := func(m doctorMocks) {
+		m.identity.EXPECT().Get().Return(identity.Caller{ARN: "arn:aws:iam::12345:user/me"}, nil)
+		m.docker.EXPECT().CheckEngineRunning().Return(nil)
+		m.docker.EXPECT().CheckBuildx().Return(nil)
+		m.ws.EXPECT().ManifestDirectoryPath().Return("/chicken/ecs-project", nil)
+		m.ws.EXPECT().Summary().Return(&archer.WorkspaceSummary{ProjectName: "chicken"}, nil)
+		m.ws.EXPECT().ListManifestFiles().Return([]string{"frontend-app.yml"}, nil)
+		m.ws.EXPECT().ReadFile("frontend-app.yml").Return([]byte(mockAppManifest), nil)
+		m.ws.EXPECT().ReadFile(workspace.PipelineFileName).Return(nil, &workspace.ErrManifestNotFound{ManifestName: workspace.PipelineFileName})
+	}
+	healthyProject := func(m doctorMocks) {
+		m.store.EXPECT().GetProject("chicken").Return(mockProject, nil)
+		m.projectStacks.EXPECT().DescribeProjectStacks(mockProject).Return(&cloudformation.ProjectStacks{
+			StackStatus: "UPDATE_COMPLETE",
+			Instances: []*cloudformation.ProjectStackInstance{
+				{Region: "us-west-2", Status: "CURRENT", StackStatus: "UPDATE_COMPLETE"},
+			},
+		}, nil)
+		m.store.EXPECT().ListEnvironments("chicken").Return([]*archer.Environment{mockEnv}, nil)
+		m.store.EXPECT().ListApplications("chicken").Return([]*archer.Application{{Name: "frontend"}}, nil)
+		m.projectStacks.EXPECT().GetRegionalProjectResources(mockProject).Return([]*archer.ProjectRegionalResources{
+			{Region: "us-west-2", RepositoryURLs: map[string]string{"frontend": "12345.dkr.ecr.us-west-2.amazonaws.com/chicken/frontend"}},
+		}, nil)
+		m.repositories.EXPECT().GetRepository("chicken/frontend").Return("12345.dkr.ecr.us-west-2.amazonaws.com/chicken/frontend", nil)
+	}
+
+	testCases := map[string]struct {
+		inRegion  string
+		inProject string
+		setupMock func(m doctorMocks)
+
+		wantedErr error
+	}{
+		"passes if every check passes": {
+			inRegion:  "us-west-2",
+			inProject: "chicken",
+			setupMock: func(m doctorMocks) {
+				healthyLocalSetup(m)
+				healthyProject(m)
+				m.envDescriber.EXPECT().Describe(mockEnv, false).Return(&describe.Env{
+					Name:        "test",
+					StackStatus: "CREATE_COMPLETE",
+					Apps:        []*describe.EnvApp{{Name: "frontend", StackStatus: "UPDATE_COMPLETE"}},
+				}, nil)
+			},
+		},
+		"skips the project checks without a region": {
+			inProject: "chicken",
+			setupMock: func(m doctorMocks) {
+				m.identity.EXPECT().Get().Times(0)
+				m.docker.EXPECT().CheckEngineRunning().Return(nil)
+				m.docker.EXPECT().CheckBuildx().Return(nil)
+				m.ws.EXPECT().ManifestDirectoryPath().Return("/chicken/ecs-project", nil)
+				m.ws.EXPECT().Summary().Return(&archer.WorkspaceSummary{ProjectName: "chicken"}, nil)
+				m.ws.EXPECT().ListManifestFiles().Return(nil, nil)
+				m.ws.EXPECT().ReadFile(workspace.PipelineFileName).Return(nil, &workspace.ErrManifestNotFound{ManifestName: workspace.PipelineFileName})
+				m.store.EXPECT().GetProject(gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("1 check failed"),
+		},
+		"reports invalid credentials, docker, workspace and project failures": {
+			inRegion:  "us-west-2",
+			inProject: "chicken",
+			setupMock: func(m doctorMocks) {
+				m.identity.EXPECT().Get().Return(identity.Caller{ARN: "arn:aws:iam::12345:user/me"}, nil)
+				m.docker.EXPECT().CheckEngineRunning().Return(errors.New("exit status 1"))
+				m.docker.EXPECT().CheckBuildx().Times(0)
+				m.ws.EXPECT().ManifestDirectoryPath().Return("", &workspace.ErrWorkspaceNotFound{
+					CurrentDirectory:      "/chicken",
+					ManifestDirectoryName: "ecs-project",
+					NumberOfLevelsChecked: 5,
+				})
+				m.ws.EXPECT().ListManifestFiles().Times(0)
+				m.store.EXPECT().GetProject("chicken").Return(nil, &store.ErrNoSuchProject{ProjectName: "chicken"})
+				m.projectStacks.EXPECT().DescribeProjectStacks(gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("3 checks failed"),
+		},
+		"reports manifests that don't parse": {
+			inRegion:  "us-west-2",
+			inProject: "chicken",
+			setupMock: func(m doctorMocks) {
+				m.identity.EXPECT().Get().Return(identity.Caller{ARN: "arn:aws:iam::12345:user/me"}, nil)
+				m.docker.EXPECT().CheckEngineRunning().Return(nil)
+				m.docker.EXPECT().CheckBuildx().Return(errors.New("unknown command"))
+				m.ws.EXPECT().ManifestDirectoryPath().Return("/chicken/ecs-project", nil)
+				m.ws.EXPECT().Summary().Return(&archer.WorkspaceSummary{ProjectName: "chicken"}, nil)
+				m.ws.EXPECT().ListManifestFiles().Return([]string{"frontend-app.yml"}, nil)
+				m.ws.EXPECT().ReadFile("frontend-app.yml").Return([]byte("name: [frontend"), nil)
+				m.ws.EXPECT().ReadFile(workspace.PipelineFileName).Return([]byte("version: [1"), nil)
+				healthyProject(m)
+				m.envDescriber.EXPECT().Describe(mockEnv, false).Return(&describe.Env{Name: "test", StackStatus: "CREATE_COMPLETE"}, nil)
+			},
+			wantedErr: errors.New("3 checks failed"),
+		},
+		"reports unstable stacks and missing repositories": {
+			inRegion:  "us-west-2",
+			inProject: "chicken",
+			setupMock: func(m doctorMocks) {
+				healthyLocalSetup(m)
+				m.store.EXPECT().GetProject("chicken").Return(mockProject, nil)
+				m.projectStacks.EXPECT().DescribeProjectStacks(mockProject).Return(&cloudformation.ProjectStacks{
+					StackStatus: "UPDATE_ROLLBACK_COMPLETE",
+					Instances: []*cloudformation.ProjectStackInstance{
+						{Region: "us-west-2", Status: "OUTDATED", StatusReason: "operation failed", StackStatus: "UPDATE_COMPLETE"},
+						{Region: "us-east-1", Status: "CURRENT", StackStatus: "UPDATE_IN_PROGRESS"},
+					},
+				}, nil)
+				m.store.EXPECT().ListEnvironments("chicken").Return([]*archer.Environment{mockEnv}, nil)
+				m.envDescriber.EXPECT().Describe(mockEnv, false).Return(&describe.Env{
+					Name:        "test",
+					StackStatus: "ROLLBACK_COMPLETE",
+					Apps:        []*describe.EnvApp{{Name: "frontend", StackStatus: "UPDATE_ROLLBACK_IN_PROGRESS"}},
+				}, nil)
+				m.store.EXPECT().ListApplications("chicken").Return([]*archer.Application{{Name: "frontend"}, {Name: "backend"}}, nil)
+				m.projectStacks.EXPECT().GetRegionalProjectResources(mockProject).Return([]*archer.ProjectRegionalResources{
+					{Region: "us-west-2", RepositoryURLs: map[string]string{"frontend": "12345.dkr.ecr.us-west-2.amazonaws.com/chicken/frontend"}},
+				}, nil)
+				m.repositories.EXPECT().GetRepository("chicken/frontend").Return("", errors.New("repository chicken/frontend not found"))
+			},
+			wantedErr: errors.New("7 checks failed"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := doctorMocks{
+				identity:      climocks.NewMockidentityService(ctrl),
+				docker:        climocks.NewMockdockerChecker(ctrl),
+				ws:            climocks.NewMockdoctorWorkspace(ctrl),
+				store:         climocks.NewMockdoctorStore(ctrl),
+				projectStacks: climocks.NewMockprojectStacksDescriber(ctrl),
+				envDescriber:  climocks.NewMockenvStackDescriber(ctrl),
+				repositories:  climocks.NewMockrepositoryGetter(ctrl),
+			}
+			tc.setupMock(m)
+			opts := &DoctorOpts{
+				region:        tc.inRegion,
+				identity:      m.identity,
+				docker:        m.docker,
+				ws:            m.ws,
+				store:         m.store,
+				projectStacks: m.projectStacks,
+				initEnvDescriber: func(env *archer.Environment) (envStackDescriber, error) {
+					return m.envDescriber, nil
+				},
+				initRepositoryGetter: func(region string) (repositoryGetter, error) {
+					require.Equal(t, "us-west-2", region)
+					return m.repositories, nil
+				},
+				GlobalOpts: &GlobalOpts{projectName: tc.inProject},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -25,9 +25,12 @@ An environment represents a deployment stage.`,
 	// The flags bound by viper are available to all sub-commands through viper.GetString({flagName})
 	cmd.PersistentFlags().StringP(projectFlag, projectFlagShort, "" /* default */, projectFlagDescription)
 	viper.BindPFlag(projectFlag, cmd.PersistentFlags().Lookup(projectFlag))
+	markFlagCompletion(cmd.PersistentFlags(), projectFlag, completeProjects)
 
 	cmd.AddCommand(BuildEnvInitCmd())
 	cmd.AddCommand(BuildEnvListCmd())
+	cmd.AddCommand(BuildEnvShowCmd())
+	cmd.AddCommand(BuildEnvUpgradeCmd())
 	cmd.AddCommand(BuildEnvDeleteCmd())
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -9,12 +9,12 @@ import (
 	"strings"
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
-	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/session"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
 	termprogress "github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/progress"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/workspace"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/iam"
@@ -45,6 +45,7 @@ type DeleteEnvOpts struct {
 	rgClient     resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI
 	iamClient    iamiface.IAMAPI
 	deployClient environmentDeployer
+	envProfiles  envProfileSetter
 	prog         progress
 
 	// Cached objects to avoid multiple requests.
@@ -69,7 +70,7 @@ func (opts *DeleteEnvOpts) Validate() error {
 
 	if opts.rgClient == nil {
 		// Tests mock the client.
-		if err := opts.initClientsFromRole(opts.env.ManagerRoleARN, opts.env.Region); err != nil {
+		if err := opts.initClients(opts.env); err != nil {
 			return err
 		}
 	}
@@ -138,10 +139,10 @@ func (opts *DeleteEnvOpts) RecommendedActions() []string {
 	return nil
 }
 
-func (opts *DeleteEnvOpts) initClientsFromRole(roleARN, region string) error {
-	sess, err := session.FromRole(roleARN, region)
+func (opts *DeleteEnvOpts) initClients(env *archer.Environment) error {
+	sess, err := opts.sessionProvider().FromEnvRole(env.Name, env.ManagerRoleARN, env.Region)
 	if err != nil {
-		return fmt.Errorf("get session from role %s and region %s: %w", roleARN, region, err)
+		return fmt.Errorf("get session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
 	}
 	opts.rgClient = resourcegroupstaggingapi.New(sess)
 	opts.iamClient = iam.New(sess)
@@ -154,7 +155,7 @@ func (opts *DeleteEnvOpts) shouldDelete(projName, envName string) (bool, error)
 		return true, nil
 	}
 
-	shouldDelete, err := opts.prompt.Confirm(fmt.Sprintf(fmtDeleteEnvPrompt, envName, projName), "")
+	shouldDelete, err := opts.promptFor(yesFlag).Confirm(fmt.Sprintf(fmtDeleteEnvPrompt, envName, projName), "")
 	if err != nil {
 		return false, fmt.Errorf("prompt for environment deletion: %w", err)
 	}
@@ -218,6 +219,14 @@ func (opts *DeleteEnvOpts) deleteRoles() bool {
 func (opts *DeleteEnvOpts) deleteFromStore() {
 	if err := opts.storeClient.DeleteEnvironment(opts.env.Project, opts.env.Name); err != nil {
 		log.Infof("Failed to remove environment %s from project %s store: %w\n", opts.env.Name, opts.env.Project, err)
+		return
+	}
+	// A new environment with the same name shouldn't be managed with the profile of the deleted one.
+	if err := opts.envProfiles.SetEnvProfile(opts.env.Name, ""); err != nil {
+		var errNoWorkspace *workspace.ErrWorkspaceNotFound
+		if !errors.As(err, &errNoWorkspace) {
+			log.Infof("Failed to remove the profile of environment %s from the workspace: %v\n", opts.env.Name, err)
+		}
 	}
 }
 
@@ -247,6 +256,11 @@ func BuildEnvDeleteCmd() *cobra.Command {
 				return fmt.Errorf("connect to ecs-cli metadata store: %w", err)
 			}
 			opts.storeClient = store
+			ws, err := workspace.New()
+			if err != nil {
+				return fmt.Errorf("new workspace: %w", err)
+			}
+			opts.envProfiles = ws
 			opts.prog = termprogress.NewSpinner()
 			return nil
 		}),
@@ -258,5 +272,6 @@ func BuildEnvDeleteCmd() *cobra.Command {
 		}),
 	}
 	cmd.Flags().BoolVar(&opts.SkipConfirmation, yesFlag, false, yesFlagDescription)
+	markArgsCompletion(cmd, completeEnvs)
 	return cmd
 }
@@ -150,6 +150,8 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 		mockIAM      func(ctrl *gomock.Controller) *climocks.MockIAMAPI
 		mockStore    func(ctrl *gomock.Controller) *mocks.MockEnvironmentStore
 
+		mockEnvProfiles func(m *climocks.MockenvProfileSetter)
+
 		wantedError error
 	}{
 		"error from prompt": {
@@ -292,6 +294,9 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 				store.EXPECT().DeleteEnvironment(testProject, testEnv).Return(nil)
 				return store
 			},
+			mockEnvProfiles: func(m *climocks.MockenvProfileSetter) {
+				m.EXPECT().SetEnvProfile(testEnv, "").Return(nil)
+			},
 		},
 	}
 
@@ -300,12 +305,17 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 			// GIVEN
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
+			mockEnvProfiles := climocks.NewMockenvProfileSetter(ctrl)
+			if tc.mockEnvProfiles != nil {
+				tc.mockEnvProfiles(mockEnvProfiles)
+			}
 			opts := DeleteEnvOpts{
 				EnvName:          testEnv,
 				SkipConfirmation: tc.inSkipPrompt,
 				storeClient:      tc.mockStore(ctrl),
 				iamClient:        tc.mockIAM(ctrl),
 				deployClient:     tc.mockDeploy(ctrl),
+				envProfiles:      mockEnvProfiles,
 				prog:             tc.mockProg(ctrl),
 				env: &archer.Environment{
 					Project:          testProject,
@@ -4,19 +4,23 @@
 package cli
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"net"
+	"strconv"
 	"strings"
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/ec2"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/identity"
-	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/session"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
 	termprogress "github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/progress"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/workspace"
 	"github.com/spf13/cobra"
 )
 
@@ -33,13 +37,66 @@ const (
 	fmtAddEnvToProjectComplete = "Linked account %s and region %s project %s."
 )
 
+const (
+	envInitDefaultConfigSelectOption = "Yes, use default."
+	envInitConfigureVPCSelectOption  = "No, I'd like to configure the network of a new VPC."
+	envInitImportVPCSelectOption     = "No, I'd like to import an existing VPC."
+
+	envInitVPCConfigPrompt      = "Would you like to create a new VPC with the default configuration for your environment?"
+	envInitVPCConfigHelp        = "A new VPC with two public and two private subnets, and a NAT gateway in each availability zone, is created by default."
+	envInitVPCPrompt            = "Which VPC would you like to use?"
+	envInitVPCHelp              = "The VPC is kept when the environment is deleted."
+	envInitPublicSubnetsPrompt  = "Which public subnets would you like to use?"
+	envInitPublicSubnetsHelp    = "The public load balancer of the environment is placed in these subnets. Select at least two subnets in different availability zones."
+	envInitPrivateSubnetsPrompt = "Which private subnets would you like to use?"
+	envInitPrivateSubnetsHelp   = "The tasks of the applications are placed in these subnets. They need a route to the internet or VPC endpoints to pull images."
+	envInitVPCCIDRPrompt        = "What CIDR block would you like to use for the VPC?"
+	envInitVPCCIDRHelp          = "The subnets of the environment are carved out of this block, e.g. 10.0.0.0/16."
+	envInitAZCountPrompt        = "How many availability zones would you like to use?"
+	envInitAZCountHelp          = "A public and a private subnet are created in each availability zone."
+	envInitNATGatewaysPrompt    = "Which NAT gateways would you like to create?"
+	envInitNATGatewaysHelp      = `NAT gateways let private services send requests to the internet.
+"per-az" keeps working when a zone fails, "single" costs less, and "none" only gives access to AWS services through VPC endpoints.`
+)
+
+const minImportPublicSubnets = 2 // Load balancers require subnets in at least two availability zones.
+
+// Default layout of the VPC created for an environment.
+const (
+	defaultVPCCIDR      = "10.0.0.0/16"
+	defaultAZCount      = 2
+	defaultSubnetPrefix = 24
+	minAZCount          = 2
+	maxAZCount          = 4
+)
+
+type vpcLister interface {
+	ListVPCs() ([]ec2.VPC, error)
+	ListSubnets(vpcID string) ([]ec2.Subnet, error)
+}
+
+type envProfileSetter interface {
+	SetEnvProfile(envName, profile string) error
+}
+
 // InitEnvOpts contains the fields to collect for adding an environment.
 type InitEnvOpts struct {
 	// Flags set by the user.
 	EnvName      string // Name of the environment.
-	EnvProfile   string // AWS profile used to create an environment.
+	EnvProfile   string // AWS profile used to create an environment, the default credentials are used if empty.
 	IsProduction bool   // Marks the environment as "production" to create it with additional guardrails.
 
+	ImportVPCID            string   // ID of an existing VPC to use instead of creating one.
+	ImportPublicSubnetIDs  []string // IDs of the public subnets of the imported VPC.
+	ImportPrivateSubnetIDs []string // IDs of the private subnets of the imported VPC.
+
+	VPCCIDR            string   // CIDR block of the VPC to create.
+	AZCount            int      // Number of availability zones of the VPC to create.
+	PublicSubnetCIDRs  []string // CIDR blocks of the public subnets of the VPC to create.
+	PrivateSubnetCIDRs []string // CIDR blocks of the private subnets of the VPC to create.
+	NATGatewayMode     string   // NAT gateways of the VPC to create.
+	Private            bool     // Creates the VPC without access to the internet.
+
 	// Interfaces to interact with dependencies.
 	projectGetter archer.ProjectGetter
 	envCreator    archer.EnvironmentCreator
@@ -47,6 +104,8 @@ type InitEnvOpts struct {
 	projDeployer  deployer
 	identity      identityService
 	envIdentity   identityService
+	vpcLister     vpcLister
+	envProfiles   envProfileSetter
 	prog          progress
 
 	*GlobalOpts // Embed global options.
@@ -55,7 +114,7 @@ type InitEnvOpts struct {
 // Ask asks for fields that are required but not passed in.
 func (opts *InitEnvOpts) Ask() error {
 	if opts.EnvName == "" {
-		envName, err := opts.prompt.Get(
+		envName, err := opts.promptForArg(nameArg).Get(
 			"What is your environment's name?",
 			"A unique identifier for an environment (e.g. dev, test, prod)",
 			validateEnvironmentName,
@@ -65,9 +124,133 @@ func (opts *InitEnvOpts) Ask() error {
 		}
 		opts.EnvName = envName
 	}
+	return opts.askVPC()
+}
+
+// askVPC prompts for the configuration of the environment's VPC unless it's set by flags.
+func (opts *InitEnvOpts) askVPC() error {
+	if opts.importsVPC() {
+		return opts.askImportVPC()
+	}
+	if opts.configuresNewVPC() || !opts.interactive() {
+		// Without flags, non-interactive commands create the default VPC.
+		return nil
+	}
+	config, err := opts.prompt.SelectOne(envInitVPCConfigPrompt, envInitVPCConfigHelp,
+		[]string{envInitDefaultConfigSelectOption, envInitConfigureVPCSelectOption, envInitImportVPCSelectOption})
+	if err != nil {
+		return fmt.Errorf("select VPC configuration: %w", err)
+	}
+	switch config {
+	case envInitConfigureVPCSelectOption:
+		return opts.askNewVPC()
+	case envInitImportVPCSelectOption:
+		return opts.askImportVPC()
+	}
+	return nil
+}
+
+// askNewVPC prompts for the layout of the VPC to create.
+// The subnet CIDR blocks aren't prompted for, they're carved out of the VPC's block unless set by flags.
+func (opts *InitEnvOpts) askNewVPC() error {
+	cidr, err := opts.promptFor(vpcCIDRFlag).Get(envInitVPCCIDRPrompt, envInitVPCCIDRHelp, validateCIDR)
+	if err != nil {
+		return fmt.Errorf("get VPC CIDR block: %w", err)
+	}
+	opts.VPCCIDR = cidr
+
+	var azCounts []string
+	for count := minAZCount; count <= maxAZCount; count++ {
+		azCounts = append(azCounts, strconv.Itoa(count))
+	}
+	azCount, err := opts.promptFor(azCountFlag).SelectOne(envInitAZCountPrompt, envInitAZCountHelp, azCounts)
+	if err != nil {
+		return fmt.Errorf("select number of availability zones: %w", err)
+	}
+	opts.AZCount, _ = strconv.Atoi(azCount)
+
+	mode, err := opts.promptFor(natGatewaysFlag).SelectOne(envInitNATGatewaysPrompt, envInitNATGatewaysHelp, deploy.NATGatewayModes)
+	if err != nil {
+		return fmt.Errorf("select NAT gateways: %w", err)
+	}
+	opts.NATGatewayMode = mode
+	return nil
+}
+
+// askImportVPC prompts for an existing VPC and its subnets unless they're set by flags.
+func (opts *InitEnvOpts) askImportVPC() error {
+	if opts.ImportVPCID == "" {
+		vpcs, err := opts.vpcLister.ListVPCs()
+		if err != nil {
+			return fmt.Errorf("list VPCs: %w", err)
+		}
+		if len(vpcs) == 0 {
+			return errors.New("no VPCs found in the account and region of the environment's profile")
+		}
+		options := make([]string, len(vpcs))
+		for i, vpc := range vpcs {
+			options[i] = vpc.String()
+		}
+		selected, err := opts.promptFor(importVPCIDFlag).SelectOne(envInitVPCPrompt, envInitVPCHelp, options)
+		if err != nil {
+			return fmt.Errorf("select VPC: %w", err)
+		}
+		for i, option := range options {
+			if option == selected {
+				opts.ImportVPCID = vpcs[i].ID
+			}
+		}
+	}
+	if len(opts.ImportPublicSubnetIDs) != 0 && len(opts.ImportPrivateSubnetIDs) != 0 {
+		return nil
+	}
+	subnets, err := opts.vpcLister.ListSubnets(opts.ImportVPCID)
+	if err != nil {
+		return fmt.Errorf("list subnets of VPC %s: %w", opts.ImportVPCID, err)
+	}
+	if len(opts.ImportPublicSubnetIDs) == 0 {
+		ids, err := selectSubnets(opts.promptFor(importPublicSubnetsFlag), envInitPublicSubnetsPrompt, envInitPublicSubnetsHelp, subnets, opts.ImportPrivateSubnetIDs)
+		if err != nil {
+			return fmt.Errorf("select public subnets: %w", err)
+		}
+		opts.ImportPublicSubnetIDs = ids
+	}
+	if len(opts.ImportPrivateSubnetIDs) == 0 {
+		ids, err := selectSubnets(opts.promptFor(importPrivateSubnetsFlag), envInitPrivateSubnetsPrompt, envInitPrivateSubnetsHelp, subnets, opts.ImportPublicSubnetIDs)
+		if err != nil {
+			return fmt.Errorf("select private subnets: %w", err)
+		}
+		opts.ImportPrivateSubnetIDs = ids
+	}
 	return nil
 }
 
+// selectSubnets prompts for several subnets among the ones that aren't already taken and returns their IDs.
+func selectSubnets(p prompter, msg, help string, subnets []ec2.Subnet, taken []string) ([]string, error) {
+	isTaken := make(map[string]bool)
+	for _, id := range taken {
+		isTaken[id] = true
+	}
+	var options []string
+	ids := make(map[string]string)
+	for _, subnet := range subnets {
+		if isTaken[subnet.ID] {
+			continue
+		}
+		options = append(options, subnet.String())
+		ids[subnet.String()] = subnet.ID
+	}
+	selected, err := p.MultiSelect(msg, help, options)
+	if err != nil {
+		return nil, err
+	}
+	var selectedIDs []string
+	for _, option := range selected {
+		selectedIDs = append(selectedIDs, ids[option])
+	}
+	return selectedIDs, nil
+}
+
 // Validate returns an error if the values passed by the user are invalid.
 func (opts *InitEnvOpts) Validate() error {
 	if opts.EnvName != "" {
@@ -78,9 +261,169 @@ func (opts *InitEnvOpts) Validate() error {
 	if opts.ProjectName() == "" {
 		return errors.New("no project found, run `project init` first please")
 	}
+	if err := opts.validateImportVPC(); err != nil {
+		return err
+	}
+	return opts.validateNewVPC()
+}
+
+func (opts *InitEnvOpts) importsVPC() bool {
+	return opts.ImportVPCID != "" || len(opts.ImportPublicSubnetIDs) != 0 || len(opts.ImportPrivateSubnetIDs) != 0
+}
+
+func (opts *InitEnvOpts) configuresNewVPC() bool {
+	return opts.VPCCIDR != "" || opts.AZCount != 0 || len(opts.PublicSubnetCIDRs) != 0 ||
+		len(opts.PrivateSubnetCIDRs) != 0 || opts.NATGatewayMode != "" || opts.Private
+}
+
+func (opts *InitEnvOpts) validateImportVPC() error {
+	if opts.ImportVPCID == "" {
+		if len(opts.ImportPublicSubnetIDs) != 0 || len(opts.ImportPrivateSubnetIDs) != 0 {
+			return fmt.Errorf("--%s is required to import subnets", importVPCIDFlag)
+		}
+		return nil
+	}
+	if len(opts.ImportPublicSubnetIDs) < minImportPublicSubnets {
+		return fmt.Errorf("at least %d public subnets are required to import VPC %s", minImportPublicSubnets, opts.ImportVPCID)
+	}
+	if len(opts.ImportPrivateSubnetIDs) == 0 {
+		return fmt.Errorf("at least 1 private subnet is required to import VPC %s", opts.ImportVPCID)
+	}
+	for _, public := range opts.ImportPublicSubnetIDs {
+		for _, private := range opts.ImportPrivateSubnetIDs {
+			if public == private {
+				return fmt.Errorf("subnet %s can't be both public and private", public)
+			}
+		}
+	}
 	return nil
 }
 
+func (opts *InitEnvOpts) validateNewVPC() error {
+	if !opts.configuresNewVPC() {
+		return nil
+	}
+	if opts.importsVPC() {
+		return fmt.Errorf("--%s can't be used with the flags configuring a new VPC", importVPCIDFlag)
+	}
+	_, err := opts.newVPCConfig()
+	return err
+}
+
+// newVPCConfig returns the layout of the VPC to create with defaults for the values that aren't set,
+// or nil if the default layout of the environment template is used.
+func (opts *InitEnvOpts) newVPCConfig() (*deploy.NewVPCConfig, error) {
+	if !opts.configuresNewVPC() {
+		return nil, nil
+	}
+	conf := &deploy.NewVPCConfig{
+		CIDR:           opts.VPCCIDR,
+		AZCount:        opts.AZCount,
+		NATGatewayMode: opts.NATGatewayMode,
+	}
+	if conf.CIDR == "" {
+		conf.CIDR = defaultVPCCIDR
+	}
+	if conf.AZCount == 0 {
+		conf.AZCount = defaultAZCount
+	}
+	if conf.NATGatewayMode == "" {
+		conf.NATGatewayMode = deploy.NATGatewayPerAZ
+		if opts.Private {
+			conf.NATGatewayMode = deploy.NATGatewayNone
+		}
+	}
+	if opts.Private && conf.NATGatewayMode != deploy.NATGatewayNone {
+		return nil, fmt.Errorf("--%s %s can't be used with --%s: private environments don't have NAT gateways", natGatewaysFlag, conf.NATGatewayMode, privateFlag)
+	}
+	if conf.AZCount < minAZCount || conf.AZCount > maxAZCount {
+		return nil, fmt.Errorf("number of availability zones %d is invalid: must be between %d and %d", conf.AZCount, minAZCount, maxAZCount)
+	}
+	if err := validateOneOf(conf.NATGatewayMode, "NAT gateway mode", deploy.NATGatewayModes); err != nil {
+		return nil, err
+	}
+	vpc, err := parseCIDR(conf.CIDR)
+	if err != nil {
+		return nil, fmt.Errorf("VPC CIDR block %s is invalid: %w", conf.CIDR, err)
+	}
+
+	// Public subnets come first in the VPC's block, followed by the private subnets.
+	public, err := subnetBlocks(vpc, "public", opts.PublicSubnetCIDRs, conf.AZCount, 0)
+	if err != nil {
+		return nil, err
+	}
+	private, err := subnetBlocks(vpc, "private", opts.PrivateSubnetCIDRs, conf.AZCount, conf.AZCount)
+	if err != nil {
+		return nil, err
+	}
+	subnets := append(public, private...)
+	for i, subnet := range subnets {
+		for _, other := range subnets[i+1:] {
+			if subnet.Contains(other.IP) || other.Contains(subnet.IP) {
+				return nil, fmt.Errorf("subnet CIDR blocks %s and %s overlap", subnet, other)
+			}
+		}
+	}
+	for _, subnet := range public {
+		conf.PublicSubnetCIDRs = append(conf.PublicSubnetCIDRs, subnet.String())
+	}
+	for _, subnet := range private {
+		conf.PrivateSubnetCIDRs = append(conf.PrivateSubnetCIDRs, subnet.String())
+	}
+	return conf, nil
+}
+
+// subnetBlocks parses the CIDR blocks of the public or private subnets, one per availability zone.
+// If no block is set, they're carved out of the VPC's block starting at the offset-th block.
+func subnetBlocks(vpc *net.IPNet, kind string, cidrs []string, azCount, offset int) ([]*net.IPNet, error) {
+	var blocks []*net.IPNet
+	if len(cidrs) == 0 {
+		for i := 0; i < azCount; i++ {
+			block, err := carveSubnet(vpc, offset+i, 2*azCount)
+			if err != nil {
+				return nil, err
+			}
+			blocks = append(blocks, block)
+		}
+		return blocks, nil
+	}
+	if len(cidrs) != azCount {
+		return nil, fmt.Errorf("%d %s subnet CIDR blocks are required, one per availability zone", azCount, kind)
+	}
+	vpcPrefix, _ := vpc.Mask.Size()
+	for _, cidr := range cidrs {
+		block, err := parseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("%s subnet CIDR block %s is invalid: %w", kind, cidr, err)
+		}
+		if prefix, _ := block.Mask.Size(); prefix < vpcPrefix || !vpc.Contains(block.IP) {
+			return nil, fmt.Errorf("%s subnet CIDR block %s is not in VPC CIDR block %s", kind, cidr, vpc)
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
+
+// carveSubnet returns the i-th of n equally sized blocks carved out of the VPC's block.
+// Blocks are /24 if the VPC's block is large enough.
+func carveSubnet(vpc *net.IPNet, i, n int) (*net.IPNet, error) {
+	vpcPrefix, _ := vpc.Mask.Size()
+	bits := 0
+	for 1<<bits < n {
+		bits++
+	}
+	prefix := vpcPrefix + bits
+	if prefix < defaultSubnetPrefix {
+		prefix = defaultSubnetPrefix
+	}
+	if prefix > maxCIDRPrefix {
+		return nil, fmt.Errorf("VPC CIDR block %s is too small for %d subnets", vpc, n)
+	}
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, binary.BigEndian.Uint32(vpc.IP.To4())+uint32(i)<<(32-prefix))
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(prefix, 32)}, nil
+}
+
 // Execute deploys a new environment with CloudFormation and adds it to SSM.
 func (opts *InitEnvOpts) Execute() error {
 	project, err := opts.projectGetter.GetProject(opts.ProjectName())
@@ -101,7 +444,20 @@ func (opts *InitEnvOpts) Execute() error {
 		PublicLoadBalancer:       true, // TODO: configure this based on user input or application Type needs?
 		ToolsAccountPrincipalARN: caller.RootUserARN,
 		ProjectDNSName:           project.Domain,
+		Private:                  opts.Private,
+	}
+	if opts.ImportVPCID != "" {
+		deployEnvInput.ImportVPC = &deploy.ImportVPCConfig{
+			ID:               opts.ImportVPCID,
+			PublicSubnetIDs:  opts.ImportPublicSubnetIDs,
+			PrivateSubnetIDs: opts.ImportPrivateSubnetIDs,
+		}
+	}
+	newVPC, err := opts.newVPCConfig()
+	if err != nil {
+		return err
 	}
+	deployEnvInput.NewVPC = newVPC
 
 	if project.RequiresDNSDelegation() {
 		if err := opts.delegateDNSFromProject(project); err != nil {
@@ -128,7 +484,7 @@ func (opts *InitEnvOpts) Execute() error {
 	opts.prog.Start(fmt.Sprintf(fmtStreamEnvStart, color.HighlightUserInput(opts.EnvName)))
 	stackEvents, responses := opts.envDeployer.StreamEnvironmentCreation(deployEnvInput)
 	for stackEvent := range stackEvents {
-		opts.prog.Events(opts.humanizeEnvironmentEvents(stackEvent))
+		opts.prog.Events(humanizeEnvironmentEvents(deployEnvInput, stackEvent))
 	}
 	resp := <-responses
 	if resp.Err != nil {
@@ -151,6 +507,16 @@ func (opts *InitEnvOpts) Execute() error {
 	}
 	log.Successf("Created environment %s in region %s under project %s.\n",
 		color.HighlightUserInput(resp.Env.Name), color.HighlightResource(resp.Env.Region), color.HighlightResource(resp.Env.Project))
+
+	// 5. Record the environment's profile so that later commands manage the environment with it.
+	if opts.EnvProfile == "" {
+		return nil
+	}
+	if err := opts.envProfiles.SetEnvProfile(opts.EnvName, opts.EnvProfile); err != nil {
+		// The environment is created, the profile has to be passed with the --profile flag to manage it instead.
+		log.Warningf("Couldn't record the profile %s of environment %s in the workspace: %v.\n",
+			color.HighlightUserInput(opts.EnvProfile), color.HighlightUserInput(opts.EnvName), err)
+	}
 	return nil
 }
 
@@ -174,7 +540,7 @@ func (opts *InitEnvOpts) delegateDNSFromProject(project *archer.Project) error {
 	return nil
 }
 
-func (opts *InitEnvOpts) humanizeEnvironmentEvents(resourceEvents []deploy.ResourceEvent) []termprogress.TabRow {
+func humanizeEnvironmentEvents(in *deploy.CreateEnvironmentInput, resourceEvents []deploy.ResourceEvent) []termprogress.TabRow {
 	matcher := map[termprogress.Text]termprogress.ResourceMatcher{
 		textVPC: func(event deploy.Resource) bool {
 			return event.Type == "AWS::EC2::VPC"
@@ -198,6 +564,10 @@ func (opts *InitEnvOpts) humanizeEnvironmentEvents(resourceEvents []deploy.Resou
 		textRouteTables: func(event deploy.Resource) bool {
 			return strings.Contains(event.LogicalName, "Route")
 		},
+		textVPCEndpoints: func(event deploy.Resource) bool {
+			return event.Type == "AWS::EC2::VPCEndpoint" ||
+				event.LogicalName == "VPCEndpointSecurityGroup"
+		},
 		textECSCluster: func(event deploy.Resource) bool {
 			return event.Type == "AWS::ECS::Cluster"
 		},
@@ -206,13 +576,50 @@ func (opts *InitEnvOpts) humanizeEnvironmentEvents(resourceEvents []deploy.Resou
 				strings.Contains(event.Type, "ElasticLoadBalancingV2")
 		},
 	}
+	if in.ImportVPC != nil {
+		// The network resources of an imported VPC aren't part of the stack.
+		for _, text := range []termprogress.Text{textVPC, textInternetGateway, textPublicSubnets, textPrivateSubnets, textNATGateway, textRouteTables, textVPCEndpoints} {
+			delete(matcher, text)
+		}
+	}
+	azCount, natGateways := defaultAZCount, defaultAZCount
+	if in.NewVPC != nil {
+		azCount = in.NewVPC.AZCount
+		switch in.NewVPC.NATGatewayMode {
+		case deploy.NATGatewayPerAZ:
+			natGateways = azCount
+		case deploy.NATGatewaySingle:
+			natGateways = 1
+		case deploy.NATGatewayNone:
+			natGateways = 0
+		}
+	}
+	if in.Private {
+		// Private environments don't have a public network.
+		natGateways = 0
+		delete(matcher, textInternetGateway)
+		delete(matcher, textPublicSubnets)
+	}
+	if natGateways == 0 {
+		delete(matcher, textNATGateway)
+	} else {
+		delete(matcher, textVPCEndpoints)
+	}
+	routes := 2 * azCount // A route table and an association for each private subnet.
+	if natGateways != 0 {
+		routes += azCount
+	}
+	if !in.Private {
+		routes += 2 + azCount // The public route table and its route, and an association for each public subnet.
+	}
 	resourceCounts := map[termprogress.Text]int{
 		textVPC:             1,
 		textInternetGateway: 2,
-		textPublicSubnets:   2,
-		textPrivateSubnets:  2,
-		textNATGateway:      4,
-		textRouteTables:     10,
+		textPublicSubnets:   azCount,
+		textPrivateSubnets:  azCount,
+		textNATGateway:      2 * natGateways, // An elastic IP and a NAT gateway each.
+		textRouteTables:     routes,
+		textVPCEndpoints:    8,
 		textECSCluster:      1,
 		textALB:             4,
 	}
@@ -227,7 +634,6 @@ func (opts *InitEnvOpts) RecommendedActions() []string {
 // BuildEnvInitCmd builds the command for adding an environment.
 func BuildEnvInitCmd() *cobra.Command {
 	opts := InitEnvOpts{
-		EnvProfile: "default",
 		prog:       termprogress.NewSpinner(),
 		GlobalOpts: NewGlobalOpts(),
 	}
@@ -235,12 +641,26 @@ func BuildEnvInitCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "init [name]",
 		Short: "Creates a new environment in your project.",
+		Long: `Creates a new environment in your project.
+The --env-profile flag chooses the AWS profile of the environment's account, the project is still managed with the default credentials.
+The profile is recorded in the workspace so that later commands manage the environment with it.`,
 		Example: `
-  Creates a test environment in your "default" AWS profile.
+  Creates a test environment with your default AWS credentials.
   /code $ archer env init test
 
   Creates a prod-iad environment using your "prod-admin" AWS profile.
-  /code $ archer env init prod-iad --profile prod-admin --prod`,
+  Later commands use the "prod-admin" profile to manage the environment.
+  /code $ archer env init prod-iad --env-profile prod-admin --prod
+
+  Creates a test environment in a VPC spanning three availability zones with a single NAT gateway.
+  /code $ archer env init test --vpc-cidr 172.16.0.0/20 --az-count 3 --nat-gateways single
+
+  Creates a prod environment without access to the internet.
+  /code $ archer env init prod --prod --private
+
+  Creates a test environment in an existing VPC.
+  /code $ archer env init test --import-vpc-id vpc-0a1b2c3d \
+  --import-public-subnets subnet-1,subnet-2 --import-private-subnets subnet-3,subnet-4`,
 		Args: reservedArgs,
 		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			if len(args) > 0 {
@@ -250,11 +670,15 @@ func BuildEnvInitCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			profileSess, err := session.FromProfile(opts.EnvProfile)
+			ws, err := workspace.New()
+			if err != nil {
+				return fmt.Errorf("new workspace: %w", err)
+			}
+			profileSess, err := opts.sessionProvider().FromProfile(opts.EnvProfile)
 			if err != nil {
 				return err
 			}
-			defaultSession, err := session.Default()
+			defaultSession, err := opts.sessionProvider().Default()
 			if err != nil {
 				return err
 			}
@@ -264,6 +688,8 @@ func BuildEnvInitCmd() *cobra.Command {
 			opts.projDeployer = cloudformation.New(defaultSession)
 			opts.identity = identity.New(defaultSession)
 			opts.envIdentity = identity.New(profileSess)
+			opts.vpcLister = ec2.New(profileSess)
+			opts.envProfiles = ws
 			return nil
 		}),
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
@@ -276,8 +702,17 @@ func BuildEnvInitCmd() *cobra.Command {
 			return opts.Execute()
 		}),
 	}
-	cmd.Flags().StringVar(&opts.EnvProfile, profileFlag, "default", profileFlagDescription)
+	cmd.Flags().StringVar(&opts.EnvProfile, envProfileFlag, "", envProfileFlagDescription)
 	cmd.Flags().BoolVar(&opts.IsProduction, prodEnvFlag, false, prodEnvFlagDescription)
+	cmd.Flags().StringVar(&opts.ImportVPCID, importVPCIDFlag, "", importVPCIDFlagDescription)
+	cmd.Flags().StringSliceVar(&opts.ImportPublicSubnetIDs, importPublicSubnetsFlag, nil, importPublicSubnetsFlagDescription)
+	cmd.Flags().StringSliceVar(&opts.ImportPrivateSubnetIDs, importPrivateSubnetsFlag, nil, importPrivateSubnetsFlagDescription)
+	cmd.Flags().StringVar(&opts.VPCCIDR, vpcCIDRFlag, "", vpcCIDRFlagDescription)
+	cmd.Flags().IntVar(&opts.AZCount, azCountFlag, 0, azCountFlagDescription)
+	cmd.Flags().StringSliceVar(&opts.PublicSubnetCIDRs, publicSubnetCIDRsFlag, nil, publicSubnetCIDRsFlagDescription)
+	cmd.Flags().StringSliceVar(&opts.PrivateSubnetCIDRs, privateSubnetCIDRsFlag, nil, privateSubnetCIDRsFlagDescription)
+	cmd.Flags().StringVar(&opts.NATGatewayMode, natGatewaysFlag, "", natGatewaysFlagDescription)
+	cmd.Flags().BoolVar(&opts.Private, privateFlag, false, privateFlagDescription)
 
 	return cmd
 }
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/ec2"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/identity"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
@@ -22,59 +23,186 @@ import (
 )
 
 func TestInitEnvOpts_Ask(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	mockPrompter := climocks.NewMockprompter(ctrl)
-
 	mockEnv := "mockEnv"
+	subnets := []ec2.Subnet{
+		{ID: "subnet-1", CIDR: "10.0.0.0/24", AvailabilityZone: "us-west-2a"},
+		{ID: "subnet-2", CIDR: "10.0.1.0/24", AvailabilityZone: "us-west-2b"},
+		{ID: "subnet-3", CIDR: "10.0.2.0/24", AvailabilityZone: "us-west-2a"},
+	}
 
 	testCases := map[string]struct {
-		inputEnv     string
-		inputProject string
-
-		setupMocks func()
+		inputEnv            string
+		inputVPCID          string
+		inputPublicSubnets  []string
+		inputPrivateSubnets []string
+		inputNATGateway     string
+
+		setupMocks func(prompt *climocks.Mockprompter, vpcLister *climocks.MockvpcLister)
+
+		wantedVPCID          string
+		wantedPublicSubnets  []string
+		wantedPrivateSubnets []string
+		wantedVPCCIDR        string
+		wantedAZCount        int
+		wantedNATGateway     string
+		wantedErr            error
 	}{
 		"with no flags set": {
-			setupMocks: func() {
+			setupMocks: func(prompt *climocks.Mockprompter, vpcLister *climocks.MockvpcLister) {
 				gomock.InOrder(
-					mockPrompter.EXPECT().
+					prompt.EXPECT().
 						Get(
 							gomock.Eq("What is your environment's name?"),
 							gomock.Eq("A unique identifier for an environment (e.g. dev, test, prod)"),
 							gomock.Any()).
 						Return(mockEnv, nil).
-						Times(1))
+						Times(1),
+					prompt.EXPECT().SelectOne(envInitVPCConfigPrompt, envInitVPCConfigHelp, gomock.Any()).Return(envInitDefaultConfigSelectOption, nil),
+				)
+				vpcLister.EXPECT().ListVPCs().Times(0)
 			},
 		},
+		"prompts for the VPC and subnets to import": {
+			inputEnv: mockEnv,
+			setupMocks: func(prompt *climocks.Mockprompter, vpcLister *climocks.MockvpcLister) {
+				prompt.EXPECT().SelectOne(envInitVPCConfigPrompt, envInitVPCConfigHelp, gomock.Any()).Return(envInitImportVPCSelectOption, nil)
+				vpcLister.EXPECT().ListVPCs().Return([]ec2.VPC{
+					{ID: "vpc-1", CIDR: "10.0.0.0/16"},
+					{ID: "vpc-2", Name: "shared", CIDR: "10.1.0.0/16"},
+				}, nil)
+				prompt.EXPECT().SelectOne(envInitVPCPrompt, envInitVPCHelp, []string{"vpc-1 (10.0.0.0/16)", "vpc-2 (shared, 10.1.0.0/16)"}).Return("vpc-2 (shared, 10.1.0.0/16)", nil)
+				vpcLister.EXPECT().ListSubnets("vpc-2").Return(subnets, nil)
+				gomock.InOrder(
+					prompt.EXPECT().MultiSelect(envInitPublicSubnetsPrompt, envInitPublicSubnetsHelp, []string{
+						"subnet-1 (10.0.0.0/24, us-west-2a)",
+						"subnet-2 (10.0.1.0/24, us-west-2b)",
+						"subnet-3 (10.0.2.0/24, us-west-2a)",
+					}).Return([]string{"subnet-1 (10.0.0.0/24, us-west-2a)", "subnet-2 (10.0.1.0/24, us-west-2b)"}, nil),
+					prompt.EXPECT().MultiSelect(envInitPrivateSubnetsPrompt, envInitPrivateSubnetsHelp, []string{
+						"subnet-3 (10.0.2.0/24, us-west-2a)",
+					}).Return([]string{"subnet-3 (10.0.2.0/24, us-west-2a)"}, nil),
+				)
+			},
+			wantedVPCID:          "vpc-2",
+			wantedPublicSubnets:  []string{"subnet-1", "subnet-2"},
+			wantedPrivateSubnets: []string{"subnet-3"},
+		},
+		"only prompts for the subnets that aren't passed in": {
+			inputEnv:           mockEnv,
+			inputVPCID:         "vpc-1",
+			inputPublicSubnets: []string{"subnet-1", "subnet-2"},
+			setupMocks: func(prompt *climocks.Mockprompter, vpcLister *climocks.MockvpcLister) {
+				prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				vpcLister.EXPECT().ListVPCs().Times(0)
+				vpcLister.EXPECT().ListSubnets("vpc-1").Return(subnets, nil)
+				prompt.EXPECT().MultiSelect(envInitPrivateSubnetsPrompt, envInitPrivateSubnetsHelp, []string{
+					"subnet-3 (10.0.2.0/24, us-west-2a)",
+				}).Return([]string{"subnet-3 (10.0.2.0/24, us-west-2a)"}, nil)
+			},
+			wantedVPCID:          "vpc-1",
+			wantedPublicSubnets:  []string{"subnet-1", "subnet-2"},
+			wantedPrivateSubnets: []string{"subnet-3"},
+		},
+		"skips the prompts if the VPC flags are set": {
+			inputEnv:            mockEnv,
+			inputVPCID:          "vpc-1",
+			inputPublicSubnets:  []string{"subnet-1", "subnet-2"},
+			inputPrivateSubnets: []string{"subnet-3"},
+			setupMocks: func(prompt *climocks.Mockprompter, vpcLister *climocks.MockvpcLister) {
+				vpcLister.EXPECT().ListSubnets(gomock.Any()).Times(0)
+			},
+			wantedVPCID:          "vpc-1",
+			wantedPublicSubnets:  []string{"subnet-1", "subnet-2"},
+			wantedPrivateSubnets: []string{"subnet-3"},
+		},
+		"prompts for the layout of a new VPC": {
+			inputEnv: mockEnv,
+			setupMocks: func(prompt *climocks.Mockprompter, vpcLister *climocks.MockvpcLister) {
+				gomock.InOrder(
+					prompt.EXPECT().SelectOne(envInitVPCConfigPrompt, envInitVPCConfigHelp, gomock.Any()).Return(envInitConfigureVPCSelectOption, nil),
+					prompt.EXPECT().Get(envInitVPCCIDRPrompt, envInitVPCCIDRHelp, gomock.Any()).Return("172.16.0.0/20", nil),
+					prompt.EXPECT().SelectOne(envInitAZCountPrompt, envInitAZCountHelp, []string{"2", "3", "4"}).Return("3", nil),
+					prompt.EXPECT().SelectOne(envInitNATGatewaysPrompt, envInitNATGatewaysHelp, deploy.NATGatewayModes).Return(deploy.NATGatewaySingle, nil),
+				)
+				vpcLister.EXPECT().ListVPCs().Times(0)
+			},
+			wantedVPCCIDR:    "172.16.0.0/20",
+			wantedAZCount:    3,
+			wantedNATGateway: deploy.NATGatewaySingle,
+		},
+		"skips the prompts if the new VPC is configured by flags": {
+			inputEnv:        mockEnv,
+			inputNATGateway: deploy.NATGatewayNone,
+			setupMocks: func(prompt *climocks.Mockprompter, vpcLister *climocks.MockvpcLister) {
+				prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedNATGateway: deploy.NATGatewayNone,
+		},
+		"wraps error from listing VPCs": {
+			inputEnv: mockEnv,
+			setupMocks: func(prompt *climocks.Mockprompter, vpcLister *climocks.MockvpcLister) {
+				prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).Return(envInitImportVPCSelectOption, nil)
+				vpcLister.EXPECT().ListVPCs().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list VPCs: some error"),
+		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPrompter := climocks.NewMockprompter(ctrl)
+			mockVPCLister := climocks.NewMockvpcLister(ctrl)
+			tc.setupMocks(mockPrompter, mockVPCLister)
+
 			addEnv := &InitEnvOpts{
-				EnvName: tc.inputEnv,
+				EnvName:                tc.inputEnv,
+				ImportVPCID:            tc.inputVPCID,
+				ImportPublicSubnetIDs:  tc.inputPublicSubnets,
+				ImportPrivateSubnetIDs: tc.inputPrivateSubnets,
+				NATGatewayMode:         tc.inputNATGateway,
+				vpcLister:              mockVPCLister,
 				GlobalOpts: &GlobalOpts{
-					prompt:      mockPrompter,
-					projectName: tc.inputProject,
+					prompt: mockPrompter,
 				},
 			}
-			tc.setupMocks()
 
 			// WHEN
 			err := addEnv.Ask()
 
 			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
 			require.NoError(t, err)
 			require.Equal(t, mockEnv, addEnv.EnvName, "expected environment names to match")
+			require.Equal(t, tc.wantedVPCID, addEnv.ImportVPCID)
+			require.Equal(t, tc.wantedPublicSubnets, addEnv.ImportPublicSubnetIDs)
+			require.Equal(t, tc.wantedPrivateSubnets, addEnv.ImportPrivateSubnetIDs)
+			require.Equal(t, tc.wantedVPCCIDR, addEnv.VPCCIDR)
+			require.Equal(t, tc.wantedAZCount, addEnv.AZCount)
+			require.Equal(t, tc.wantedNATGateway, addEnv.NATGatewayMode)
 		})
 	}
 }
 
 func TestInitEnvOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inEnvName     string
-		inProjectName string
+		inEnvName          string
+		inProjectName      string
+		inVPCID            string
+		inPublicSubnetIDs  []string
+		inPrivateSubnetIDs []string
+
+		inVPCCIDR            string
+		inAZCount            int
+		inPublicSubnetCIDRs  []string
+		inPrivateSubnetCIDRs []string
+		inNATGatewayMode     string
+		inPrivate            bool
 
 		wantedErr string
 	}{
@@ -94,14 +222,141 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 
 			wantedErr: "no project found, run `project init` first please",
 		},
+		"valid imported VPC": {
+			inEnvName:          "test-pdx",
+			inProjectName:      "phonetool",
+			inVPCID:            "vpc-1",
+			inPublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+			inPrivateSubnetIDs: []string{"subnet-3"},
+		},
+		"subnets without a VPC": {
+			inEnvName:          "test-pdx",
+			inProjectName:      "phonetool",
+			inPrivateSubnetIDs: []string{"subnet-3"},
+
+			wantedErr: "--import-vpc-id is required to import subnets",
+		},
+		"a single public subnet": {
+			inEnvName:          "test-pdx",
+			inProjectName:      "phonetool",
+			inVPCID:            "vpc-1",
+			inPublicSubnetIDs:  []string{"subnet-1"},
+			inPrivateSubnetIDs: []string{"subnet-3"},
+
+			wantedErr: "at least 2 public subnets are required to import VPC vpc-1",
+		},
+		"no private subnets": {
+			inEnvName:         "test-pdx",
+			inProjectName:     "phonetool",
+			inVPCID:           "vpc-1",
+			inPublicSubnetIDs: []string{"subnet-1", "subnet-2"},
+
+			wantedErr: "at least 1 private subnet is required to import VPC vpc-1",
+		},
+		"subnet both public and private": {
+			inEnvName:          "test-pdx",
+			inProjectName:      "phonetool",
+			inVPCID:            "vpc-1",
+			inPublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+			inPrivateSubnetIDs: []string{"subnet-2"},
+
+			wantedErr: "subnet subnet-2 can't be both public and private",
+		},
+		"new VPC with an imported VPC": {
+			inEnvName:          "test-pdx",
+			inProjectName:      "phonetool",
+			inVPCID:            "vpc-1",
+			inPublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+			inPrivateSubnetIDs: []string{"subnet-3"},
+			inAZCount:          3,
+
+			wantedErr: "--import-vpc-id can't be used with the flags configuring a new VPC",
+		},
+		"too many availability zones": {
+			inEnvName:     "test-pdx",
+			inProjectName: "phonetool",
+			inAZCount:     5,
+
+			wantedErr: "number of availability zones 5 is invalid: must be between 2 and 4",
+		},
+		"unknown NAT gateway mode": {
+			inEnvName:        "test-pdx",
+			inProjectName:    "phonetool",
+			inNATGatewayMode: "some-mode",
+
+			wantedErr: `invalid NAT gateway mode some-mode: must be one of "per-az", "single", "none"`,
+		},
+		"invalid VPC CIDR block": {
+			inEnvName:     "test-pdx",
+			inProjectName: "phonetool",
+			inVPCCIDR:     "10.0.0.0/8",
+
+			wantedErr: "VPC CIDR block 10.0.0.0/8 is invalid: " + errValueBadCIDR.Error(),
+		},
+		"VPC CIDR block too small for the subnets": {
+			inEnvName:     "test-pdx",
+			inProjectName: "phonetool",
+			inVPCCIDR:     "10.0.0.0/26",
+			inAZCount:     4,
+
+			wantedErr: "VPC CIDR block 10.0.0.0/26 is too small for 8 subnets",
+		},
+		"wrong number of subnet CIDR blocks": {
+			inEnvName:           "test-pdx",
+			inProjectName:       "phonetool",
+			inPublicSubnetCIDRs: []string{"10.0.0.0/24"},
+
+			wantedErr: "2 public subnet CIDR blocks are required, one per availability zone",
+		},
+		"subnet CIDR block outside of the VPC": {
+			inEnvName:            "test-pdx",
+			inProjectName:        "phonetool",
+			inPrivateSubnetCIDRs: []string{"10.0.2.0/24", "10.1.3.0/24"},
+
+			wantedErr: "private subnet CIDR block 10.1.3.0/24 is not in VPC CIDR block 10.0.0.0/16",
+		},
+		"private environment with NAT gateways": {
+			inEnvName:        "test-pdx",
+			inProjectName:    "phonetool",
+			inPrivate:        true,
+			inNATGatewayMode: deploy.NATGatewaySingle,
+
+			wantedErr: "--nat-gateways single can't be used with --private: private environments don't have NAT gateways",
+		},
+		"private environment in an imported VPC": {
+			inEnvName:          "test-pdx",
+			inProjectName:      "phonetool",
+			inVPCID:            "vpc-1",
+			inPublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+			inPrivateSubnetIDs: []string{"subnet-3"},
+			inPrivate:          true,
+
+			wantedErr: "--import-vpc-id can't be used with the flags configuring a new VPC",
+		},
+		"overlapping subnet CIDR blocks": {
+			inEnvName:            "test-pdx",
+			inProjectName:        "phonetool",
+			inPrivateSubnetCIDRs: []string{"10.0.1.128/25", "10.0.3.0/24"},
+
+			wantedErr: "subnet CIDR blocks 10.0.1.0/24 and 10.0.1.128/25 overlap",
+		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			// GIVEN
 			opts := &InitEnvOpts{
-				EnvName:    tc.inEnvName,
-				GlobalOpts: &GlobalOpts{projectName: tc.inProjectName},
+				EnvName:                tc.inEnvName,
+				ImportVPCID:            tc.inVPCID,
+				ImportPublicSubnetIDs:  tc.inPublicSubnetIDs,
+				ImportPrivateSubnetIDs: tc.inPrivateSubnetIDs,
+				VPCCIDR:                tc.inVPCCIDR,
+				AZCount:                tc.inAZCount,
+				PublicSubnetCIDRs:      tc.inPublicSubnetCIDRs,
+				PrivateSubnetCIDRs:     tc.inPrivateSubnetCIDRs,
+				NATGatewayMode:         tc.inNATGatewayMode,
+				Private:                tc.inPrivate,
+				GlobalOpts:             &GlobalOpts{projectName: tc.inProjectName},
 			}
 
 			// WHEN
@@ -117,16 +372,99 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 	}
 }
 
+func TestInitEnvOpts_newVPCConfig(t *testing.T) {
+	testCases := map[string]struct {
+		inVPCCIDR            string
+		inAZCount            int
+		inPrivateSubnetCIDRs []string
+		inNATGatewayMode     string
+		inPrivate            bool
+
+		wanted *deploy.NewVPCConfig
+	}{
+		"uses the default layout if nothing is configured": {
+			wanted: nil,
+		},
+		"fills in the defaults": {
+			inNATGatewayMode: deploy.NATGatewaySingle,
+			wanted: &deploy.NewVPCConfig{
+				CIDR:               "10.0.0.0/16",
+				AZCount:            2,
+				PublicSubnetCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24"},
+				PrivateSubnetCIDRs: []string{"10.0.2.0/24", "10.0.3.0/24"},
+				NATGatewayMode:     deploy.NATGatewaySingle,
+			},
+		},
+		"uses VPC endpoints in private environments": {
+			inPrivate: true,
+			wanted: &deploy.NewVPCConfig{
+				CIDR:               "10.0.0.0/16",
+				AZCount:            2,
+				PublicSubnetCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24"},
+				PrivateSubnetCIDRs: []string{"10.0.2.0/24", "10.0.3.0/24"},
+				NATGatewayMode:     deploy.NATGatewayNone,
+			},
+		},
+		"carves subnets smaller than /24 out of a small VPC": {
+			inVPCCIDR: "172.16.0.0/23",
+			inAZCount: 4,
+			wanted: &deploy.NewVPCConfig{
+				CIDR:               "172.16.0.0/23",
+				AZCount:            4,
+				PublicSubnetCIDRs:  []string{"172.16.0.0/26", "172.16.0.64/26", "172.16.0.128/26", "172.16.0.192/26"},
+				PrivateSubnetCIDRs: []string{"172.16.1.0/26", "172.16.1.64/26", "172.16.1.128/26", "172.16.1.192/26"},
+				NATGatewayMode:     deploy.NATGatewayPerAZ,
+			},
+		},
+		"keeps the subnet CIDR blocks that are set": {
+			inAZCount:            3,
+			inPrivateSubnetCIDRs: []string{"10.0.128.0/20", "10.0.144.0/20", "10.0.160.0/20"},
+			wanted: &deploy.NewVPCConfig{
+				CIDR:               "10.0.0.0/16",
+				AZCount:            3,
+				PublicSubnetCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/24"},
+				PrivateSubnetCIDRs: []string{"10.0.128.0/20", "10.0.144.0/20", "10.0.160.0/20"},
+				NATGatewayMode:     deploy.NATGatewayPerAZ,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &InitEnvOpts{
+				VPCCIDR:            tc.inVPCCIDR,
+				AZCount:            tc.inAZCount,
+				PrivateSubnetCIDRs: tc.inPrivateSubnetCIDRs,
+				NATGatewayMode:     tc.inNATGatewayMode,
+				Private:            tc.inPrivate,
+			}
+
+			// WHEN
+			conf, err := opts.newVPCConfig()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, conf)
+		})
+	}
+}
+
 func TestInitEnvOpts_Execute(t *testing.T) {
 	testCases := map[string]struct {
 		inProjectName string
 		inEnvName     string
+		inVPCID       string
+		inPublicIDs   []string
+		inPrivateIDs  []string
+		inEnvProfile  string
 
 		expectProjectGetter func(m *mocks.MockProjectGetter)
 		expectEnvCreator    func(m *mocks.MockEnvironmentCreator)
 		expectDeployer      func(m *climocks.Mockdeployer)
 		expectIdentity      func(m *climocks.MockidentityService)
 		expectProgress      func(m *climocks.Mockprogress)
+		expectEnvProfiles   func(m *climocks.MockenvProfileSetter)
 
 		wantedErrorS string
 	}{
@@ -194,6 +532,38 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 			},
 			wantedErrorS: "some deploy error",
 		},
+		"deploys the environment in the imported VPC": {
+			inProjectName: "phonetool",
+			inEnvName:     "test",
+			inVPCID:       "vpc-1",
+			inPublicIDs:   []string{"subnet-1", "subnet-2"},
+			inPrivateIDs:  []string{"subnet-3"},
+
+			expectProjectGetter: func(m *mocks.MockProjectGetter) {
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool"}, nil)
+			},
+			expectIdentity: func(m *climocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
+			},
+			expectProgress: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtDeployEnvStart, "test"))
+				m.EXPECT().Stop(log.Serrorf(fmtDeployEnvFailed, "test"))
+			},
+			expectDeployer: func(m *climocks.Mockdeployer) {
+				m.EXPECT().DeployEnvironment(&deploy.CreateEnvironmentInput{
+					Name:                     "test",
+					Project:                  "phonetool",
+					PublicLoadBalancer:       true,
+					ToolsAccountPrincipalARN: "some arn",
+					ImportVPC: &deploy.ImportVPCConfig{
+						ID:               "vpc-1",
+						PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+						PrivateSubnetIDs: []string{"subnet-3"},
+					},
+				}).Return(errors.New("some deploy error"))
+			},
+			wantedErrorS: "some deploy error",
+		},
 		"streams failed events": {
 			inProjectName: "phonetool",
 			inEnvName:     "test",
@@ -373,6 +743,54 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				}).Return(nil)
 			},
 		},
+		"records the profile of the environment": {
+			inProjectName: "phonetool",
+			inEnvName:     "test",
+			inEnvProfile:  "prod-admin",
+
+			expectProjectGetter: func(m *mocks.MockProjectGetter) {
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool"}, nil)
+			},
+			expectIdentity: func(m *climocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
+			},
+			expectProgress: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtDeployEnvStart, "test"))
+				m.EXPECT().Start(fmt.Sprintf(fmtStreamEnvStart, "test"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtStreamEnvComplete, "test"))
+				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToProjectStart, "1234", "mars-1", "phonetool"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtAddEnvToProjectComplete, "1234", "mars-1", "phonetool"))
+			},
+			expectDeployer: func(m *climocks.Mockdeployer) {
+				m.EXPECT().DeployEnvironment(gomock.Any()).Return(nil)
+				events := make(chan []deploy.ResourceEvent, 1)
+				responses := make(chan deploy.CreateEnvironmentResponse, 1)
+				m.EXPECT().StreamEnvironmentCreation(gomock.Any()).Return(events, responses)
+				responses <- deploy.CreateEnvironmentResponse{
+					Env: &archer.Environment{
+						Project:   "phonetool",
+						Name:      "test",
+						AccountID: "1234",
+						Region:    "mars-1",
+					},
+					Err: nil,
+				}
+				close(events)
+				close(responses)
+				m.EXPECT().AddEnvToProject(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectEnvCreator: func(m *mocks.MockEnvironmentCreator) {
+				m.EXPECT().CreateEnvironment(&archer.Environment{
+					Project:   "phonetool",
+					Name:      "test",
+					AccountID: "1234",
+					Region:    "mars-1",
+				}).Return(nil)
+			},
+			expectEnvProfiles: func(m *climocks.MockenvProfileSetter) {
+				m.EXPECT().SetEnvProfile("test", "prod-admin").Return(nil)
+			},
+		},
 		"success with DNS Delegation (project has Domain and env and project are different)": {
 			inProjectName: "phonetool",
 			inEnvName:     "test",
@@ -432,6 +850,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 			mockDeployer := climocks.NewMockdeployer(ctrl)
 			mockIdentity := climocks.NewMockidentityService(ctrl)
 			mockProgress := climocks.NewMockprogress(ctrl)
+			mockEnvProfiles := climocks.NewMockenvProfileSetter(ctrl)
 			if tc.expectProjectGetter != nil {
 				tc.expectProjectGetter(mockProjectGetter)
 			}
@@ -447,17 +866,25 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 			if tc.expectProgress != nil {
 				tc.expectProgress(mockProgress)
 			}
+			if tc.expectEnvProfiles != nil {
+				tc.expectEnvProfiles(mockEnvProfiles)
+			}
 
 			opts := &InitEnvOpts{
-				EnvName:       tc.inEnvName,
-				projectGetter: mockProjectGetter,
-				envCreator:    mockEnvCreator,
-				envDeployer:   mockDeployer,
-				projDeployer:  mockDeployer,
-				identity:      mockIdentity,
-				envIdentity:   mockIdentity,
-				prog:          mockProgress,
-				GlobalOpts:    &GlobalOpts{projectName: tc.inProjectName},
+				EnvName:                tc.inEnvName,
+				EnvProfile:             tc.inEnvProfile,
+				ImportVPCID:            tc.inVPCID,
+				ImportPublicSubnetIDs:  tc.inPublicIDs,
+				ImportPrivateSubnetIDs: tc.inPrivateIDs,
+				projectGetter:          mockProjectGetter,
+				envCreator:             mockEnvCreator,
+				envDeployer:            mockDeployer,
+				projDeployer:           mockDeployer,
+				identity:               mockIdentity,
+				envIdentity:            mockIdentity,
+				envProfiles:            mockEnvProfiles,
+				prog:                   mockProgress,
+				GlobalOpts:             &GlobalOpts{projectName: tc.inProjectName},
 			}
 
 			// WHEN
@@ -4,21 +4,21 @@
 package cli
 
 import (
-	"encoding/json"
 	"fmt"
 	"io"
 	"os"
-	"strings"
+	"strconv"
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
-	"github.com/fatih/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/output"
 	"github.com/spf13/cobra"
 )
 
 // ListEnvOpts contains the fields to collect for listing an environment.
 type ListEnvOpts struct {
 	ShouldOutputJSON bool
+	OutputFormat     string
 
 	manager       archer.EnvironmentLister
 	projectGetter archer.ProjectGetter
@@ -35,7 +35,7 @@ func (opts *ListEnvOpts) Ask() error {
 	}
 
 	// TODO: Make this a SelectOne prompt based on existing projects?
-	projectName, err := opts.prompt.Get(
+	projectName, err := opts.promptFor(projectFlag).Get(
 		"Which project's environments would you like to list?",
 		"A project groups all of your environments together.",
 		validateProjectName)
@@ -49,6 +49,11 @@ func (opts *ListEnvOpts) Ask() error {
 	return nil
 }
 
+// Validate returns an error if the flag values passed by the user are invalid.
+func (opts *ListEnvOpts) Validate() error {
+	return output.Validate(outputFormat(opts.OutputFormat, opts.ShouldOutputJSON))
+}
+
 // Execute lists the environments through the prompt.
 func (opts *ListEnvOpts) Execute() error {
 	// Ensure the project actually exists before we try to list its environments.
@@ -61,43 +66,30 @@ func (opts *ListEnvOpts) Execute() error {
 		return err
 	}
 
-	var out string
-	if opts.ShouldOutputJSON {
-		data, err := opts.jsonOutput(envs)
-		if err != nil {
-			return err
-		}
-		out = data
-	} else {
-		out = opts.humanOutput(envs)
+	if err := output.Write(opts.w, outputFormat(opts.OutputFormat, opts.ShouldOutputJSON), envList{Environments: envs}); err != nil {
+		return fmt.Errorf("write environments of project %s: %w", opts.ProjectName(), err)
 	}
-	fmt.Fprintf(opts.w, out)
-
 	return nil
 }
 
-func (opts *ListEnvOpts) humanOutput(envs []*archer.Environment) string {
-	b := &strings.Builder{}
-	prodColor := color.New(color.FgYellow, color.Bold).SprintFunc()
-	for _, env := range envs {
-		if env.Prod {
-			fmt.Fprintf(b, "%s (prod)\n", prodColor(env.Name))
-		} else {
-			fmt.Fprintln(b, env.Name)
-		}
-	}
-	return b.String()
+// envList is the output of "env ls".
+// Its JSON and YAML schema is {"environments": [...]} with the fields of archer.Environment.
+type envList struct {
+	Environments []*archer.Environment `json:"environments"`
 }
 
-func (opts *ListEnvOpts) jsonOutput(envs []*archer.Environment) (string, error) {
-	type serializedEnvs struct {
-		Environments []*archer.Environment `json:"environments"`
-	}
-	b, err := json.Marshal(serializedEnvs{Environments: envs})
-	if err != nil {
-		return "", fmt.Errorf("marshal environments: %w", err)
+// Header returns the columns of the environments table.
+func (l envList) Header() []string {
+	return []string{"Name", "Production", "Region", "Account ID"}
+}
+
+// Rows returns one row per environment.
+func (l envList) Rows() [][]string {
+	var rows [][]string
+	for _, env := range l.Environments {
+		rows = append(rows, []string{env.Name, strconv.FormatBool(env.Prod), valueOrDash(env.Region), valueOrDash(env.AccountID)})
 	}
-	return fmt.Sprintf("%s\n", b), nil
+	return rows
 }
 
 // BuildEnvListCmd builds the command for listing environments in a project.
@@ -111,9 +103,14 @@ func BuildEnvListCmd() *cobra.Command {
 		Short: "Lists all the environments in a project",
 		Example: `
   Lists all the environments for the test project
-  /code $ archer env ls --project test`,
+  /code $ archer env ls --project test
+  Lists the environments of the test project in YAML
+  /code $ archer env ls --project test --output yaml`,
 		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			return opts.Ask()
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Validate()
 		}),
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			ssmStore, err := store.New()
@@ -125,6 +122,6 @@ func BuildEnvListCmd() *cobra.Command {
 			return opts.Execute()
 		}),
 	}
-	cmd.Flags().BoolVar(&opts.ShouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	addOutputFlags(cmd, &opts.OutputFormat, &opts.ShouldOutputJSON)
 	return cmd
 }
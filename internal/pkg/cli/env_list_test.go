@@ -5,6 +5,7 @@ package cli
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -71,7 +72,9 @@ func TestEnvList_Execute(t *testing.T) {
 						{Name: "test2"},
 					}, nil)
 			},
-			expectedContent: "test\ntest2\n",
+			expectedContent: "Name                Production          Region              Account ID\n" +
+				"test                false               -                   -\n" +
+				"test2               false               -                   -\n",
 		},
 		"with invalid project name": {
 			expectedErr: mockError,
@@ -129,11 +132,44 @@ func TestEnvList_Execute(t *testing.T) {
 					EXPECT().
 					ListEnvironments(gomock.Eq("coolproject")).
 					Return([]*archer.Environment{
-						{Name: "test"},
-						{Name: "test2", Prod: true},
+						{Name: "test", Region: "us-west-2", AccountID: "123456789012"},
+						{Name: "test2", Region: "us-west-2", AccountID: "123456789012", Prod: true},
+					}, nil)
+			},
+			expectedContent: "Name                Production          Region              Account ID\n" +
+				"test                false               us-west-2           123456789012\n" +
+				"test2               true                us-west-2           123456789012\n",
+		},
+		"with yaml envs": {
+			listOpts: ListEnvOpts{
+				OutputFormat:  "yaml",
+				manager:       mockEnvStore,
+				projectGetter: mockProjectStore,
+				GlobalOpts: &GlobalOpts{
+					projectName: "coolproject",
+				},
+			},
+			mocking: func() {
+				mockProjectStore.EXPECT().
+					GetProject(gomock.Eq("coolproject")).
+					Return(&archer.Project{}, nil)
+				mockEnvStore.
+					EXPECT().
+					ListEnvironments(gomock.Eq("coolproject")).
+					Return([]*archer.Environment{
+						{Project: "coolproject", Name: "test", Region: "us-west-2", AccountID: "123456789012"},
 					}, nil)
 			},
-			expectedContent: "test\ntest2 (prod)\n",
+			expectedContent: `environments:
+  - project: coolproject
+    name: test
+    region: us-west-2
+    accountID: "123456789012"
+    prod: false
+    registryURL: ""
+    executionRoleARN: ""
+    managerRoleARN: ""
+`,
 		},
 	}
 
@@ -153,6 +189,43 @@ func TestEnvList_Execute(t *testing.T) {
 	}
 }
 
+func TestEnvList_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inOutputFormat     string
+		inShouldOutputJSON bool
+
+		wantedErr error
+	}{
+		"valid format": {
+			inOutputFormat: "yaml",
+		},
+		"json shorthand": {
+			inShouldOutputJSON: true,
+		},
+		"invalid format": {
+			inOutputFormat: "xml",
+			wantedErr:      errors.New("output format xml is not supported, must be one of table, json, yaml"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &ListEnvOpts{
+				OutputFormat:     tc.inOutputFormat,
+				ShouldOutputJSON: tc.inShouldOutputJSON,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestEnvList_Ask(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,129 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/output"
+	"github.com/spf13/cobra"
+)
+
+type envDescriber interface {
+	Describe(env *archer.Environment, withResources bool) (*describe.Env, error)
+}
+
+// ShowEnvOpts holds the configuration needed to show an environment.
+type ShowEnvOpts struct {
+	// Arguments and flags.
+	EnvName               string
+	ShouldOutputJSON      bool
+	OutputFormat          string
+	ShouldOutputResources bool
+
+	// Interfaces to interact with dependencies.
+	envStore  archer.EnvironmentStore
+	describer envDescriber
+	w         io.Writer
+
+	*GlobalOpts
+}
+
+// Ask prompts for the environment to show if it's not passed in.
+func (opts *ShowEnvOpts) Ask() error {
+	if opts.EnvName != "" {
+		return nil
+	}
+	name, err := selectEnvironment(opts.promptForArg(nameArg), opts.envStore, opts.ProjectName(),
+		"Which environment would you like to show?",
+		"The resources of the environment and the applications deployed to it are shown.")
+	if err != nil {
+		return err
+	}
+	opts.EnvName = name
+	return nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (opts *ShowEnvOpts) Validate() error {
+	if opts.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	if err := output.Validate(outputFormat(opts.OutputFormat, opts.ShouldOutputJSON)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Execute prints the resources of the environment and the applications deployed to it.
+func (opts *ShowEnvOpts) Execute() error {
+	env, err := opts.envStore.GetEnvironment(opts.ProjectName(), opts.EnvName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", opts.EnvName, err)
+	}
+	if opts.describer == nil {
+		// Tests mock the client.
+		sess, err := opts.sessionProvider().FromEnvRole(env.Name, env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return fmt.Errorf("create session from role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		opts.describer = describe.NewEnvDescriber(sess)
+	}
+
+	desc, err := opts.describer.Describe(env, opts.ShouldOutputResources)
+	if err != nil {
+		return fmt.Errorf("describe environment %s: %w", opts.EnvName, err)
+	}
+	if err := output.Write(opts.w, outputFormat(opts.OutputFormat, opts.ShouldOutputJSON), desc); err != nil {
+		return fmt.Errorf("write environment %s: %w", opts.EnvName, err)
+	}
+	return nil
+}
+
+// BuildEnvShowCmd builds the command for showing an environment.
+func BuildEnvShowCmd() *cobra.Command {
+	opts := &ShowEnvOpts{
+		w: os.Stdout,
+	}
+	cmd := &cobra.Command{
+		Use:   "show [name]",
+		Short: "Shows info about an environment.",
+		Long: `Shows the VPC, subnets, cluster, load balancer and domain of an environment,
+and the applications deployed to it.`,
+		Example: `
+  Shows info about the "test" environment.
+  /code $ archer env show test
+
+  Shows every CloudFormation resource of the "test" environment in JSON.
+  /code $ archer env show test --resources --json`,
+		Args: cobra.MaximumNArgs(1),
+		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				opts.EnvName = args[0]
+			}
+			opts.GlobalOpts = NewGlobalOpts()
+			store, err := store.New()
+			if err != nil {
+				return fmt.Errorf("connect to ecs-cli metadata store: %w", err)
+			}
+			opts.envStore = store
+			return opts.Validate()
+		}),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	addOutputFlags(cmd, &opts.OutputFormat, &opts.ShouldOutputJSON)
+	cmd.Flags().BoolVar(&opts.ShouldOutputResources, resourcesFlag, false, resourcesFlagDescription)
+	markArgsCompletion(cmd, completeEnvs)
+	return cmd
+}
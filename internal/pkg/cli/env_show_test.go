@@ -0,0 +1,166 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/aws/amazon-ecs-cli-v2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShowEnvOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inEnvName string
+
+		mockEnvStore func(m *mocks.MockEnvironmentStore)
+		mockPrompt   func(m *climocks.Mockprompter)
+
+		wantedEnvName string
+		wantedErr     error
+	}{
+		"skips prompt if the argument is set": {
+			inEnvName:     "test",
+			mockEnvStore:  func(m *mocks.MockEnvironmentStore) {},
+			mockPrompt:    func(m *climocks.Mockprompter) {},
+			wantedEnvName: "test",
+		},
+		"prompts for the environment": {
+			mockEnvStore: func(m *mocks.MockEnvironmentStore) {
+				m.EXPECT().ListEnvironments("phonetool").Return([]*archer.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+			},
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().SelectOne("Which environment would you like to show?", gomock.Any(), []string{"test", "prod"}).Return("prod", nil)
+			},
+			wantedEnvName: "prod",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockEnvStore := mocks.NewMockEnvironmentStore(ctrl)
+			mockPrompt := climocks.NewMockprompter(ctrl)
+			tc.mockEnvStore(mockEnvStore)
+			tc.mockPrompt(mockPrompt)
+
+			opts := &ShowEnvOpts{
+				EnvName:  tc.inEnvName,
+				envStore: mockEnvStore,
+				GlobalOpts: &GlobalOpts{
+					projectName: "phonetool",
+					prompt:      mockPrompt,
+				},
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEnvName, opts.EnvName)
+		})
+	}
+}
+
+func TestShowEnvOpts_Execute(t *testing.T) {
+	testEnv := &archer.Environment{Project: "phonetool", Name: "test", Region: "us-west-2"}
+	mockErr := errors.New("some error")
+
+	testCases := map[string]struct {
+		inJSON      bool
+		inResources bool
+
+		mockEnvStore  func(m *mocks.MockEnvironmentStore)
+		mockDescriber func(m *climocks.MockenvDescriber)
+
+		wantedOutput string
+		wantedErr    error
+	}{
+		"wraps error if the environment does not exist": {
+			mockEnvStore: func(m *mocks.MockEnvironmentStore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, mockErr)
+			},
+			mockDescriber: func(m *climocks.MockenvDescriber) {},
+			wantedErr:     errors.New("get environment test: some error"),
+		},
+		"wraps error if the environment cannot be described": {
+			mockEnvStore: func(m *mocks.MockEnvironmentStore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+			},
+			mockDescriber: func(m *climocks.MockenvDescriber) {
+				m.EXPECT().Describe(testEnv, false).Return(nil, mockErr)
+			},
+			wantedErr: errors.New("describe environment test: some error"),
+		},
+		"prints the environment and its resources in JSON": {
+			inJSON:      true,
+			inResources: true,
+			mockEnvStore: func(m *mocks.MockEnvironmentStore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+			},
+			mockDescriber: func(m *climocks.MockenvDescriber) {
+				m.EXPECT().Describe(testEnv, true).Return(&describe.Env{
+					Name:        "test",
+					Project:     "phonetool",
+					Region:      "us-west-2",
+					StackStatus: "CREATE_COMPLETE",
+					VPCID:       "vpc-1234",
+					Apps:        []*describe.EnvApp{{Name: "frontend", StackStatus: "UPDATE_COMPLETE"}},
+					Resources:   []*describe.CfnResource{{Type: "AWS::EC2::VPC", LogicalID: "VPC", PhysicalID: "vpc-1234"}},
+				}, nil)
+			},
+			wantedOutput: `{"name":"test","project":"phonetool","region":"us-west-2","accountID":"","prod":false,"stackStatus":"CREATE_COMPLETE",` +
+				`"vpcID":"vpc-1234","publicSubnets":null,"privateSubnets":null,"cluster":"","loadBalancerDNSName":"","httpsListenerARN":"",` +
+				`"hostedZoneID":"","subdomain":"","apps":[{"name":"frontend","stackStatus":"UPDATE_COMPLETE"}],` +
+				`"resources":[{"type":"AWS::EC2::VPC","logicalID":"VPC","physicalID":"vpc-1234"}]}` + "\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockEnvStore := mocks.NewMockEnvironmentStore(ctrl)
+			mockDescriber := climocks.NewMockenvDescriber(ctrl)
+			tc.mockEnvStore(mockEnvStore)
+			tc.mockDescriber(mockDescriber)
+			b := &bytes.Buffer{}
+
+			opts := &ShowEnvOpts{
+				EnvName:               "test",
+				ShouldOutputJSON:      tc.inJSON,
+				ShouldOutputResources: tc.inResources,
+				envStore:              mockEnvStore,
+				describer:             mockDescriber,
+				w:                     b,
+				GlobalOpts:            &GlobalOpts{projectName: "phonetool"},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	termprogress "github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/progress"
+	"github.com/spf13/cobra"
+)
+
+const (
+	fmtUpgradeEnvPrompt = "Upgrade environment %s from template %s to %s?"
+	upgradeEnvHelp      = "The environment stack is updated with the changes above. Existing parameters such as the DNS settings are kept."
+)
+
+const (
+	fmtUpgradeEnvPlanStart    = "Computing the changes to upgrade environment %s."
+	fmtUpgradeEnvPlanFailed   = "Failed to compute the changes to upgrade environment %s."
+	fmtUpgradeEnvPlanComplete = "Computed the changes to upgrade environment %s."
+	fmtUpgradeEnvStart        = "Upgrading environment %s to template %s."
+	fmtUpgradeEnvFailed       = "Failed to upgrade environment %s."
+	fmtUpgradeEnvComplete     = "Upgraded environment %s to template %s."
+)
+
+type envUpgrader interface {
+	CreateEnvironmentUpgrade(env *archer.Environment) (*deploy.EnvironmentUpgrade, error)
+	ExecuteEnvironmentUpgrade(upgrade *deploy.EnvironmentUpgrade) error
+	CancelEnvironmentUpgrade(upgrade *deploy.EnvironmentUpgrade) error
+}
+
+// UpgradeEnvOpts holds the fields needed to upgrade environments to the latest template version.
+type UpgradeEnvOpts struct {
+	// Arguments and flags.
+	EnvName          string
+	All              bool
+	SkipConfirmation bool
+
+	// Interfaces for dependencies.
+	envStore  archer.EnvironmentStore
+	upgraders map[string]envUpgrader // Keyed by environment name.
+	prog      progress
+	w         io.Writer
+
+	*GlobalOpts
+}
+
+// Ask prompts for the environment to upgrade if neither a name nor --all is passed in.
+func (opts *UpgradeEnvOpts) Ask() error {
+	if opts.EnvName != "" || opts.All {
+		return nil
+	}
+	name, err := selectEnvironment(opts.promptForArg(nameArg), opts.envStore, opts.ProjectName(),
+		"Which environment would you like to upgrade?",
+		"The environment stack is updated to the latest template of the CLI.")
+	if err != nil {
+		return err
+	}
+	opts.EnvName = name
+	return nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (opts *UpgradeEnvOpts) Validate() error {
+	if opts.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	if opts.EnvName != "" && opts.All {
+		return errors.New("specify either an environment name or --all, not both")
+	}
+	return nil
+}
+
+// Execute upgrades the stack of each selected environment that doesn't run the latest template version.
+// The changes are shown before they are applied.
+func (opts *UpgradeEnvOpts) Execute() error {
+	envs, err := opts.targetEnvs()
+	if err != nil {
+		return err
+	}
+	for _, env := range envs {
+		if err := opts.upgrade(env); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (opts *UpgradeEnvOpts) RecommendedActions() []string {
+	return []string{
+		fmt.Sprintf("Run %s to verify the resources of the environment.", color.HighlightCode("archer env show")),
+	}
+}
+
+func (opts *UpgradeEnvOpts) targetEnvs() ([]*archer.Environment, error) {
+	if opts.All {
+		envs, err := opts.envStore.ListEnvironments(opts.ProjectName())
+		if err != nil {
+			return nil, fmt.Errorf("list environments in project %s: %w", opts.ProjectName(), err)
+		}
+		return envs, nil
+	}
+	env, err := opts.envStore.GetEnvironment(opts.ProjectName(), opts.EnvName)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opts.EnvName, err)
+	}
+	return []*archer.Environment{env}, nil
+}
+
+func (opts *UpgradeEnvOpts) upgrade(env *archer.Environment) error {
+	upgrader, err := opts.upgrader(env)
+	if err != nil {
+		return err
+	}
+
+	opts.prog.Start(fmt.Sprintf(fmtUpgradeEnvPlanStart, env.Name))
+	upgrade, err := upgrader.CreateEnvironmentUpgrade(env)
+	if err != nil {
+		opts.prog.Stop(log.Serrorf(fmtUpgradeEnvPlanFailed, env.Name))
+		return fmt.Errorf("create upgrade of environment %s: %w", env.Name, err)
+	}
+	opts.prog.Stop(log.Ssuccessf(fmtUpgradeEnvPlanComplete, env.Name))
+	if upgrade == nil {
+		log.Infof("Environment %s is already up to date with template %s.\n", color.HighlightUserInput(env.Name), deploy.LatestEnvTemplateVersion)
+		return opts.saveVersion(env, deploy.LatestEnvTemplateVersion)
+	}
+
+	opts.printChanges(upgrade)
+	shouldUpgrade, err := opts.shouldUpgrade(env, upgrade)
+	if err != nil {
+		return err
+	}
+	if !shouldUpgrade {
+		if err := upgrader.CancelEnvironmentUpgrade(upgrade); err != nil {
+			return fmt.Errorf("cancel upgrade of environment %s: %w", env.Name, err)
+		}
+		return nil
+	}
+
+	opts.prog.Start(fmt.Sprintf(fmtUpgradeEnvStart, env.Name, upgrade.ToVersion))
+	if err := upgrader.ExecuteEnvironmentUpgrade(upgrade); err != nil {
+		opts.prog.Stop(log.Serrorf(fmtUpgradeEnvFailed, env.Name))
+		return fmt.Errorf("upgrade environment %s: %w", env.Name, err)
+	}
+	opts.prog.Stop(log.Ssuccessf(fmtUpgradeEnvComplete, env.Name, upgrade.ToVersion))
+	return opts.saveVersion(env, upgrade.ToVersion)
+}
+
+func (opts *UpgradeEnvOpts) upgrader(env *archer.Environment) (envUpgrader, error) {
+	if opts.upgraders == nil {
+		opts.upgraders = make(map[string]envUpgrader)
+	}
+	if upgrader, ok := opts.upgraders[env.Name]; ok {
+		// Tests mock the client.
+		return upgrader, nil
+	}
+	sess, err := opts.sessionProvider().FromEnvRole(env.Name, env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create session from role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	upgrader := cloudformation.New(sess)
+	opts.upgraders[env.Name] = upgrader
+	return upgrader, nil
+}
+
+func (opts *UpgradeEnvOpts) printChanges(upgrade *deploy.EnvironmentUpgrade) {
+	renderChanges(opts.w, fmt.Sprintf("Changes to %s (%s -> %s)", upgrade.StackName, upgrade.FromVersion, upgrade.ToVersion), upgrade.Changes)
+}
+
+func (opts *UpgradeEnvOpts) shouldUpgrade(env *archer.Environment, upgrade *deploy.EnvironmentUpgrade) (bool, error) {
+	if opts.SkipConfirmation {
+		return true, nil
+	}
+	shouldUpgrade, err := opts.promptFor(yesFlag).Confirm(fmt.Sprintf(fmtUpgradeEnvPrompt, env.Name, upgrade.FromVersion, upgrade.ToVersion), upgradeEnvHelp)
+	if err != nil {
+		return false, fmt.Errorf("prompt for environment upgrade: %w", err)
+	}
+	return shouldUpgrade, nil
+}
+
+func (opts *UpgradeEnvOpts) saveVersion(env *archer.Environment, version string) error {
+	if env.TemplateVersion == version {
+		return nil
+	}
+	env.TemplateVersion = version
+	if err := opts.envStore.UpdateEnvironment(env); err != nil {
+		return fmt.Errorf("save template version of environment %s: %w", env.Name, err)
+	}
+	return nil
+}
+
+// BuildEnvUpgradeCmd builds the command to upgrade environments to the latest template version.
+func BuildEnvUpgradeCmd() *cobra.Command {
+	opts := &UpgradeEnvOpts{
+		w: os.Stderr,
+	}
+	cmd := &cobra.Command{
+		Use:   "upgrade [name]",
+		Short: "Upgrades environments to the latest template version.",
+		Long: `Upgrades the CloudFormation stack of environments to the template shipped with this version of the CLI.
+The changes are shown before they are applied, and the original parameters of the environment are preserved.`,
+		Example: `
+  Upgrade the "test" environment.
+  /code $ archer env upgrade test
+
+  Upgrade every environment of the project without prompting.
+  /code $ archer env upgrade --all --yes`,
+		Args: cobra.MaximumNArgs(1),
+		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				opts.EnvName = args[0]
+			}
+			opts.GlobalOpts = NewGlobalOpts()
+			store, err := store.New()
+			if err != nil {
+				return fmt.Errorf("connect to ecs-cli metadata store: %w", err)
+			}
+			opts.envStore = store
+			opts.prog = termprogress.NewSpinner()
+			return opts.Validate()
+		}),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			log.Infoln("Recommended follow-up actions:")
+			for _, followup := range opts.RecommendedActions() {
+				log.Infof("- %s\n", followup)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().BoolVar(&opts.All, allFlag, false, allFlagDescription)
+	cmd.Flags().BoolVar(&opts.SkipConfirmation, yesFlag, false, yesFlagDescription)
+	markArgsCompletion(cmd, completeEnvs)
+	return cmd
+}
@@ -0,0 +1,189 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
+	"github.com/aws/amazon-ecs-cli-v2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradeEnvOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inProjectName string
+		inEnvName     string
+		inAll         bool
+
+		wantedErr error
+	}{
+		"returns error if there is no project": {
+			inEnvName: "test",
+			wantedErr: errNoProjectInWorkspace,
+		},
+		"returns error if both a name and --all are set": {
+			inProjectName: "phonetool",
+			inEnvName:     "test",
+			inAll:         true,
+			wantedErr:     errors.New("specify either an environment name or --all, not both"),
+		},
+		"valid flags": {
+			inProjectName: "phonetool",
+			inAll:         true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &UpgradeEnvOpts{
+				EnvName:    tc.inEnvName,
+				All:        tc.inAll,
+				GlobalOpts: &GlobalOpts{projectName: tc.inProjectName},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUpgradeEnvOpts_Execute(t *testing.T) {
+	upgrade := &deploy.EnvironmentUpgrade{
+		StackName:   "phonetool-test",
+		ChangeSetID: "changeset",
+		FromVersion: deploy.LegacyEnvTemplateVersion,
+		ToVersion:   deploy.LatestEnvTemplateVersion,
+		Changes: []*deploy.ResourceChange{
+			{
+				Resource: deploy.Resource{
+					LogicalName: "Cluster",
+					Type:        "AWS::ECS::Cluster",
+				},
+				Action:      "Modify",
+				Replacement: "False",
+			},
+		},
+	}
+	mockErr := errors.New("some error")
+
+	testCases := map[string]struct {
+		inEnvName          string
+		inAll              bool
+		inSkipConfirmation bool
+
+		setupMocks func(store *mocks.MockEnvironmentStore, prompt *climocks.Mockprompter, upgrader *climocks.MockenvUpgrader)
+
+		wantedErr     error
+		wantedChanges string
+	}{
+		"records the version of an environment that is already up to date": {
+			inEnvName: "test",
+			setupMocks: func(store *mocks.MockEnvironmentStore, prompt *climocks.Mockprompter, upgrader *climocks.MockenvUpgrader) {
+				env := &archer.Environment{Project: "phonetool", Name: "test"}
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(env, nil)
+				upgrader.EXPECT().CreateEnvironmentUpgrade(env).Return(nil, nil)
+				store.EXPECT().UpdateEnvironment(&archer.Environment{
+					Project:         "phonetool",
+					Name:            "test",
+					TemplateVersion: deploy.LatestEnvTemplateVersion,
+				}).Return(nil)
+			},
+		},
+		"cancels the upgrade if the user does not confirm": {
+			inEnvName: "test",
+			setupMocks: func(store *mocks.MockEnvironmentStore, prompt *climocks.Mockprompter, upgrader *climocks.MockenvUpgrader) {
+				env := &archer.Environment{Project: "phonetool", Name: "test"}
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(env, nil)
+				upgrader.EXPECT().CreateEnvironmentUpgrade(env).Return(upgrade, nil)
+				prompt.EXPECT().Confirm("Upgrade environment test from template v0.0.0 to "+deploy.LatestEnvTemplateVersion+"?", gomock.Any()).Return(false, nil)
+				upgrader.EXPECT().CancelEnvironmentUpgrade(upgrade).Return(nil)
+			},
+			wantedChanges: "Modify",
+		},
+		"returns an error if the upgrade fails": {
+			inEnvName:          "test",
+			inSkipConfirmation: true,
+			setupMocks: func(store *mocks.MockEnvironmentStore, prompt *climocks.Mockprompter, upgrader *climocks.MockenvUpgrader) {
+				env := &archer.Environment{Project: "phonetool", Name: "test"}
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(env, nil)
+				upgrader.EXPECT().CreateEnvironmentUpgrade(env).Return(upgrade, nil)
+				upgrader.EXPECT().ExecuteEnvironmentUpgrade(upgrade).Return(mockErr)
+			},
+			wantedErr: errors.New("upgrade environment test: some error"),
+		},
+		"upgrades every environment with --all": {
+			inAll:              true,
+			inSkipConfirmation: true,
+			setupMocks: func(store *mocks.MockEnvironmentStore, prompt *climocks.Mockprompter, upgrader *climocks.MockenvUpgrader) {
+				env := &archer.Environment{Project: "phonetool", Name: "test"}
+				store.EXPECT().ListEnvironments("phonetool").Return([]*archer.Environment{env}, nil)
+				gomock.InOrder(
+					upgrader.EXPECT().CreateEnvironmentUpgrade(env).Return(upgrade, nil),
+					upgrader.EXPECT().ExecuteEnvironmentUpgrade(upgrade).Return(nil),
+					store.EXPECT().UpdateEnvironment(&archer.Environment{
+						Project:         "phonetool",
+						Name:            "test",
+						TemplateVersion: deploy.LatestEnvTemplateVersion,
+					}).Return(nil),
+				)
+			},
+			wantedChanges: "AWS::ECS::Cluster",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockEnvironmentStore(ctrl)
+			mockPrompt := climocks.NewMockprompter(ctrl)
+			mockUpgrader := climocks.NewMockenvUpgrader(ctrl)
+			mockProg := climocks.NewMockprogress(ctrl)
+			mockProg.EXPECT().Start(gomock.Any()).AnyTimes()
+			mockProg.EXPECT().Stop(gomock.Any()).AnyTimes()
+			tc.setupMocks(mockStore, mockPrompt, mockUpgrader)
+			b := &bytes.Buffer{}
+
+			opts := &UpgradeEnvOpts{
+				EnvName:          tc.inEnvName,
+				All:              tc.inAll,
+				SkipConfirmation: tc.inSkipConfirmation,
+				envStore:         mockStore,
+				upgraders:        map[string]envUpgrader{"test": mockUpgrader},
+				prog:             mockProg,
+				w:                b,
+				GlobalOpts: &GlobalOpts{
+					projectName: "phonetool",
+					prompt:      mockPrompt,
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Contains(t, b.String(), tc.wantedChanges)
+		})
+	}
+}
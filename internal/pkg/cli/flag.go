@@ -6,27 +6,70 @@ package cli
 // Long flag names.
 const (
 	// Common flags.
-	projectFlag = "project"
-	nameFlag    = "name"
-	appFlag     = "app"
-	envFlag     = "env"
-	appTypeFlag = "app-type"
-	profileFlag = "profile"
-	yesFlag     = "yes"
-	jsonFlag    = "json"
+	projectFlag  = "project"
+	nameFlag     = "name"
+	appFlag      = "app"
+	envFlag      = "env"
+	appTypeFlag  = "app-type"
+	profileFlag  = "profile"
+	regionFlag   = "region"
+	yesFlag      = "yes"
+	jsonFlag     = "json"
+	noPromptFlag = "no-prompt"
 
 	// Command specific flags.
-	dockerFileFlag        = "dockerfile"
-	imageTagFlag          = "tag"
-	stackOutputDirFlag    = "output-dir"
-	prodEnvFlag           = "prod"
-	deployFlag            = "deploy"
-	githubRepoFlag        = "github-repo"
-	githubAccessTokenFlag = "github-access-token"
-	enableCDFlag          = "enable-cd"
-	envsFlag              = "environments"
-	domainNameFlag        = "domain"
-	pipelineFileFlag      = "file"
+	dockerFileFlag           = "dockerfile"
+	imageTagFlag             = "tag"
+	stackOutputDirFlag       = "output-dir"
+	prodEnvFlag              = "prod"
+	deployFlag               = "deploy"
+	githubRepoFlag           = "github-repo"
+	githubAccessTokenFlag    = "github-access-token"
+	enableCDFlag             = "enable-cd"
+	envsFlag                 = "environments"
+	domainNameFlag           = "domain"
+	pipelineFileFlag         = "file"
+	storageTypeFlag          = "storage-type"
+	partitionKeyFlag         = "partition-key"
+	sortKeyFlag              = "sort-key"
+	lsiFlag                  = "lsi"
+	versioningFlag           = "versioning"
+	engineFlag               = "engine"
+	initialDBFlag            = "initial-db"
+	followFlag               = "follow"
+	sinceFlag                = "since"
+	filterFlag               = "filter"
+	taskFlag                 = "task"
+	emptyRepoFlag            = "empty-repo"
+	deleteManifestFlag       = "delete-manifest"
+	resourcesFlag            = "resources"
+	allFlag                  = "all"
+	commandFlag              = "command"
+	containerFlag            = "container"
+	envFileFlag              = "env-file"
+	offlineFlag              = "offline"
+	portFlag                 = "port"
+	diffFlag                 = "diff"
+	dryRunFlag               = "dry-run"
+	toFlag                   = "to"
+	deleteSecretFlag         = "delete-secret"
+	valuesFlag               = "values"
+	importVPCIDFlag          = "import-vpc-id"
+	importPublicSubnetsFlag  = "import-public-subnets"
+	importPrivateSubnetsFlag = "import-private-subnets"
+	vpcCIDRFlag              = "vpc-cidr"
+	azCountFlag              = "az-count"
+	publicSubnetCIDRsFlag    = "public-subnet-cidrs"
+	privateSubnetCIDRsFlag   = "private-subnet-cidrs"
+	natGatewaysFlag          = "nat-gateways"
+	privateFlag              = "private"
+	outputFlag               = "output"
+	envProfileFlag           = "env-profile"
+)
+
+// Positional argument names.
+const (
+	nameArg = "name"
 )
 
 // Short flag names.
@@ -43,29 +86,79 @@ const (
 	githubAccessTokenFlagShort = "t"
 	envsFlagShort              = "e"
 	pipelineFileFlagShort      = "f"
+	storageTypeFlagShort       = "t"
 )
 
 // Descriptions for flags.
 const (
-	projectFlagDescription = "Name of the project."
-	appFlagDescription     = "Name of the application."
-	envFlagDescription     = "Name of the environment."
-	appTypeFlagDescription = "Type of application to create."
-	profileFlagDescription = "Name of the profile."
-	yesFlagDescription     = "Skips confirmation prompt."
-	jsonFlagDescription    = "Output in JSON format."
+	projectFlagDescription  = "Name of the project."
+	appFlagDescription      = "Name of the application."
+	envFlagDescription      = "Name of the environment."
+	appTypeFlagDescription  = "Type of application to create."
+	profileFlagDescription  = "Name of the AWS profile used instead of the default credentials."
+	regionFlagDescription   = "AWS region used instead of the region of the profile."
+	yesFlagDescription      = "Skips confirmation prompt."
+	jsonFlagDescription     = "Output in JSON format."
+	noPromptFlagDescription = `Fails instead of prompting for missing flags. Defaults to true if the standard input isn't a terminal or CI is set to "true".`
 
-	dockerFileFlagDescription        = "Path to the Dockerfile."
-	imageTagFlagDescription          = `Optional. The application's image tag.`
-	stackOutputDirFlagDescription    = "Optional. Writes the stack template and template configuration to a directory."
-	prodEnvFlagDescription           = "If the environment contains production services."
-	deployTestFlagDescription        = `Deploy your application to a "test" environment.`
-	githubRepoFlagDescription        = "GitHub repository for your application."
-	githubAccessTokenFlagDescription = "GitHub personal access token for your repository."
-	deployPipelineFlagDescription    = "Deploys the pipeline."
-	enableCDFlagDescription          = "Enables automatic deployment to production environment."
-	pipelineEnvsFlagDescription      = "Environments to add to the pipeline."
-	domainNameFlagDescription        = "Optional. Your existing custom domain name."
-	pipelineFileFlagDescription      = "Name of YAML file used to update the pipeline."
-	deployFlagDescription            = "Trigger a deployment of your application(s) to any new stage in your pipeline."
+	envProfileFlagDescription           = "Name of the AWS profile of the environment's account, recorded in the workspace to manage the environment."
+	dockerFileFlagDescription           = "Path to the Dockerfile."
+	imageTagFlagDescription             = `Optional. The application's image tag.`
+	stackOutputDirFlagDescription       = "Optional. Writes the stack template and template configuration to a directory."
+	prodEnvFlagDescription              = "If the environment contains production services."
+	deployTestFlagDescription           = `Deploy your application to a "test" environment.`
+	githubRepoFlagDescription           = "GitHub repository for your application."
+	githubAccessTokenFlagDescription    = "GitHub personal access token for your repository."
+	deployPipelineFlagDescription       = "Deploys the pipeline."
+	enableCDFlagDescription             = "Enables automatic deployment to production environment."
+	pipelineEnvsFlagDescription         = "Environments to add to the pipeline."
+	domainNameFlagDescription           = "Optional. Your existing custom domain name."
+	pipelineFileFlagDescription         = "Name of YAML file used to update the pipeline."
+	deployFlagDescription               = "Trigger a deployment of your application(s) to any new stage in your pipeline."
+	storageTypeFlagDescription          = "Type of storage to add to the application."
+	storageNameFlagDescription          = "Name of the storage resource."
+	partitionKeyFlagDescription         = `Partition key of the DynamoDB table, e.g. "id:S".`
+	sortKeyFlagDescription              = `Optional. Sort key of the DynamoDB table, e.g. "timestamp:N".`
+	lsiFlagDescription                  = `Optional. Sort keys of the local secondary indexes of the DynamoDB table, e.g. "email:S".`
+	versioningFlagDescription           = "Optional. Enables versioning of the objects in the S3 bucket."
+	engineFlagDescription               = "Database engine of the Aurora Serverless cluster."
+	initialDBFlagDescription            = "Name of the initial database created in the Aurora Serverless cluster."
+	followFlagDescription               = "Optional. Keeps printing new log events as they are written."
+	sinceFlagDescription                = `Optional. Only shows log events newer than a relative duration, e.g. "30m" or "1h".`
+	filterFlagDescription               = "Optional. Only shows log events matching the CloudWatch Logs filter pattern."
+	taskFlagDescription                 = "Optional. Only shows log events of the task with this ID."
+	emptyRepoFlagDescription            = "Optional. Deletes the images in the application's ECR repositories so that they can be deleted."
+	deleteManifestFlagDescription       = "Optional. Deletes the local manifest of the application."
+	resourcesFlagDescription            = "Optional. Lists every CloudFormation resource of the environment with its physical ID."
+	allFlagDescription                  = "Optional. Upgrades every environment of the project."
+	commandFlagDescription              = `Optional. Overrides the command of the application's container, run with "/bin/sh -c".`
+	taskDockerfileFlagDescription       = "Optional. Path to the Dockerfile of an ad-hoc image to run instead of the application's image."
+	taskImageTagFlagDescription         = "Optional. The tag of the ad-hoc image built from the Dockerfile."
+	execTaskFlagDescription             = "Optional. ID of the task to start the session in."
+	containerFlagDescription            = "Optional. Name of the container to start the session in. Defaults to the application's container."
+	execCommandFlagDescription          = "Optional. Command to run in the container."
+	localEnvFlagDescription             = "Optional. Name of the environment whose manifest overrides and secrets are used."
+	envFileFlagDescription              = "Optional. Path to a file of KEY=VALUE lines overriding the variables and secrets of the application."
+	offlineFlagDescription              = "Optional. Runs without calling AWS, taking the values of secrets from the env file."
+	portFlagDescription                 = "Optional. Port of the host mapped to the application's port. Defaults to the application's port."
+	diffFlagDescription                 = "Optional. Shows the changes to the stack and asks for confirmation before applying them."
+	dryRunFlagDescription               = "Optional. Shows the changes to the stack without applying them."
+	applyChangesFlagDescription         = "Optional. Applies the changes shown with --diff without asking for confirmation."
+	toFlagDescription                   = "Optional. Revision to roll back to. Defaults to the revision before the latest one."
+	pipelineFlagDescription             = "Name of the pipeline."
+	deleteSecretFlagDescription         = "Optional. Deletes the secret holding the GitHub access token of the pipeline."
+	secretNameFlagDescription           = "Name of the secret."
+	secretValuesFlagDescription         = `Optional. Values of the secret by environment, e.g. "test=value1,prod=value2". Prompts for each environment if not set.`
+	secretEnvFlagDescription            = "Optional. Name of the environment. Defaults to every environment of the project."
+	importVPCIDFlagDescription          = "Optional. ID of an existing VPC to use instead of creating one."
+	importPublicSubnetsFlagDescription  = "Optional. IDs of the public subnets of the imported VPC, in at least two availability zones."
+	importPrivateSubnetsFlagDescription = "Optional. IDs of the private subnets of the imported VPC."
+	vpcCIDRFlagDescription              = "Optional. CIDR block of the VPC created for the environment. Defaults to 10.0.0.0/16."
+	azCountFlagDescription              = "Optional. Number of availability zones of the VPC, from 2 to 4. Defaults to 2."
+	publicSubnetCIDRsFlagDescription    = "Optional. CIDR blocks of the public subnets, one per availability zone. Defaults to blocks carved out of the VPC CIDR."
+	privateSubnetCIDRsFlagDescription   = "Optional. CIDR blocks of the private subnets, one per availability zone. Defaults to blocks carved out of the VPC CIDR."
+	natGatewaysFlagDescription          = `Optional. NAT gateways of the VPC: "per-az", "single" or "none" to use VPC endpoints instead. Defaults to "per-az".`
+	outputFlagDescription               = `Optional. Output format: "table", "json" or "yaml". The JSON and YAML formats have the same schema.`
+	jsonOutputFlagDescription           = "Output in JSON format. Same as --output json."
+	privateFlagDescription              = "Optional. Creates an environment without access to the internet, with VPC endpoints instead of NAT gateways and an internal load balancer."
 )
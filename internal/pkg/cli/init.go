@@ -5,6 +5,7 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/aws/amazon-ecs-cli-v2/cmd/archer/template"
@@ -47,6 +48,9 @@ type InitOpts struct {
 	dockerfilePath *string
 
 	prompt prompter
+
+	// initClients creates the AWS clients of the sub-commands once the global flags choosing the credentials are parsed.
+	initClients func() error
 }
 
 func NewInitOpts() (*InitOpts, error) {
@@ -54,47 +58,26 @@ func NewInitOpts() (*InitOpts, error) {
 	if err != nil {
 		return nil, err
 	}
-	ssm, err := store.New()
-	if err != nil {
-		return nil, err
-	}
-	sess, err := session.Default()
-	if err != nil {
-		return nil, err
-	}
 	prompt := prompt.New()
 	spin := termprogress.NewSpinner()
-	id := identity.New(sess)
-	deployer := cloudformation.New(sess)
 
 	initProject := &InitProjectOpts{
-		projectStore: ssm,
-		ws:           ws,
-		prompt:       prompt,
-		identity:     id,
-		deployer:     deployer,
-		prog:         spin,
+		ws:     ws,
+		prompt: prompt,
+		prog:   spin,
 	}
 	initApp := &InitAppOpts{
 		fs:             &afero.Afero{Fs: afero.NewOsFs()},
 		manifestWriter: ws,
-		appStore:       ssm,
-		projGetter:     ssm,
-		projDeployer:   deployer,
 		prog:           spin,
 		GlobalOpts:     NewGlobalOpts(),
 	}
 	initEnv := &InitEnvOpts{
-		EnvName:       defaultEnvironmentName,
-		EnvProfile:    "default",
-		IsProduction:  false,
-		envCreator:    ssm,
-		projectGetter: ssm,
-		envDeployer:   deployer,
-		projDeployer:  deployer, // TODO #317
-		prog:          spin,
-		identity:      id,
-		GlobalOpts:    NewGlobalOpts(),
+		EnvName:      defaultEnvironmentName,
+		IsProduction: false,
+		envProfiles:  ws,
+		prog:         spin,
+		GlobalOpts:   NewGlobalOpts(),
 	}
 
 	deployApp := &appDeployOpts{
@@ -118,6 +101,32 @@ func NewInitOpts() (*InitOpts, error) {
 		dockerfilePath: &initApp.DockerfilePath,
 
 		prompt: prompt,
+
+		initClients: func() error {
+			ssm, err := store.New()
+			if err != nil {
+				return err
+			}
+			sess, err := session.Default()
+			if err != nil {
+				return err
+			}
+			id := identity.New(sess)
+			deployer := cloudformation.New(sess)
+
+			initProject.projectStore = ssm
+			initProject.identity = id
+			initProject.deployer = deployer
+			initApp.appStore = ssm
+			initApp.projGetter = ssm
+			initApp.projDeployer = deployer
+			initEnv.envCreator = ssm
+			initEnv.projectGetter = ssm
+			initEnv.envDeployer = deployer
+			initEnv.projDeployer = deployer // TODO #317
+			initEnv.identity = id
+			return nil
+		},
 	}, nil
 }
 
@@ -154,6 +163,11 @@ containerized applications (or micro-services) that operate together.`)
 
 func (opts *InitOpts) loadProject() error {
 	if err := opts.initProject.Ask(); err != nil {
+		// The name of the project is passed with a flag to init, instead of an argument to project init.
+		var errMissingArg *ErrMissingArg
+		if errors.As(err, &errMissingArg) {
+			err = &ErrMissingFlag{Flag: projectFlag}
+		}
 		return fmt.Errorf("prompt for project init: %w", err)
 	}
 	if err := opts.initProject.Validate(); err != nil {
@@ -173,7 +187,8 @@ func (opts *InitOpts) loadApp() error {
 
 // deployEnv prompts the user to deploy a test environment if the project doesn't already have one.
 func (opts *InitOpts) deployEnv() error {
-	if opts.promptForShouldDeploy {
+	// Non-interactive commands don't deploy unless the --deploy flag is set.
+	if opts.promptForShouldDeploy && !promptsDisabled() {
 		log.Infoln("All right, you're all set for local development.")
 		if err := opts.askShouldDeploy(); err != nil {
 			return err
@@ -214,7 +229,10 @@ func BuildInitCmd() *cobra.Command {
 		Use:   "init",
 		Short: "Create a new ECS application.",
 		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			return err
+			if err != nil {
+				return err
+			}
+			return opts.initClients()
 		}),
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts.promptForShouldDeploy = !cmd.Flags().Changed("deploy")
@@ -41,6 +41,13 @@ func TestInitOpts_Run(t *testing.T) {
 			},
 			wantedError: "prompt for project init: my error",
 		},
+		"names the project flag if the project can't be prompted for": {
+			expect: func(opts *InitOpts) {
+				opts.initProject.(*climocks.MockactionCommand).EXPECT().Ask().Return(&ErrMissingArg{Arg: nameArg})
+				opts.initProject.(*climocks.MockactionCommand).EXPECT().Validate().Times(0)
+			},
+			wantedError: "prompt for project init: --project is required in non-interactive mode",
+		},
 		"returns validation error for project": {
 			expect: func(opts *InitOpts) {
 				opts.initProject.(*climocks.MockactionCommand).EXPECT().Ask().Return(nil)
@@ -5,10 +5,94 @@
 package mocks
 
 import (
+	session "github.com/aws/aws-sdk-go/aws/session"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
 )
 
+// MocksessionProvider is a mock of sessionProvider interface
+type MocksessionProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MocksessionProviderMockRecorder
+}
+
+// MocksessionProviderMockRecorder is the mock recorder for MocksessionProvider
+type MocksessionProviderMockRecorder struct {
+	mock *MocksessionProvider
+}
+
+// NewMocksessionProvider creates a new mock instance
+func NewMocksessionProvider(ctrl *gomock.Controller) *MocksessionProvider {
+	mock := &MocksessionProvider{ctrl: ctrl}
+	mock.recorder = &MocksessionProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksessionProvider) EXPECT() *MocksessionProviderMockRecorder {
+	return m.recorder
+}
+
+// Default mocks base method
+func (m *MocksessionProvider) Default() (*session.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Default")
+	ret0, _ := ret[0].(*session.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Default indicates an expected call of Default
+func (mr *MocksessionProviderMockRecorder) Default() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Default", reflect.TypeOf((*MocksessionProvider)(nil).Default))
+}
+
+// DefaultWithRegion mocks base method
+func (m *MocksessionProvider) DefaultWithRegion(region string) (*session.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DefaultWithRegion", region)
+	ret0, _ := ret[0].(*session.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DefaultWithRegion indicates an expected call of DefaultWithRegion
+func (mr *MocksessionProviderMockRecorder) DefaultWithRegion(region interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultWithRegion", reflect.TypeOf((*MocksessionProvider)(nil).DefaultWithRegion), region)
+}
+
+// FromProfile mocks base method
+func (m *MocksessionProvider) FromProfile(name string) (*session.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromProfile", name)
+	ret0, _ := ret[0].(*session.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FromProfile indicates an expected call of FromProfile
+func (mr *MocksessionProviderMockRecorder) FromProfile(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromProfile", reflect.TypeOf((*MocksessionProvider)(nil).FromProfile), name)
+}
+
+// FromEnvRole mocks base method
+func (m *MocksessionProvider) FromEnvRole(envName, roleARN, region string) (*session.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromEnvRole", envName, roleARN, region)
+	ret0, _ := ret[0].(*session.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FromEnvRole indicates an expected call of FromEnvRole
+func (mr *MocksessionProviderMockRecorder) FromEnvRole(envName, roleARN, region interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromEnvRole", reflect.TypeOf((*MocksessionProvider)(nil).FromEnvRole), envName, roleARN, region)
+}
+
 // MockactionCommand is a mock of actionCommand interface
 type MockactionCommand struct {
 	ctrl     *gomock.Controller
@@ -60,3 +60,31 @@ func (mr *MockshellCompleterMockRecorder) GenZshCompletion(w interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenZshCompletion", reflect.TypeOf((*MockshellCompleter)(nil).GenZshCompletion), w)
 }
+
+// GenFishCompletion mocks base method
+func (m *MockshellCompleter) GenFishCompletion(w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenFishCompletion", w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GenFishCompletion indicates an expected call of GenFishCompletion
+func (mr *MockshellCompleterMockRecorder) GenFishCompletion(w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenFishCompletion", reflect.TypeOf((*MockshellCompleter)(nil).GenFishCompletion), w)
+}
+
+// GenPowerShellCompletion mocks base method
+func (m *MockshellCompleter) GenPowerShellCompletion(w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenPowerShellCompletion", w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GenPowerShellCompletion indicates an expected call of GenPowerShellCompletion
+func (mr *MockshellCompleterMockRecorder) GenPowerShellCompletion(w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenPowerShellCompletion", reflect.TypeOf((*MockshellCompleter)(nil).GenPowerShellCompletion), w)
+}
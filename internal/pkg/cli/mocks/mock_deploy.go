@@ -114,6 +114,21 @@ func (mr *MockpipelineDeployerMockRecorder) DeployPipeline(env interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployPipeline", reflect.TypeOf((*MockpipelineDeployer)(nil).DeployPipeline), env)
 }
 
+// StreamPipelineCreation mocks base method
+func (m *MockpipelineDeployer) StreamPipelineCreation(env *deploy.CreatePipelineInput) (<-chan []deploy.ResourceEvent, <-chan deploy.CreatePipelineResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamPipelineCreation", env)
+	ret0, _ := ret[0].(<-chan []deploy.ResourceEvent)
+	ret1, _ := ret[1].(<-chan deploy.CreatePipelineResponse)
+	return ret0, ret1
+}
+
+// StreamPipelineCreation indicates an expected call of StreamPipelineCreation
+func (mr *MockpipelineDeployerMockRecorder) StreamPipelineCreation(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamPipelineCreation", reflect.TypeOf((*MockpipelineDeployer)(nil).StreamPipelineCreation), env)
+}
+
 // AddPipelineResourcesToProject mocks base method
 func (m *MockpipelineDeployer) AddPipelineResourcesToProject(project *archer.Project, region string) error {
 	m.ctrl.T.Helper()
@@ -128,6 +143,49 @@ func (mr *MockpipelineDeployerMockRecorder) AddPipelineResourcesToProject(projec
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPipelineResourcesToProject", reflect.TypeOf((*MockpipelineDeployer)(nil).AddPipelineResourcesToProject), project, region)
 }
 
+// CreatePipelineChangeSet mocks base method
+func (m *MockpipelineDeployer) CreatePipelineChangeSet(in *deploy.CreatePipelineInput) (*deploy.ChangeSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePipelineChangeSet", in)
+	ret0, _ := ret[0].(*deploy.ChangeSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePipelineChangeSet indicates an expected call of CreatePipelineChangeSet
+func (mr *MockpipelineDeployerMockRecorder) CreatePipelineChangeSet(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePipelineChangeSet", reflect.TypeOf((*MockpipelineDeployer)(nil).CreatePipelineChangeSet), in)
+}
+
+// ExecuteChangeSet mocks base method
+func (m *MockpipelineDeployer) ExecuteChangeSet(cs *deploy.ChangeSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteChangeSet", cs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteChangeSet indicates an expected call of ExecuteChangeSet
+func (mr *MockpipelineDeployerMockRecorder) ExecuteChangeSet(cs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteChangeSet", reflect.TypeOf((*MockpipelineDeployer)(nil).ExecuteChangeSet), cs)
+}
+
+// DeleteChangeSet mocks base method
+func (m *MockpipelineDeployer) DeleteChangeSet(cs *deploy.ChangeSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChangeSet", cs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChangeSet indicates an expected call of DeleteChangeSet
+func (mr *MockpipelineDeployerMockRecorder) DeleteChangeSet(cs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChangeSet", reflect.TypeOf((*MockpipelineDeployer)(nil).DeleteChangeSet), cs)
+}
+
 // GetProjectResourcesByRegion mocks base method
 func (m *MockpipelineDeployer) GetProjectResourcesByRegion(project *archer.Project, region string) (*archer.ProjectRegionalResources, error) {
 	m.ctrl.T.Helper()
@@ -237,6 +295,57 @@ func (mr *MockprojectDeployerMockRecorder) DelegateDNSPermissions(project, accou
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateDNSPermissions", reflect.TypeOf((*MockprojectDeployer)(nil).DelegateDNSPermissions), project, accountID)
 }
 
+// MockchangeSetExecutor is a mock of changeSetExecutor interface
+type MockchangeSetExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockchangeSetExecutorMockRecorder
+}
+
+// MockchangeSetExecutorMockRecorder is the mock recorder for MockchangeSetExecutor
+type MockchangeSetExecutorMockRecorder struct {
+	mock *MockchangeSetExecutor
+}
+
+// NewMockchangeSetExecutor creates a new mock instance
+func NewMockchangeSetExecutor(ctrl *gomock.Controller) *MockchangeSetExecutor {
+	mock := &MockchangeSetExecutor{ctrl: ctrl}
+	mock.recorder = &MockchangeSetExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockchangeSetExecutor) EXPECT() *MockchangeSetExecutorMockRecorder {
+	return m.recorder
+}
+
+// ExecuteChangeSet mocks base method
+func (m *MockchangeSetExecutor) ExecuteChangeSet(cs *deploy.ChangeSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteChangeSet", cs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteChangeSet indicates an expected call of ExecuteChangeSet
+func (mr *MockchangeSetExecutorMockRecorder) ExecuteChangeSet(cs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteChangeSet", reflect.TypeOf((*MockchangeSetExecutor)(nil).ExecuteChangeSet), cs)
+}
+
+// DeleteChangeSet mocks base method
+func (m *MockchangeSetExecutor) DeleteChangeSet(cs *deploy.ChangeSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChangeSet", cs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChangeSet indicates an expected call of DeleteChangeSet
+func (mr *MockchangeSetExecutorMockRecorder) DeleteChangeSet(cs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChangeSet", reflect.TypeOf((*MockchangeSetExecutor)(nil).DeleteChangeSet), cs)
+}
+
 // MockprojectResourcesGetter is a mock of projectResourcesGetter interface
 type MockprojectResourcesGetter struct {
 	ctrl     *gomock.Controller
@@ -426,6 +535,21 @@ func (mr *MockdeployerMockRecorder) DeployPipeline(env interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployPipeline", reflect.TypeOf((*Mockdeployer)(nil).DeployPipeline), env)
 }
 
+// StreamPipelineCreation mocks base method
+func (m *Mockdeployer) StreamPipelineCreation(env *deploy.CreatePipelineInput) (<-chan []deploy.ResourceEvent, <-chan deploy.CreatePipelineResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamPipelineCreation", env)
+	ret0, _ := ret[0].(<-chan []deploy.ResourceEvent)
+	ret1, _ := ret[1].(<-chan deploy.CreatePipelineResponse)
+	return ret0, ret1
+}
+
+// StreamPipelineCreation indicates an expected call of StreamPipelineCreation
+func (mr *MockdeployerMockRecorder) StreamPipelineCreation(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamPipelineCreation", reflect.TypeOf((*Mockdeployer)(nil).StreamPipelineCreation), env)
+}
+
 // AddPipelineResourcesToProject mocks base method
 func (m *Mockdeployer) AddPipelineResourcesToProject(project *archer.Project, region string) error {
 	m.ctrl.T.Helper()
@@ -440,6 +564,49 @@ func (mr *MockdeployerMockRecorder) AddPipelineResourcesToProject(project, regio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPipelineResourcesToProject", reflect.TypeOf((*Mockdeployer)(nil).AddPipelineResourcesToProject), project, region)
 }
 
+// CreatePipelineChangeSet mocks base method
+func (m *Mockdeployer) CreatePipelineChangeSet(in *deploy.CreatePipelineInput) (*deploy.ChangeSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePipelineChangeSet", in)
+	ret0, _ := ret[0].(*deploy.ChangeSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePipelineChangeSet indicates an expected call of CreatePipelineChangeSet
+func (mr *MockdeployerMockRecorder) CreatePipelineChangeSet(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePipelineChangeSet", reflect.TypeOf((*Mockdeployer)(nil).CreatePipelineChangeSet), in)
+}
+
+// ExecuteChangeSet mocks base method
+func (m *Mockdeployer) ExecuteChangeSet(cs *deploy.ChangeSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteChangeSet", cs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteChangeSet indicates an expected call of ExecuteChangeSet
+func (mr *MockdeployerMockRecorder) ExecuteChangeSet(cs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteChangeSet", reflect.TypeOf((*Mockdeployer)(nil).ExecuteChangeSet), cs)
+}
+
+// DeleteChangeSet mocks base method
+func (m *Mockdeployer) DeleteChangeSet(cs *deploy.ChangeSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChangeSet", cs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChangeSet indicates an expected call of DeleteChangeSet
+func (mr *MockdeployerMockRecorder) DeleteChangeSet(cs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChangeSet", reflect.TypeOf((*Mockdeployer)(nil).DeleteChangeSet), cs)
+}
+
 // GetProjectResourcesByRegion mocks base method
 func (m *Mockdeployer) GetProjectResourcesByRegion(project *archer.Project, region string) (*archer.ProjectRegionalResources, error) {
 	m.ctrl.T.Helper()
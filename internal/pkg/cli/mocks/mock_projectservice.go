@@ -7,7 +7,9 @@ package mocks
 import (
 	archer "github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
 	ecr "github.com/aws/amazon-ecs-cli-v2/internal/pkg/build/ecr"
+	deploy "github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
 	gomock "github.com/golang/mock/gomock"
+	io "io"
 	reflect "reflect"
 )
 
@@ -78,6 +80,20 @@ func (mr *MockprojectServiceMockRecorder) CreateProject(project interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockprojectService)(nil).CreateProject), project)
 }
 
+// DeleteProject mocks base method
+func (m *MockprojectService) DeleteProject(projectName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", projectName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProject indicates an expected call of DeleteProject
+func (mr *MockprojectServiceMockRecorder) DeleteProject(projectName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockprojectService)(nil).DeleteProject), projectName)
+}
+
 // ListEnvironments mocks base method
 func (m *MockprojectService) ListEnvironments(projectName string) ([]*archer.Environment, error) {
 	m.ctrl.T.Helper()
@@ -122,6 +138,20 @@ func (mr *MockprojectServiceMockRecorder) CreateEnvironment(env interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEnvironment", reflect.TypeOf((*MockprojectService)(nil).CreateEnvironment), env)
 }
 
+// UpdateEnvironment mocks base method
+func (m *MockprojectService) UpdateEnvironment(env *archer.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment
+func (mr *MockprojectServiceMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockprojectService)(nil).UpdateEnvironment), env)
+}
+
 // DeleteEnvironment mocks base method
 func (m *MockprojectService) DeleteEnvironment(projectName, environmentName string) error {
 	m.ctrl.T.Helper()
@@ -180,6 +210,20 @@ func (mr *MockprojectServiceMockRecorder) CreateApplication(app interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApplication", reflect.TypeOf((*MockprojectService)(nil).CreateApplication), app)
 }
 
+// DeleteApplication mocks base method
+func (m *MockprojectService) DeleteApplication(projectName, appName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteApplication", projectName, appName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteApplication indicates an expected call of DeleteApplication
+func (mr *MockprojectServiceMockRecorder) DeleteApplication(projectName, appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApplication", reflect.TypeOf((*MockprojectService)(nil).DeleteApplication), projectName, appName)
+}
+
 // MockecrService is a mock of ecrService interface
 type MockecrService struct {
 	ctrl     *gomock.Controller
@@ -233,6 +277,124 @@ func (mr *MockecrServiceMockRecorder) GetECRAuth() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetECRAuth", reflect.TypeOf((*MockecrService)(nil).GetECRAuth))
 }
 
+// MockartifactUploader is a mock of artifactUploader interface
+type MockartifactUploader struct {
+	ctrl     *gomock.Controller
+	recorder *MockartifactUploaderMockRecorder
+}
+
+// MockartifactUploaderMockRecorder is the mock recorder for MockartifactUploader
+type MockartifactUploaderMockRecorder struct {
+	mock *MockartifactUploader
+}
+
+// NewMockartifactUploader creates a new mock instance
+func NewMockartifactUploader(ctrl *gomock.Controller) *MockartifactUploader {
+	mock := &MockartifactUploader{ctrl: ctrl}
+	mock.recorder = &MockartifactUploaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockartifactUploader) EXPECT() *MockartifactUploaderMockRecorder {
+	return m.recorder
+}
+
+// PutArtifact mocks base method
+func (m *MockartifactUploader) PutArtifact(bucket, key string, data io.Reader) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutArtifact", bucket, key, data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutArtifact indicates an expected call of PutArtifact
+func (mr *MockartifactUploaderMockRecorder) PutArtifact(bucket, key, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutArtifact", reflect.TypeOf((*MockartifactUploader)(nil).PutArtifact), bucket, key, data)
+}
+
+// MockappStackDeployer is a mock of appStackDeployer interface
+type MockappStackDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockappStackDeployerMockRecorder
+}
+
+// MockappStackDeployerMockRecorder is the mock recorder for MockappStackDeployer
+type MockappStackDeployerMockRecorder struct {
+	mock *MockappStackDeployer
+}
+
+// NewMockappStackDeployer creates a new mock instance
+func NewMockappStackDeployer(ctrl *gomock.Controller) *MockappStackDeployer {
+	mock := &MockappStackDeployer{ctrl: ctrl}
+	mock.recorder = &MockappStackDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockappStackDeployer) EXPECT() *MockappStackDeployerMockRecorder {
+	return m.recorder
+}
+
+// DeployApp mocks base method
+func (m *MockappStackDeployer) DeployApp(template, stackName, changeSetName, cfExecutionRole string, tags map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployApp", template, stackName, changeSetName, cfExecutionRole, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployApp indicates an expected call of DeployApp
+func (mr *MockappStackDeployerMockRecorder) DeployApp(template, stackName, changeSetName, cfExecutionRole, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployApp", reflect.TypeOf((*MockappStackDeployer)(nil).DeployApp), template, stackName, changeSetName, cfExecutionRole, tags)
+}
+
+// CreateAppChangeSet mocks base method
+func (m *MockappStackDeployer) CreateAppChangeSet(template, stackName, cfExecutionRole string, tags map[string]string) (*deploy.ChangeSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAppChangeSet", template, stackName, cfExecutionRole, tags)
+	ret0, _ := ret[0].(*deploy.ChangeSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAppChangeSet indicates an expected call of CreateAppChangeSet
+func (mr *MockappStackDeployerMockRecorder) CreateAppChangeSet(template, stackName, cfExecutionRole, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAppChangeSet", reflect.TypeOf((*MockappStackDeployer)(nil).CreateAppChangeSet), template, stackName, cfExecutionRole, tags)
+}
+
+// ExecuteChangeSet mocks base method
+func (m *MockappStackDeployer) ExecuteChangeSet(cs *deploy.ChangeSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteChangeSet", cs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteChangeSet indicates an expected call of ExecuteChangeSet
+func (mr *MockappStackDeployerMockRecorder) ExecuteChangeSet(cs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteChangeSet", reflect.TypeOf((*MockappStackDeployer)(nil).ExecuteChangeSet), cs)
+}
+
+// DeleteChangeSet mocks base method
+func (m *MockappStackDeployer) DeleteChangeSet(cs *deploy.ChangeSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChangeSet", cs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChangeSet indicates an expected call of DeleteChangeSet
+func (mr *MockappStackDeployerMockRecorder) DeleteChangeSet(cs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChangeSet", reflect.TypeOf((*MockappStackDeployer)(nil).DeleteChangeSet), cs)
+}
+
 // MockdockerService is a mock of dockerService interface
 type MockdockerService struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,34 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/output"
+	"github.com/spf13/cobra"
+)
+
+// addOutputFlags adds the --output flag of read commands, and its --json shorthand.
+func addOutputFlags(cmd *cobra.Command, format *string, shouldOutputJSON *bool) {
+	cmd.Flags().StringVar(format, outputFlag, output.TableFormat, outputFlagDescription)
+	cmd.Flags().BoolVar(shouldOutputJSON, jsonFlag, false, jsonOutputFlagDescription)
+}
+
+// outputFormat returns the format selected with the --output flag, or JSON if --json is set.
+func outputFormat(format string, shouldOutputJSON bool) string {
+	if shouldOutputJSON {
+		return output.JSONFormat
+	}
+	if format == "" {
+		return output.TableFormat
+	}
+	return format
+}
+
+// valueOrDash returns the value, or "-" if it's empty, so that the cells of a table are never blank.
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -22,6 +22,10 @@ func BuildPipelineCmd() *cobra.Command {
 
 	cmd.AddCommand(BuildPipelineInitCmd())
 	cmd.AddCommand(BuildPipelineUpdateCmd())
+	cmd.AddCommand(BuildPipelineListCmd())
+	cmd.AddCommand(BuildPipelineShowCmd())
+	cmd.AddCommand(BuildPipelineStatusCmd())
+	cmd.AddCommand(BuildPipelineDeleteCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
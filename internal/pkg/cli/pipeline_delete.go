@@ -0,0 +1,176 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store/secretsmanager"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	termprogress "github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/progress"
+	"github.com/spf13/cobra"
+)
+
+const (
+	fmtDeletePipelinePrompt = "Are you sure you want to delete pipeline %s from project %s?"
+	deletePipelineHelp      = "The pipeline's stack is deleted. The applications it deployed keep running."
+)
+
+type pipelineStackDeleter interface {
+	DeletePipeline(stackName string) error
+}
+
+// DeletePipelineOpts holds the configuration needed to delete a pipeline.
+type DeletePipelineOpts struct {
+	// Fields with matching flags.
+	PipelineName     string
+	SkipConfirmation bool
+	DeleteSecret     bool
+	ShouldOutputJSON bool
+
+	// Interfaces to interact with dependencies.
+	pipelineLister pipelineLister
+	describer      pipelineDescriber
+	deleter        pipelineStackDeleter
+	secretDeleter  archer.SecretDeleter
+	prog           progress
+	w              io.Writer
+
+	*GlobalOpts
+}
+
+// Ask prompts for the pipeline if it's not passed in.
+func (opts *DeletePipelineOpts) Ask() error {
+	if opts.PipelineName != "" {
+		return nil
+	}
+	name, err := selectPipeline(opts.promptFor(nameFlag), opts.pipelineLister, opts.ProjectName(),
+		"Which pipeline would you like to delete?",
+		deletePipelineHelp)
+	if err != nil {
+		return err
+	}
+	opts.PipelineName = name
+	return nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (opts *DeletePipelineOpts) Validate() error {
+	if opts.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	return nil
+}
+
+// Execute deletes the pipeline's stack, and then the secret holding its GitHub access token if requested.
+func (opts *DeletePipelineOpts) Execute() error {
+	shouldDelete, err := opts.shouldDelete()
+	if err != nil {
+		return err
+	}
+	if !shouldDelete {
+		return nil
+	}
+
+	// The name of the secret is a parameter of the stack, so it's retrieved before the stack is deleted.
+	var secretName string
+	if opts.DeleteSecret {
+		pipeline, err := opts.describer.Describe(opts.PipelineName)
+		if err != nil {
+			return fmt.Errorf("describe pipeline %s: %w", opts.PipelineName, err)
+		}
+		secretName = pipeline.Secret
+	}
+
+	opts.prog.Start(fmt.Sprintf(fmtDeletePipelineStart, opts.PipelineName))
+	if err := opts.deleter.DeletePipeline(opts.PipelineName); err != nil {
+		opts.prog.Stop(log.Serrorf(fmtDeletePipelineFailed, opts.PipelineName, err))
+		return fmt.Errorf("delete pipeline %s: %w", opts.PipelineName, err)
+	}
+	opts.prog.Stop(log.Ssuccessf(fmtDeletePipelineComplete, opts.PipelineName))
+
+	if secretName != "" {
+		opts.prog.Start(fmt.Sprintf(fmtDeleteSecretStart, secretName))
+		if err := opts.secretDeleter.DeleteSecret(secretName); err != nil {
+			opts.prog.Stop(log.Serrorf(fmtDeleteSecretFailed, secretName, err))
+			return fmt.Errorf("delete secret %s of pipeline %s: %w", secretName, opts.PipelineName, err)
+		}
+		opts.prog.Stop(log.Ssuccessf(fmtDeleteSecretComplete, secretName))
+	}
+
+	if opts.ShouldOutputJSON {
+		data, err := json.Marshal(struct {
+			Pipeline string `json:"pipeline"`
+			Secret   string `json:"deletedSecret,omitempty"`
+		}{Pipeline: opts.PipelineName, Secret: secretName})
+		if err != nil {
+			return fmt.Errorf("marshal deleted pipeline %s: %w", opts.PipelineName, err)
+		}
+		fmt.Fprintf(opts.w, "%s\n", data)
+	}
+	return nil
+}
+
+func (opts *DeletePipelineOpts) shouldDelete() (bool, error) {
+	if opts.SkipConfirmation {
+		return true, nil
+	}
+	shouldDelete, err := opts.promptFor(yesFlag).Confirm(fmt.Sprintf(fmtDeletePipelinePrompt, opts.PipelineName, opts.ProjectName()), deletePipelineHelp)
+	if err != nil {
+		return false, fmt.Errorf("prompt for pipeline deletion: %w", err)
+	}
+	return shouldDelete, nil
+}
+
+// BuildPipelineDeleteCmd builds the command for deleting a pipeline.
+func BuildPipelineDeleteCmd() *cobra.Command {
+	opts := &DeletePipelineOpts{
+		w:          os.Stdout,
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Deletes a pipeline from your project.",
+		Long: `Deletes the CloudFormation stack of a pipeline.
+The secret holding the GitHub access token created by "pipeline init" is kept unless --delete-secret is passed,
+as other pipelines of the same repository may use it.`,
+		Example: `
+  Delete the "phonetool-pipeline-phonetool" pipeline and its GitHub access token secret without prompting.
+  /code $ archer pipeline delete --name phonetool-pipeline-phonetool --delete-secret --yes`,
+		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			sess, err := opts.sessionProvider().Default()
+			if err != nil {
+				return fmt.Errorf("error retrieving default session: %w", err)
+			}
+			cf := cloudformation.New(sess)
+			opts.pipelineLister = cf
+			opts.deleter = cf
+			opts.describer = describe.NewPipelineDescriber(sess)
+			secretsManager, err := secretsmanager.NewStore()
+			if err != nil {
+				return fmt.Errorf("couldn't create secrets manager: %w", err)
+			}
+			opts.secretDeleter = secretsManager
+			opts.prog = termprogress.NewSpinner()
+			return opts.Validate()
+		}),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&opts.PipelineName, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().BoolVar(&opts.SkipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&opts.DeleteSecret, deleteSecretFlag, false, deleteSecretFlagDescription)
+	cmd.Flags().BoolVar(&opts.ShouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,126 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/aws/amazon-ecs-cli-v2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type deletePipelineMocks struct {
+	prompt        *climocks.Mockprompter
+	describer     *climocks.MockpipelineDescriber
+	deleter       *climocks.MockpipelineStackDeleter
+	secretDeleter *mocks.MockSecretDeleter
+	prog          *climocks.Mockprogress
+}
+
+func TestDeletePipelineOpts_Execute(t *testing.T) {
+	const pipelineName = "phonetool-pipeline-frontend"
+	mockErr := errors.New("some error")
+
+	testCases := map[string]struct {
+		inSkipConfirmation bool
+		inDeleteSecret     bool
+		inShouldOutputJSON bool
+
+		setupMocks func(m deletePipelineMocks)
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"does nothing if the deletion is not confirmed": {
+			setupMocks: func(m deletePipelineMocks) {
+				m.prompt.EXPECT().Confirm("Are you sure you want to delete pipeline phonetool-pipeline-frontend from project phonetool?", deletePipelineHelp).Return(false, nil)
+				m.deleter.EXPECT().DeletePipeline(gomock.Any()).Times(0)
+			},
+		},
+		"deletes the stack and keeps the secret by default": {
+			setupMocks: func(m deletePipelineMocks) {
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil)
+				m.describer.EXPECT().Describe(gomock.Any()).Times(0)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.deleter.EXPECT().DeletePipeline(pipelineName).Return(nil)
+				m.prog.EXPECT().Stop(gomock.Any())
+				m.secretDeleter.EXPECT().DeleteSecret(gomock.Any()).Times(0)
+			},
+		},
+		"deletes the secret of the stack after the stack": {
+			inSkipConfirmation: true,
+			inDeleteSecret:     true,
+			inShouldOutputJSON: true,
+			setupMocks: func(m deletePipelineMocks) {
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+				m.prog.EXPECT().Start(gomock.Any()).Times(2)
+				m.prog.EXPECT().Stop(gomock.Any()).Times(2)
+				gomock.InOrder(
+					m.describer.EXPECT().Describe(pipelineName).Return(&describe.Pipeline{Secret: "github-token-phonetool-frontend"}, nil),
+					m.deleter.EXPECT().DeletePipeline(pipelineName).Return(nil),
+					m.secretDeleter.EXPECT().DeleteSecret("github-token-phonetool-frontend").Return(nil),
+				)
+			},
+			wantedContent: `{"pipeline":"phonetool-pipeline-frontend","deletedSecret":"github-token-phonetool-frontend"}` + "\n",
+		},
+		"keeps the secret if the stack can't be deleted": {
+			inSkipConfirmation: true,
+			inDeleteSecret:     true,
+			setupMocks: func(m deletePipelineMocks) {
+				m.describer.EXPECT().Describe(pipelineName).Return(&describe.Pipeline{Secret: "github-token-phonetool-frontend"}, nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.deleter.EXPECT().DeletePipeline(pipelineName).Return(mockErr)
+				m.prog.EXPECT().Stop(gomock.Any())
+				m.secretDeleter.EXPECT().DeleteSecret(gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("delete pipeline phonetool-pipeline-frontend: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := deletePipelineMocks{
+				prompt:        climocks.NewMockprompter(ctrl),
+				describer:     climocks.NewMockpipelineDescriber(ctrl),
+				deleter:       climocks.NewMockpipelineStackDeleter(ctrl),
+				secretDeleter: mocks.NewMockSecretDeleter(ctrl),
+				prog:          climocks.NewMockprogress(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+
+			opts := &DeletePipelineOpts{
+				PipelineName:     pipelineName,
+				SkipConfirmation: tc.inSkipConfirmation,
+				DeleteSecret:     tc.inDeleteSecret,
+				ShouldOutputJSON: tc.inShouldOutputJSON,
+				describer:        m.describer,
+				deleter:          m.deleter,
+				secretDeleter:    m.secretDeleter,
+				prog:             m.prog,
+				w:                b,
+				GlobalOpts:       &GlobalOpts{projectName: "phonetool", prompt: m.prompt},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store/secretsmanager"
@@ -30,6 +31,8 @@ const (
 
 const (
 	buildspecTemplatePath = "cicd/buildspec.yml"
+
+	fmtPipelineSecretName = "github-token-%s-%s" // Name of the secret holding the GitHub access token of a project's repository.
 )
 
 var errNoEnvsInProject = errors.New("There were no more environments found that can be added to your pipeline. Please run `archer env init` to create a new environment.")
@@ -117,7 +120,10 @@ func (opts *InitPipelineOpts) Validate() error {
 // Execute writes the pipeline manifest file.
 func (opts *InitPipelineOpts) Execute() error {
 	secretName := opts.createSecretName()
-	_, err := opts.secretsmanager.CreateSecret(secretName, opts.GitHubAccessToken)
+	// The secret is tagged with the project so that "project delete" can find it.
+	_, err := opts.secretsmanager.CreateSecret(secretName, opts.GitHubAccessToken, map[string]string{
+		stack.ProjectTagKey: opts.ProjectName(),
+	})
 
 	if err != nil {
 		var existsErr *secretsmanager.ErrSecretAlreadyExists
@@ -166,7 +172,7 @@ func (opts *InitPipelineOpts) RecommendedActions() []string {
 
 func (opts *InitPipelineOpts) createSecretName() string {
 	repoName := opts.getRepoName()
-	return fmt.Sprintf("github-token-%s-%s", opts.projectName, repoName)
+	return fmt.Sprintf(fmtPipelineSecretName, opts.projectName, repoName)
 }
 
 func (opts *InitPipelineOpts) createPipelineName() string {
@@ -242,7 +248,7 @@ func (opts *InitPipelineOpts) selectEnvironments(addMore bool) error {
 		return nil
 	}
 
-	addEnv, err := opts.prompt.Confirm(
+	addEnv, err := opts.promptFor(envsFlag).Confirm(
 		pipelineAddEnvPrompt,
 		"Adds an environment that corresponds to a deployment stage in your pipeline. Environments are added sequentially.",
 	)
@@ -295,7 +301,7 @@ func (opts *InitPipelineOpts) selectEnvironment() (bool, error) {
 		return selectMoreEnvs, nil
 	}
 
-	env, err := opts.prompt.SelectOne(
+	env, err := opts.promptFor(envsFlag).SelectOne(
 		pipelineSelectEnvPrompt,
 		"Environment to be added as the next stage in your pipeline.",
 		envs,
@@ -327,7 +333,7 @@ func relPath(fullPath string) string {
 
 // TODO: Nice-to-have: have an opts.listRemoteRepos() method that execs out to `git remote -v` and parse repo name to offer select menu
 func (opts *InitPipelineOpts) selectGitHubRepo() error {
-	repo, err := opts.prompt.Get(
+	repo, err := opts.promptFor(githubRepoFlag).Get(
 		pipelineEnterGitHubRepoPrompt,
 		fmt.Sprintf(`The GitHub repository linked to your workspace. Pushing to this repository will trigger your pipeline build stage. Please enter full repository URL, e.g. "https://github.com/myCompany/myRepo", or the owner/rep, e.g. "myCompany/myRepo"`),
 		validateGitHubRepo,
@@ -343,7 +349,7 @@ func (opts *InitPipelineOpts) selectGitHubRepo() error {
 }
 
 func (opts *InitPipelineOpts) getGitHubAccessToken() error {
-	token, err := opts.prompt.GetSecret(
+	token, err := opts.promptFor(githubAccessTokenFlag).GetSecret(
 		fmt.Sprintf("Please enter your GitHub Personal Access Token for your repository: %s", opts.GitHubRepo),
 		fmt.Sprintf(`The personal access token for the GitHub repository linked to your workspace. For more information on how to create a personal access token, please refer to: https://help.github.com/en/enterprise/2.17/user/authenticating-to-github/creating-a-personal-access-token-for-the-command-line.`),
 	)
@@ -358,7 +364,7 @@ func (opts *InitPipelineOpts) getGitHubAccessToken() error {
 }
 
 func (opts *InitPipelineOpts) askEnableCD() error {
-	enable, err := opts.prompt.Confirm(
+	enable, err := opts.promptFor(enableCDFlag).Confirm(
 		"Would you like to automatically enable deploying to production?",
 		"Enables the transition to your production environment automatically through your pipeline.",
 	)
@@ -373,7 +379,7 @@ func (opts *InitPipelineOpts) askEnableCD() error {
 }
 
 func (opts *InitPipelineOpts) askDeploy() error {
-	deploy, err := opts.prompt.Confirm(
+	deploy, err := opts.promptFor(deployFlag).Confirm(
 		"Would you like to deploy your pipeline?",
 		"Deploys your pipeline through CloudFormation.",
 	)
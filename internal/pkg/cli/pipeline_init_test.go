@@ -165,7 +165,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			inProjectName:  "badgoose",
 
 			mockSecretsManager: func(m *archermocks.MockSecretsManager) {
-				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
+				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2", map[string]string{"ecs-project": "badgoose"}).Return("some-arn", nil)
 			},
 			mockManifestWriter: func(m *archermocks.MockManifestIO) {
 				m.EXPECT().WriteFile(gomock.Any(), workspace.PipelineFileName).Return(workspace.PipelineFileName, nil)
@@ -187,7 +187,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 
 			mockSecretsManager: func(m *archermocks.MockSecretsManager) {
 				existsErr := &secretsmanager.ErrSecretAlreadyExists{}
-				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("", existsErr)
+				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2", map[string]string{"ecs-project": "badgoose"}).Return("", existsErr)
 			},
 			mockManifestWriter: func(m *archermocks.MockManifestIO) {
 				m.EXPECT().WriteFile(gomock.Any(), workspace.PipelineFileName).Return(workspace.PipelineFileName, nil)
@@ -209,7 +209,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			inProjectName:  "badgoose",
 
 			mockSecretsManager: func(m *archermocks.MockSecretsManager) {
-				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
+				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2", map[string]string{"ecs-project": "badgoose"}).Return("some-arn", nil)
 			},
 			mockManifestWriter: func(m *archermocks.MockManifestIO) {
 				m.EXPECT().WriteFile(gomock.Any(), workspace.PipelineFileName).Return(workspace.PipelineFileName, nil)
@@ -226,7 +226,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			inProjectName:  "badgoose",
 
 			mockSecretsManager: func(m *archermocks.MockSecretsManager) {
-				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
+				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2", map[string]string{"ecs-project": "badgoose"}).Return("some-arn", nil)
 			},
 			mockManifestWriter: func(m *archermocks.MockManifestIO) {
 				m.EXPECT().WriteFile(gomock.Any(), workspace.PipelineFileName).Return(workspace.PipelineFileName, nil)
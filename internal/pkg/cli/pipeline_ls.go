@@ -0,0 +1,103 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/output"
+	"github.com/spf13/cobra"
+)
+
+type pipelineLister interface {
+	ListPipelineStacks(projectName string) ([]string, error)
+}
+
+// ListPipelinesOpts holds the configuration needed to list the pipelines of a project.
+type ListPipelinesOpts struct {
+	// Fields with matching flags.
+	ShouldOutputJSON bool
+	OutputFormat     string
+
+	// Interfaces to interact with dependencies.
+	pipelineLister pipelineLister
+	w              io.Writer
+
+	*GlobalOpts
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (opts *ListPipelinesOpts) Validate() error {
+	if opts.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	if err := output.Validate(outputFormat(opts.OutputFormat, opts.ShouldOutputJSON)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Execute writes the names of the pipelines deployed for the project.
+func (opts *ListPipelinesOpts) Execute() error {
+	names, err := opts.pipelineLister.ListPipelineStacks(opts.ProjectName())
+	if err != nil {
+		return fmt.Errorf("list pipelines in project %s: %w", opts.ProjectName(), err)
+	}
+	if err := output.Write(opts.w, outputFormat(opts.OutputFormat, opts.ShouldOutputJSON), pipelineList{Pipelines: names}); err != nil {
+		return fmt.Errorf("write pipelines of project %s: %w", opts.ProjectName(), err)
+	}
+	return nil
+}
+
+// pipelineList is the output of "pipeline ls".
+// Its JSON and YAML schema is {"pipelines": [...]} with the names of the pipelines.
+type pipelineList struct {
+	Pipelines []string `json:"pipelines"`
+}
+
+// Header returns the columns of the pipelines table.
+func (l pipelineList) Header() []string {
+	return []string{"Name"}
+}
+
+// Rows returns one row per pipeline.
+func (l pipelineList) Rows() [][]string {
+	var rows [][]string
+	for _, name := range l.Pipelines {
+		rows = append(rows, []string{name})
+	}
+	return rows
+}
+
+// BuildPipelineListCmd builds the command for listing the pipelines of a project.
+func BuildPipelineListCmd() *cobra.Command {
+	opts := &ListPipelinesOpts{
+		w:          os.Stdout,
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists the pipelines of your project.",
+		Long:  `Lists the names of the pipelines deployed for your project.`,
+		Example: `
+  Lists the pipelines of the "phonetool" project.
+  /code $ archer pipeline ls --project phonetool`,
+		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			sess, err := opts.sessionProvider().Default()
+			if err != nil {
+				return fmt.Errorf("error retrieving default session: %w", err)
+			}
+			opts.pipelineLister = cloudformation.New(sess)
+			return opts.Validate()
+		}),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			return opts.Execute()
+		}),
+	}
+	addOutputFlags(cmd, &opts.OutputFormat, &opts.ShouldOutputJSON)
+	return cmd
+}
@@ -0,0 +1,76 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListPipelinesOpts_Execute(t *testing.T) {
+	mockErr := errors.New("some error")
+
+	testCases := map[string]struct {
+		inShouldOutputJSON bool
+
+		setupMocks func(lister *climocks.MockpipelineLister)
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"wraps error from listing the pipelines": {
+			setupMocks: func(lister *climocks.MockpipelineLister) {
+				lister.EXPECT().ListPipelineStacks("phonetool").Return(nil, mockErr)
+			},
+			wantedErr: errors.New("list pipelines in project phonetool: some error"),
+		},
+		"writes one pipeline per row": {
+			setupMocks: func(lister *climocks.MockpipelineLister) {
+				lister.EXPECT().ListPipelineStacks("phonetool").Return([]string{"phonetool-pipeline-frontend", "phonetool-pipeline-backend"}, nil)
+			},
+			wantedContent: "Name\nphonetool-pipeline-frontend\nphonetool-pipeline-backend\n",
+		},
+		"writes the pipelines in JSON": {
+			inShouldOutputJSON: true,
+			setupMocks: func(lister *climocks.MockpipelineLister) {
+				lister.EXPECT().ListPipelineStacks("phonetool").Return([]string{"phonetool-pipeline-frontend"}, nil)
+			},
+			wantedContent: "{\"pipelines\":[\"phonetool-pipeline-frontend\"]}\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockLister := climocks.NewMockpipelineLister(ctrl)
+			tc.setupMocks(mockLister)
+			b := &bytes.Buffer{}
+
+			opts := &ListPipelinesOpts{
+				ShouldOutputJSON: tc.inShouldOutputJSON,
+				pipelineLister:   mockLister,
+				w:                b,
+				GlobalOpts:       &GlobalOpts{projectName: "phonetool"},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
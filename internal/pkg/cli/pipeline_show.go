@@ -0,0 +1,107 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/output"
+	"github.com/spf13/cobra"
+)
+
+type pipelineDescriber interface {
+	Describe(name string) (*describe.Pipeline, error)
+}
+
+// ShowPipelineOpts holds the configuration needed to show the configuration of a pipeline.
+type ShowPipelineOpts struct {
+	// Fields with matching flags.
+	PipelineName     string
+	ShouldOutputJSON bool
+	OutputFormat     string
+
+	// Interfaces to interact with dependencies.
+	pipelineLister pipelineLister
+	describer      pipelineDescriber
+	w              io.Writer
+
+	*GlobalOpts
+}
+
+// Ask prompts for the pipeline if it's not passed in.
+func (opts *ShowPipelineOpts) Ask() error {
+	if opts.PipelineName != "" {
+		return nil
+	}
+	name, err := selectPipeline(opts.promptFor(nameFlag), opts.pipelineLister, opts.ProjectName(),
+		"Which pipeline would you like to show?",
+		"The source repository and the stages of the pipeline are shown.")
+	if err != nil {
+		return err
+	}
+	opts.PipelineName = name
+	return nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (opts *ShowPipelineOpts) Validate() error {
+	if opts.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	if err := output.Validate(outputFormat(opts.OutputFormat, opts.ShouldOutputJSON)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Execute writes the source repository and the stages of the pipeline.
+func (opts *ShowPipelineOpts) Execute() error {
+	pipeline, err := opts.describer.Describe(opts.PipelineName)
+	if err != nil {
+		return fmt.Errorf("describe pipeline %s: %w", opts.PipelineName, err)
+	}
+	if err := output.Write(opts.w, outputFormat(opts.OutputFormat, opts.ShouldOutputJSON), pipeline); err != nil {
+		return fmt.Errorf("write pipeline %s: %w", opts.PipelineName, err)
+	}
+	return nil
+}
+
+// BuildPipelineShowCmd builds the command for showing the configuration of a pipeline.
+func BuildPipelineShowCmd() *cobra.Command {
+	opts := &ShowPipelineOpts{
+		w:          os.Stdout,
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Shows the configuration of a pipeline.",
+		Long: `Shows the source repository and branch of a pipeline, and its stages with the environment,
+account and region they deploy to.`,
+		Example: `
+  Shows the "phonetool-pipeline-phonetool" pipeline.
+  /code $ archer pipeline show --name phonetool-pipeline-phonetool`,
+		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			sess, err := opts.sessionProvider().Default()
+			if err != nil {
+				return fmt.Errorf("error retrieving default session: %w", err)
+			}
+			opts.pipelineLister = cloudformation.New(sess)
+			opts.describer = describe.NewPipelineDescriber(sess)
+			return opts.Validate()
+		}),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&opts.PipelineName, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	addOutputFlags(cmd, &opts.OutputFormat, &opts.ShouldOutputJSON)
+	return cmd
+}
@@ -0,0 +1,150 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShowPipelineOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inPipelineName string
+
+		setupMocks func(lister *climocks.MockpipelineLister, prompt *climocks.Mockprompter)
+
+		wantedPipeline string
+		wantedErr      error
+	}{
+		"returns error if there are no pipelines": {
+			setupMocks: func(lister *climocks.MockpipelineLister, prompt *climocks.Mockprompter) {
+				lister.EXPECT().ListPipelineStacks("phonetool").Return(nil, nil)
+			},
+			wantedErr: errNoPipelinesInProject,
+		},
+		"selects the only pipeline without prompting": {
+			setupMocks: func(lister *climocks.MockpipelineLister, prompt *climocks.Mockprompter) {
+				lister.EXPECT().ListPipelineStacks("phonetool").Return([]string{"phonetool-pipeline-frontend"}, nil)
+				prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedPipeline: "phonetool-pipeline-frontend",
+		},
+		"prompts for the pipeline if there are several": {
+			setupMocks: func(lister *climocks.MockpipelineLister, prompt *climocks.Mockprompter) {
+				lister.EXPECT().ListPipelineStacks("phonetool").Return([]string{"phonetool-pipeline-frontend", "phonetool-pipeline-backend"}, nil)
+				prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), []string{"phonetool-pipeline-frontend", "phonetool-pipeline-backend"}).Return("phonetool-pipeline-backend", nil)
+			},
+			wantedPipeline: "phonetool-pipeline-backend",
+		},
+		"skips the prompt if the pipeline is passed in": {
+			inPipelineName: "phonetool-pipeline-frontend",
+			setupMocks: func(lister *climocks.MockpipelineLister, prompt *climocks.Mockprompter) {
+				lister.EXPECT().ListPipelineStacks(gomock.Any()).Times(0)
+			},
+			wantedPipeline: "phonetool-pipeline-frontend",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockLister := climocks.NewMockpipelineLister(ctrl)
+			mockPrompt := climocks.NewMockprompter(ctrl)
+			tc.setupMocks(mockLister, mockPrompt)
+
+			opts := &ShowPipelineOpts{
+				PipelineName:   tc.inPipelineName,
+				pipelineLister: mockLister,
+				GlobalOpts:     &GlobalOpts{projectName: "phonetool", prompt: mockPrompt},
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPipeline, opts.PipelineName)
+		})
+	}
+}
+
+func TestShowPipelineOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(describer *climocks.MockpipelineDescriber)
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"wraps error from describing the pipeline": {
+			setupMocks: func(describer *climocks.MockpipelineDescriber) {
+				describer.EXPECT().Describe("phonetool-pipeline-frontend").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe pipeline phonetool-pipeline-frontend: some error"),
+		},
+		"writes the pipeline in JSON": {
+			setupMocks: func(describer *climocks.MockpipelineDescriber) {
+				describer.EXPECT().Describe("phonetool-pipeline-frontend").Return(&describe.Pipeline{
+					Name: "phonetool-pipeline-frontend",
+					Source: describe.PipelineSource{
+						Provider:   "GitHub",
+						Repository: "badgoose/frontend",
+						Branch:     "master",
+					},
+					Stages: []describe.PipelineStage{
+						{
+							Name:         "DeployTo-test",
+							Environment:  "test",
+							AccountID:    "123456789012",
+							Region:       "us-west-2",
+							Applications: []string{"frontend"},
+						},
+					},
+				}, nil)
+			},
+			wantedContent: `{"name":"phonetool-pipeline-frontend","source":{"provider":"GitHub","repository":"badgoose/frontend","branch":"master"},"stages":[{"name":"DeployTo-test","environment":"test","accountID":"123456789012","region":"us-west-2","applications":["frontend"],"requiresApproval":false}]}` + "\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDescriber := climocks.NewMockpipelineDescriber(ctrl)
+			tc.setupMocks(mockDescriber)
+			b := &bytes.Buffer{}
+
+			opts := &ShowPipelineOpts{
+				PipelineName:     "phonetool-pipeline-frontend",
+				ShouldOutputJSON: true,
+				describer:        mockDescriber,
+				w:                b,
+				GlobalOpts:       &GlobalOpts{projectName: "phonetool"},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/output"
+	"github.com/spf13/cobra"
+)
+
+type pipelineStatusDescriber interface {
+	Status(name string) (*describe.PipelineStatus, error)
+}
+
+// PipelineStatusOpts holds the configuration needed to show the status of a pipeline.
+type PipelineStatusOpts struct {
+	// Fields with matching flags.
+	PipelineName     string
+	ShouldOutputJSON bool
+	OutputFormat     string
+
+	// Interfaces to interact with dependencies.
+	pipelineLister pipelineLister
+	describer      pipelineStatusDescriber
+	w              io.Writer
+
+	*GlobalOpts
+}
+
+// Ask prompts for the pipeline if it's not passed in.
+func (opts *PipelineStatusOpts) Ask() error {
+	if opts.PipelineName != "" {
+		return nil
+	}
+	name, err := selectPipeline(opts.promptFor(nameFlag), opts.pipelineLister, opts.ProjectName(),
+		"Which pipeline's status would you like to show?",
+		"The state of the latest execution of each stage of the pipeline is shown.")
+	if err != nil {
+		return err
+	}
+	opts.PipelineName = name
+	return nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (opts *PipelineStatusOpts) Validate() error {
+	if opts.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	if err := output.Validate(outputFormat(opts.OutputFormat, opts.ShouldOutputJSON)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Execute writes the state of the stages and actions of the pipeline.
+func (opts *PipelineStatusOpts) Execute() error {
+	status, err := opts.describer.Status(opts.PipelineName)
+	if err != nil {
+		return fmt.Errorf("describe status of pipeline %s: %w", opts.PipelineName, err)
+	}
+	if err := output.Write(opts.w, outputFormat(opts.OutputFormat, opts.ShouldOutputJSON), status); err != nil {
+		return fmt.Errorf("write status of pipeline %s: %w", opts.PipelineName, err)
+	}
+	return nil
+}
+
+// BuildPipelineStatusCmd builds the command for showing the status of a pipeline.
+func BuildPipelineStatusCmd() *cobra.Command {
+	opts := &PipelineStatusOpts{
+		w:          os.Stdout,
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Shows the status of a pipeline.",
+		Long: `Shows the state of the latest execution of each stage and action of a pipeline,
+the latest revision of the source repository and the manual approvals waiting for a decision.`,
+		Example: `
+  Shows the status of the "phonetool-pipeline-phonetool" pipeline.
+  /code $ archer pipeline status --name phonetool-pipeline-phonetool`,
+		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			sess, err := opts.sessionProvider().Default()
+			if err != nil {
+				return fmt.Errorf("error retrieving default session: %w", err)
+			}
+			opts.pipelineLister = cloudformation.New(sess)
+			opts.describer = describe.NewPipelineDescriber(sess)
+			return opts.Validate()
+		}),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&opts.PipelineName, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	addOutputFlags(cmd, &opts.OutputFormat, &opts.ShouldOutputJSON)
+	return cmd
+}
@@ -0,0 +1,82 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineStatusOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inShouldOutputJSON bool
+
+		setupMocks func(describer *climocks.MockpipelineStatusDescriber)
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"wraps error from describing the status": {
+			setupMocks: func(describer *climocks.MockpipelineStatusDescriber) {
+				describer.EXPECT().Status("phonetool-pipeline-frontend").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe status of pipeline phonetool-pipeline-frontend: some error"),
+		},
+		"writes the status in JSON": {
+			inShouldOutputJSON: true,
+			setupMocks: func(describer *climocks.MockpipelineStatusDescriber) {
+				describer.EXPECT().Status("phonetool-pipeline-frontend").Return(&describe.PipelineStatus{
+					Name:     "phonetool-pipeline-frontend",
+					Revision: "f2b9c1d",
+					Stages: []describe.StageStatus{
+						{
+							Name:   "DeployTo-prod",
+							Status: "InProgress",
+							Actions: []describe.ActionStatus{
+								{Name: "ApprovePromotionTo-prod", Status: "InProgress", WaitingForApproval: true},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedContent: `{"name":"phonetool-pipeline-frontend","revision":"f2b9c1d","stages":[{"name":"DeployTo-prod","status":"InProgress","actions":[{"name":"ApprovePromotionTo-prod","status":"InProgress","updatedAt":"0001-01-01T00:00:00Z","waitingForApproval":true}]}]}` + "\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDescriber := climocks.NewMockpipelineStatusDescriber(ctrl)
+			tc.setupMocks(mockDescriber)
+			b := &bytes.Buffer{}
+
+			opts := &PipelineStatusOpts{
+				PipelineName:     "phonetool-pipeline-frontend",
+				ShouldOutputJSON: tc.inShouldOutputJSON,
+				describer:        mockDescriber,
+				w:                b,
+				GlobalOpts:       &GlobalOpts{projectName: "phonetool"},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
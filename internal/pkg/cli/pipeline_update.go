@@ -6,9 +6,11 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"strings"
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
-	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/session"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
@@ -25,18 +27,29 @@ import (
 
 const (
 	fmtAddPipelineResourcesStart    = "Adding pipeline resources to your project: %s"
+	fmtAddPipelineResourcesFailed   = "Failed to add pipeline resources to your project: %s"
 	fmtAddPipelineResourcesComplete = "Successfully added pipeline resources to your project: %s"
 
 	fmtUpdatePipelineFailed   = "Failed to accept changes for pipeline: %s."
 	fmtUpdatePipelineStart    = "Proposing infrastructure changes for the pipeline: %s"
 	fmtUpdatePipelineComplete = "Successfully updated pipeline: %s"
+
+	fmtStreamPipelineStart  = "Deploying the infrastructure for the pipeline: %s"
+	fmtStreamPipelineFailed = "Failed to deploy the infrastructure for the pipeline: %s."
+
+	fmtPipelineChangeSetStart    = "Computing the changes to pipeline: %s"
+	fmtPipelineChangeSetFailed   = "Failed to compute the changes to pipeline: %s."
+	fmtPipelineChangeSetComplete = "Computed the changes to pipeline: %s"
 )
 
 var errNoPipelineFile = errors.New("There was no pipeline manifest found in your workspace. Please run `archer pipeline init` to create an pipeline.")
 
 // UpdatePipelineOpts holds the configuration needed to create or update a pipeline
 type UpdatePipelineOpts struct {
-	PipelineFile string
+	PipelineFile     string
+	Diff             bool
+	DryRun           bool
+	SkipConfirmation bool
 	// Deploy bool
 
 	pipelineDeployer pipelineDeployer
@@ -45,6 +58,7 @@ type UpdatePipelineOpts struct {
 	region           string
 	envStore         archer.EnvironmentStore
 	ws               archer.Workspace
+	w                io.Writer
 
 	*GlobalOpts
 }
@@ -53,6 +67,7 @@ func NewUpdatePipelineOpts() *UpdatePipelineOpts {
 	return &UpdatePipelineOpts{
 		GlobalOpts: NewGlobalOpts(),
 		prog:       termprogress.NewSpinner(),
+		w:          os.Stderr,
 	}
 }
 
@@ -111,22 +126,26 @@ func (opts *UpdatePipelineOpts) getArtifactBuckets() ([]deploy.ArtifactBucket, e
 	return buckets, nil
 }
 
+// Execute deploys the pipeline of the manifest in the workspace and streams the events of its stack
+// until the deployment completes.
 func (opts *UpdatePipelineOpts) Execute() error {
 	// bootstrap pipeline resources
 	opts.prog.Start(fmt.Sprintf(fmtAddPipelineResourcesStart, color.HighlightUserInput(opts.ProjectName())))
-	err := opts.pipelineDeployer.AddPipelineResourcesToProject(opts.project, opts.region)
-	if err != nil {
-		return nil
+	if err := opts.pipelineDeployer.AddPipelineResourcesToProject(opts.project, opts.region); err != nil {
+		opts.prog.Stop(log.Serrorf(fmtAddPipelineResourcesFailed, color.HighlightUserInput(opts.ProjectName())))
+		return fmt.Errorf("add pipeline resources to project %s in region %s: %w", opts.ProjectName(), opts.region, err)
 	}
 	opts.prog.Stop(log.Ssuccessf(fmtAddPipelineResourcesComplete, color.HighlightUserInput(opts.ProjectName())))
 
 	// read pipeline manifest
 	data, err := opts.ws.ReadFile(workspace.PipelineFileName)
 	if err != nil {
-		return nil
+		return fmt.Errorf("read pipeline manifest: %w", err)
 	}
 	pipeline, err := manifest.UnmarshalPipeline(data)
-	// TODO nil check source?
+	if err != nil {
+		return fmt.Errorf("unmarshal pipeline manifest: %w", err)
+	}
 	source := &deploy.Source{
 		ProviderName: pipeline.Source.ProviderName,
 		Properties:   pipeline.Source.Properties,
@@ -135,13 +154,13 @@ func (opts *UpdatePipelineOpts) Execute() error {
 	// convert environments to deployment stages
 	stages, err := opts.convertStages(pipeline.Stages)
 	if err != nil {
-		return nil
+		return fmt.Errorf("convert environments to deployment stages: %w", err)
 	}
 
 	// get cross-regional resources
 	artifactBuckets, err := opts.getArtifactBuckets()
 	if err != nil {
-		return nil
+		return fmt.Errorf("get cross-regional resources: %w", err)
 	}
 
 	deployPipelineInput := &deploy.CreatePipelineInput{
@@ -152,18 +171,90 @@ func (opts *UpdatePipelineOpts) Execute() error {
 		ArtifactBuckets: artifactBuckets,
 	}
 
-	// deploy pipeline
-	opts.prog.Start(fmt.Sprintf(fmtUpdatePipelineStart, color.HighlightUserInput(opts.PipelineFile)))
+	if opts.Diff || opts.DryRun {
+		return opts.deployPipelineWithReview(deployPipelineInput)
+	}
+	return opts.deployPipeline(deployPipelineInput)
+}
 
-	if err := opts.pipelineDeployer.DeployPipeline(deployPipelineInput); err != nil {
-		// TODO if pipeline already exists, still update?
-		opts.prog.Stop(log.Serrorf(fmtUpdatePipelineFailed, color.HighlightUserInput(opts.PipelineFile)))
+// deployPipeline deploys the pipeline's stack and displays the progress of its resources until the deployment halts.
+func (opts *UpdatePipelineOpts) deployPipeline(in *deploy.CreatePipelineInput) error {
+	opts.prog.Start(fmt.Sprintf(fmtUpdatePipelineStart, color.HighlightUserInput(in.Name)))
+	if err := opts.pipelineDeployer.DeployPipeline(in); err != nil {
+		opts.prog.Stop(log.Serrorf(fmtUpdatePipelineFailed, color.HighlightUserInput(in.Name)))
 		return fmt.Errorf("deploy pipeline: %w", err)
 	}
-	// TODO stream events
 
-	opts.prog.Stop(log.Ssuccessf(fmtUpdatePipelineComplete, color.HighlightUserInput(opts.PipelineFile))) // change to pipeline name?
+	opts.prog.Start(fmt.Sprintf(fmtStreamPipelineStart, color.HighlightUserInput(in.Name)))
+	stackEvents, responses := opts.pipelineDeployer.StreamPipelineCreation(in)
+	for stackEvent := range stackEvents {
+		opts.prog.Events(opts.humanizePipelineEvents(stackEvent, len(in.Stages)))
+	}
+	resp := <-responses
+	if resp.Err != nil {
+		opts.prog.Stop(log.Serrorf(fmtStreamPipelineFailed, color.HighlightUserInput(in.Name)))
+		return fmt.Errorf("deploy pipeline %s: %w", in.Name, resp.Err)
+	}
+	opts.prog.Stop(log.Ssuccessf(fmtUpdatePipelineComplete, color.HighlightUserInput(in.Name)))
+	return nil
+}
 
+func (opts *UpdatePipelineOpts) humanizePipelineEvents(resourceEvents []deploy.ResourceEvent, numStages int) []termprogress.TabRow {
+	matcher := map[termprogress.Text]termprogress.ResourceMatcher{
+		textPipelineRoles: func(event deploy.Resource) bool {
+			return event.Type == "AWS::IAM::Role" || event.Type == "AWS::IAM::Policy"
+		},
+		textBuildProject: func(event deploy.Resource) bool {
+			return event.LogicalName == "BuildProject"
+		},
+		textTestProjects: func(event deploy.Resource) bool {
+			return strings.HasPrefix(event.LogicalName, "IntegTestProject")
+		},
+		textPipeline: func(event deploy.Resource) bool {
+			return event.Type == "AWS::CodePipeline::Pipeline"
+		},
+	}
+	resourceCounts := map[termprogress.Text]int{
+		textPipelineRoles: 6,
+		textBuildProject:  1,
+		textTestProjects:  numStages,
+		textPipeline:      1,
+	}
+	return termprogress.HumanizeResourceEvents(pipelineProgressOrder, resourceEvents, matcher, resourceCounts)
+}
+
+// deployPipelineWithReview creates a change set of the pipeline's stack, renders its changes and applies them
+// only once the user confirms.
+func (opts *UpdatePipelineOpts) deployPipelineWithReview(in *deploy.CreatePipelineInput) error {
+	opts.prog.Start(fmt.Sprintf(fmtPipelineChangeSetStart, color.HighlightUserInput(in.Name)))
+	cs, err := opts.pipelineDeployer.CreatePipelineChangeSet(in)
+	if err != nil {
+		opts.prog.Stop(log.Serrorf(fmtPipelineChangeSetFailed, color.HighlightUserInput(in.Name)))
+		return fmt.Errorf("create change set for pipeline %s: %w", in.Name, err)
+	}
+	opts.prog.Stop(log.Ssuccessf(fmtPipelineChangeSetComplete, color.HighlightUserInput(in.Name)))
+	if cs == nil {
+		log.Infof("No changes to deploy for pipeline %s.\n", color.HighlightUserInput(in.Name))
+		return nil
+	}
+
+	confirmed, err := reviewChangeSet(opts.w, opts.promptFor(yesFlag), opts.pipelineDeployer, cs,
+		fmt.Sprintf("Changes to %s", cs.StackName),
+		fmt.Sprintf("Deploy these changes to pipeline %s?", color.HighlightUserInput(in.Name)),
+		opts.DryRun, opts.SkipConfirmation)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return nil
+	}
+
+	opts.prog.Start(fmt.Sprintf(fmtUpdatePipelineStart, color.HighlightUserInput(in.Name)))
+	if err := opts.pipelineDeployer.ExecuteChangeSet(cs); err != nil {
+		opts.prog.Stop(log.Serrorf(fmtUpdatePipelineFailed, color.HighlightUserInput(in.Name)))
+		return fmt.Errorf("deploy pipeline: %w", err)
+	}
+	opts.prog.Stop(log.Ssuccessf(fmtUpdatePipelineComplete, color.HighlightUserInput(in.Name)))
 	return nil
 }
 
@@ -176,7 +267,9 @@ func BuildPipelineUpdateCmd() *cobra.Command {
 		Long:  `Deploys a pipeline for the applications in your workspace, using the environments associated with the applications.`,
 		Example: `
   Deploy an updated pipeline for the applications in your workspace:
-  /code $ archer pipeline update`,
+  /code $ archer pipeline update
+  Review the changes to the pipeline without applying them:
+  /code $ archer pipeline update --dry-run`,
 
 		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			store, err := store.New()
@@ -191,7 +284,7 @@ func BuildPipelineUpdateCmd() *cobra.Command {
 			}
 			opts.project = project
 
-			defaultSession, err := session.Default()
+			defaultSession, err := opts.sessionProvider().Default()
 			if err != nil {
 				return err
 			}
@@ -213,6 +306,9 @@ func BuildPipelineUpdateCmd() *cobra.Command {
 		}),
 	}
 	cmd.Flags().StringVarP(&opts.PipelineFile, pipelineFileFlag, pipelineFileFlagShort, workspace.PipelineFileName, pipelineFileFlagDescription)
+	cmd.Flags().BoolVar(&opts.Diff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().BoolVar(&opts.DryRun, dryRunFlag, false, dryRunFlagDescription)
+	cmd.Flags().BoolVar(&opts.SkipConfirmation, yesFlag, false, applyChangesFlagDescription)
 	// cmd.Flags().BoolVar(&opts.Deploy, deployFlag, false, deployFlagDescription)
 
 	return cmd
@@ -4,6 +4,8 @@
 package cli
 
 import (
+	"bytes"
+	"errors"
 	"testing"
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
@@ -149,3 +151,163 @@ func TestInitPipelineOpts_getArtifactBuckets(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdatePipelineOpts_deployPipelineWithReview(t *testing.T) {
+	in := &deploy.CreatePipelineInput{ProjectName: "badgoose", Name: "pipepiper"}
+	mockChangeSet := &deploy.ChangeSet{
+		StackName: "pipeline-badgoose-pipepiper",
+		ID:        "changeset",
+		Changes: []*deploy.ResourceChange{
+			{
+				Resource: deploy.Resource{
+					LogicalName: "Pipeline",
+					Type:        "AWS::CodePipeline::Pipeline",
+				},
+				Action:      "Modify",
+				Replacement: "False",
+				Properties:  []string{"Properties.Stages"},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		inDryRun           bool
+		inSkipConfirmation bool
+
+		mockDeployer func(m *climocks.MockpipelineDeployer, p *climocks.Mockprompter)
+	}{
+		"executes the change set once confirmed": {
+			mockDeployer: func(m *climocks.MockpipelineDeployer, p *climocks.Mockprompter) {
+				gomock.InOrder(
+					m.EXPECT().CreatePipelineChangeSet(in).Return(mockChangeSet, nil),
+					p.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil),
+					m.EXPECT().ExecuteChangeSet(mockChangeSet).Return(nil),
+				)
+			},
+		},
+		"executes the change set without prompting if the confirmation is skipped": {
+			inSkipConfirmation: true,
+			mockDeployer: func(m *climocks.MockpipelineDeployer, p *climocks.Mockprompter) {
+				m.EXPECT().CreatePipelineChangeSet(in).Return(mockChangeSet, nil)
+				p.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+				m.EXPECT().ExecuteChangeSet(mockChangeSet).Return(nil)
+			},
+		},
+		"deletes the change set without prompting in dry-run mode": {
+			inDryRun: true,
+			mockDeployer: func(m *climocks.MockpipelineDeployer, p *climocks.Mockprompter) {
+				m.EXPECT().CreatePipelineChangeSet(in).Return(mockChangeSet, nil)
+				m.EXPECT().DeleteChangeSet(mockChangeSet).Return(nil)
+				m.EXPECT().ExecuteChangeSet(gomock.Any()).Times(0)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPipelineDeployer := climocks.NewMockpipelineDeployer(ctrl)
+			mockPrompt := climocks.NewMockprompter(ctrl)
+			mockProg := climocks.NewMockprogress(ctrl)
+			mockProg.EXPECT().Start(gomock.Any()).AnyTimes()
+			mockProg.EXPECT().Stop(gomock.Any()).AnyTimes()
+			tc.mockDeployer(mockPipelineDeployer, mockPrompt)
+			b := &bytes.Buffer{}
+
+			opts := &UpdatePipelineOpts{
+				DryRun:           tc.inDryRun,
+				SkipConfirmation: tc.inSkipConfirmation,
+				pipelineDeployer: mockPipelineDeployer,
+				prog:             mockProg,
+				w:                b,
+				GlobalOpts:       &GlobalOpts{prompt: mockPrompt},
+			}
+
+			// WHEN
+			err := opts.deployPipelineWithReview(in)
+
+			// THEN
+			require.NoError(t, err)
+			require.Contains(t, b.String(), "AWS::CodePipeline::Pipeline")
+			require.Contains(t, b.String(), "Properties.Stages")
+		})
+	}
+}
+
+func TestUpdatePipelineOpts_deployPipeline(t *testing.T) {
+	in := &deploy.CreatePipelineInput{ProjectName: "badgoose", Name: "pipepiper"}
+	mockErr := errors.New("some error")
+	stream := func(resp deploy.CreatePipelineResponse) (<-chan []deploy.ResourceEvent, <-chan deploy.CreatePipelineResponse) {
+		events := make(chan []deploy.ResourceEvent, 1)
+		events <- []deploy.ResourceEvent{
+			{
+				Resource: deploy.Resource{LogicalName: "Pipeline", Type: "AWS::CodePipeline::Pipeline"},
+				Status:   "CREATE_IN_PROGRESS",
+			},
+		}
+		close(events)
+		responses := make(chan deploy.CreatePipelineResponse, 1)
+		responses <- resp
+		return events, responses
+	}
+
+	testCases := map[string]struct {
+		mockDeployer func(m *climocks.MockpipelineDeployer, p *climocks.Mockprogress)
+
+		wantedErr error
+	}{
+		"returns error if the stack can't be deployed": {
+			mockDeployer: func(m *climocks.MockpipelineDeployer, p *climocks.Mockprogress) {
+				m.EXPECT().DeployPipeline(in).Return(mockErr)
+				m.EXPECT().StreamPipelineCreation(gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("deploy pipeline: some error"),
+		},
+		"returns the error of the stack deployment": {
+			mockDeployer: func(m *climocks.MockpipelineDeployer, p *climocks.Mockprogress) {
+				m.EXPECT().DeployPipeline(in).Return(nil)
+				m.EXPECT().StreamPipelineCreation(in).Return(stream(deploy.CreatePipelineResponse{Err: mockErr}))
+				p.EXPECT().Events(gomock.Any())
+			},
+			wantedErr: errors.New("deploy pipeline pipepiper: some error"),
+		},
+		"displays the events of the stack until the deployment completes": {
+			mockDeployer: func(m *climocks.MockpipelineDeployer, p *climocks.Mockprogress) {
+				m.EXPECT().DeployPipeline(in).Return(nil)
+				m.EXPECT().StreamPipelineCreation(in).Return(stream(deploy.CreatePipelineResponse{}))
+				p.EXPECT().Events(gomock.Any())
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPipelineDeployer := climocks.NewMockpipelineDeployer(ctrl)
+			mockProg := climocks.NewMockprogress(ctrl)
+			mockProg.EXPECT().Start(gomock.Any()).AnyTimes()
+			mockProg.EXPECT().Stop(gomock.Any()).AnyTimes()
+			tc.mockDeployer(mockPipelineDeployer, mockProg)
+
+			opts := &UpdatePipelineOpts{
+				pipelineDeployer: mockPipelineDeployer,
+				prog:             mockProg,
+				GlobalOpts:       &GlobalOpts{},
+			}
+
+			// WHEN
+			err := opts.deployPipeline(in)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
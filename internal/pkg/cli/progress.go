@@ -16,16 +16,28 @@ type progress interface {
 }
 
 // envProgressOrder is the order in which we want to progress text to appear on the terminal.
-var envProgressOrder = []termprogress.Text{textVPC, textInternetGateway, textPublicSubnets, textPrivateSubnets, textNATGateway, textRouteTables, textECSCluster, textALB}
+var envProgressOrder = []termprogress.Text{textVPC, textInternetGateway, textPublicSubnets, textPrivateSubnets, textNATGateway, textRouteTables, textVPCEndpoints, textECSCluster, textALB}
 
 // Row descriptions displayed while deploying an environment.
 const (
-	textVPC             termprogress.Text = "- Virtual private cloud on 2 availability zones to hold your services"
+	textVPC             termprogress.Text = "- Virtual private cloud to hold your services"
 	textInternetGateway termprogress.Text = "  - Internet gateway to connect the network to the internet"
 	textPublicSubnets   termprogress.Text = "  - Public subnets for internet facing services "
 	textPrivateSubnets  termprogress.Text = "  - Private subnets for services that can't be reached from the internet"
 	textNATGateway      termprogress.Text = "  - NAT gateway for private services to send requests to the internet"
 	textRouteTables     termprogress.Text = "  - Routing tables for services to talk with each other"
+	textVPCEndpoints    termprogress.Text = "  - VPC endpoints for private services to reach AWS services without the internet"
 	textECSCluster      termprogress.Text = "- ECS Cluster to hold your services "
 	textALB             termprogress.Text = "- Application load balancer to distribute traffic "
 )
+
+// pipelineProgressOrder is the order in which we want the progress of a pipeline's resources to appear on the terminal.
+var pipelineProgressOrder = []termprogress.Text{textPipelineRoles, textBuildProject, textTestProjects, textPipeline}
+
+// Row descriptions displayed while deploying a pipeline.
+const (
+	textPipelineRoles termprogress.Text = "- IAM roles for the pipeline and its build and test projects"
+	textBuildProject  termprogress.Text = "- CodeBuild project to build the images of your applications"
+	textTestProjects  termprogress.Text = "- CodeBuild projects to run the integration tests of each stage"
+	textPipeline      termprogress.Text = "- CodePipeline pipeline to release your applications to your environments"
+)
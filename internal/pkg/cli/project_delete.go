@@ -0,0 +1,438 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/build/ecr"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store/secretsmanager"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	termprogress "github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/progress"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	fmtDeleteProjectPrompt = "Are you sure you want to delete project %s and all of its resources?"
+	deleteProjectHelp      = "Every application, environment and pipeline of the project is deleted along with the project's regional resources and metadata."
+)
+
+const (
+	fmtDeleteProjectResourcesStart    = "Deleting the regional resources of project %s."
+	fmtDeleteProjectResourcesFailed   = "Failed to delete the regional resources of project %s: %v."
+	fmtDeleteProjectResourcesComplete = "Deleted the regional resources of project %s."
+
+	fmtDeleteProjectStackStart    = "Deleting the roles and hosted zone of project %s."
+	fmtDeleteProjectStackFailed   = "Failed to delete the roles and hosted zone of project %s: %v."
+	fmtDeleteProjectStackComplete = "Deleted the roles and hosted zone of project %s."
+
+	fmtDeleteSecretStart    = "Deleting secret %s."
+	fmtDeleteSecretFailed   = "Failed to delete secret %s: %v."
+	fmtDeleteSecretComplete = "Deleted secret %s."
+
+	fmtDeletePipelineStart    = "Deleting pipeline stack %s."
+	fmtDeletePipelineFailed   = "Failed to delete pipeline stack %s: %v."
+	fmtDeletePipelineComplete = "Deleted pipeline stack %s."
+
+	fmtDeleteProjectParamsStart    = "Deleting the metadata of project %s."
+	fmtDeleteProjectParamsFailed   = "Failed to delete the metadata of project %s: %v."
+	fmtDeleteProjectParamsComplete = "Deleted the metadata of project %s."
+)
+
+type projectResourcesDeleter interface {
+	GetRegionalProjectResources(project *archer.Project) ([]*archer.ProjectRegionalResources, error)
+	DeleteProjectResources(projectName string) error
+	DeleteProjectStack(projectName string) error
+	ListPipelineStacks(projectName string) ([]string, error)
+	DeletePipeline(stackName string) error
+}
+
+type secretsDeleter interface {
+	archer.SecretLister
+	archer.SecretDeleter
+}
+
+// DeleteProjectOpts holds the fields needed to delete a project.
+type DeleteProjectOpts struct {
+	// Fields with matching flags.
+	SkipConfirmation bool
+
+	// Interfaces for dependencies.
+	store            projectService
+	resourcesDeleter projectResourcesDeleter
+	secretsDeleter   secretsDeleter
+	pipelines        pipelineDescriber
+	appStackDeleters map[string]appStackDeleter // Keyed by environment name, created from the environment's manager role.
+	envDeleters      map[string]actionCommand   // Keyed by environment name.
+	imageRemovers    map[string]imageRemover    // Keyed by region.
+	envProfiles      envProfileSetter
+	prog             progress
+
+	*GlobalOpts
+}
+
+// deleteProjectPlan holds the resources of the project found before deleting anything.
+type deleteProjectPlan struct {
+	apps      []*archer.Application
+	envs      []*archer.Environment
+	resources []*archer.ProjectRegionalResources
+	secrets   []string
+	pipelines []string
+}
+
+// Validate returns an error if the project does not exist.
+func (opts *DeleteProjectOpts) Validate() error {
+	if opts.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	if _, err := opts.store.GetProject(opts.ProjectName()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Execute prints the resources of the project, and once confirmed deletes the applications' stacks, the environments,
+// the project's StackSet and stack, the pipelines' secrets and stacks, and finally the project's metadata.
+// It halts on the first failure. Every step ignores resources that were already deleted, and the project's
+// metadata is deleted last, so the command can be re-run to resume the deletion.
+func (opts *DeleteProjectOpts) Execute() error {
+	plan, err := opts.plan()
+	if err != nil {
+		return err
+	}
+	opts.printPlan(plan)
+	shouldDelete, err := opts.shouldDelete()
+	if err != nil {
+		return err
+	}
+	if !shouldDelete {
+		return nil
+	}
+
+	if err := opts.deleteAppStacks(plan.apps, plan.envs); err != nil {
+		return err
+	}
+	if err := opts.deleteEnvs(plan.envs); err != nil {
+		return err
+	}
+	if err := opts.clearRepositories(plan.resources); err != nil {
+		return err
+	}
+
+	opts.prog.Start(fmt.Sprintf(fmtDeleteProjectResourcesStart, opts.ProjectName()))
+	if err := opts.resourcesDeleter.DeleteProjectResources(opts.ProjectName()); err != nil {
+		opts.prog.Stop(log.Serrorf(fmtDeleteProjectResourcesFailed, opts.ProjectName(), err))
+		return fmt.Errorf("delete regional resources of project %s: %w", opts.ProjectName(), err)
+	}
+	opts.prog.Stop(log.Ssuccessf(fmtDeleteProjectResourcesComplete, opts.ProjectName()))
+
+	opts.prog.Start(fmt.Sprintf(fmtDeleteProjectStackStart, opts.ProjectName()))
+	if err := opts.resourcesDeleter.DeleteProjectStack(opts.ProjectName()); err != nil {
+		opts.prog.Stop(log.Serrorf(fmtDeleteProjectStackFailed, opts.ProjectName(), err))
+		return fmt.Errorf("delete stack of project %s: %w", opts.ProjectName(), err)
+	}
+	opts.prog.Stop(log.Ssuccessf(fmtDeleteProjectStackComplete, opts.ProjectName()))
+
+	for _, secret := range plan.secrets {
+		opts.prog.Start(fmt.Sprintf(fmtDeleteSecretStart, secret))
+		if err := opts.secretsDeleter.DeleteSecret(secret); err != nil {
+			opts.prog.Stop(log.Serrorf(fmtDeleteSecretFailed, secret, err))
+			return err
+		}
+		opts.prog.Stop(log.Ssuccessf(fmtDeleteSecretComplete, secret))
+	}
+
+	for _, pipeline := range plan.pipelines {
+		opts.prog.Start(fmt.Sprintf(fmtDeletePipelineStart, pipeline))
+		if err := opts.resourcesDeleter.DeletePipeline(pipeline); err != nil {
+			opts.prog.Stop(log.Serrorf(fmtDeletePipelineFailed, pipeline, err))
+			return fmt.Errorf("delete pipeline stack %s: %w", pipeline, err)
+		}
+		opts.prog.Stop(log.Ssuccessf(fmtDeletePipelineComplete, pipeline))
+	}
+
+	opts.prog.Start(fmt.Sprintf(fmtDeleteProjectParamsStart, opts.ProjectName()))
+	if err := opts.store.DeleteProject(opts.ProjectName()); err != nil {
+		opts.prog.Stop(log.Serrorf(fmtDeleteProjectParamsFailed, opts.ProjectName(), err))
+		return err
+	}
+	opts.prog.Stop(log.Ssuccessf(fmtDeleteProjectParamsComplete, opts.ProjectName()))
+
+	log.Successf("Deleted project %s.\n", color.HighlightUserInput(opts.ProjectName()))
+	return nil
+}
+
+// plan looks up every resource of the project that will be deleted.
+func (opts *DeleteProjectOpts) plan() (*deleteProjectPlan, error) {
+	proj, err := opts.store.GetProject(opts.ProjectName())
+	if err != nil {
+		return nil, fmt.Errorf("get project %s: %w", opts.ProjectName(), err)
+	}
+	apps, err := opts.store.ListApplications(opts.ProjectName())
+	if err != nil {
+		return nil, fmt.Errorf("list applications in project %s: %w", opts.ProjectName(), err)
+	}
+	envs, err := opts.store.ListEnvironments(opts.ProjectName())
+	if err != nil {
+		return nil, fmt.Errorf("list environments in project %s: %w", opts.ProjectName(), err)
+	}
+	resources, err := opts.resourcesDeleter.GetRegionalProjectResources(proj)
+	if err != nil {
+		return nil, fmt.Errorf("get regional resources of project %s: %w", opts.ProjectName(), err)
+	}
+	secrets, err := opts.secretsDeleter.ListSecretNames(map[string]string{
+		stack.ProjectTagKey: opts.ProjectName(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list secrets of project %s: %w", opts.ProjectName(), err)
+	}
+	pipelines, err := opts.resourcesDeleter.ListPipelineStacks(opts.ProjectName())
+	if err != nil {
+		return nil, fmt.Errorf("list pipeline stacks of project %s: %w", opts.ProjectName(), err)
+	}
+	// Secrets created before they were tagged with the project are only known to their pipeline.
+	for _, name := range pipelines {
+		pipeline, err := opts.pipelines.Describe(name)
+		if err != nil {
+			return nil, fmt.Errorf("describe pipeline %s: %w", name, err)
+		}
+		if pipeline.Secret != "" && !contains(pipeline.Secret, secrets) {
+			secrets = append(secrets, pipeline.Secret)
+		}
+	}
+	return &deleteProjectPlan{
+		apps:      apps,
+		envs:      envs,
+		resources: resources,
+		secrets:   secrets,
+		pipelines: pipelines,
+	}, nil
+}
+
+func (opts *DeleteProjectOpts) printPlan(plan *deleteProjectPlan) {
+	var appNames, envNames, regions []string
+	for _, app := range plan.apps {
+		appNames = append(appNames, app.Name)
+	}
+	for _, env := range plan.envs {
+		envNames = append(envNames, env.Name)
+	}
+	for _, r := range plan.resources {
+		regions = append(regions, r.Region)
+	}
+
+	log.Infof("Deleting project %s removes the following resources in order:\n", color.HighlightUserInput(opts.ProjectName()))
+	log.Infof("  1. The stacks of the applications %s in every environment.\n", listOrNone(appNames))
+	log.Infof("  2. The environments %s, their stacks and IAM roles.\n", listOrNone(envNames))
+	log.Infof("  3. The ECR repositories and their images, KMS keys and StackSet instances in the regions %s, and the StackSet.\n", listOrNone(regions))
+	log.Infof("  4. The project's IAM roles, DNS delegation role and hosted zone.\n")
+	log.Infof("  5. The GitHub token secrets %s.\n", listOrNone(plan.secrets))
+	log.Infof("  6. The pipeline stacks %s.\n", listOrNone(plan.pipelines))
+	log.Infof("  7. The project's metadata under %s.\n", color.HighlightResource(fmt.Sprintf("/archer/%s", opts.ProjectName())))
+	log.Infoln("The S3 buckets storing pipeline artifacts are retained.")
+}
+
+func listOrNone(names []string) string {
+	if len(names) == 0 {
+		return "(none)"
+	}
+	return color.HighlightResource(strings.Join(names, ", "))
+}
+
+func (opts *DeleteProjectOpts) shouldDelete() (bool, error) {
+	if opts.SkipConfirmation {
+		return true, nil
+	}
+	shouldDelete, err := opts.promptFor(yesFlag).Confirm(fmt.Sprintf(fmtDeleteProjectPrompt, opts.ProjectName()), deleteProjectHelp)
+	if err != nil {
+		return false, fmt.Errorf("prompt for project deletion: %w", err)
+	}
+	return shouldDelete, nil
+}
+
+// deleteAppStacks deletes the stack of every application in every environment with the environment's execution role.
+// Applications that aren't deployed in an environment are skipped.
+func (opts *DeleteProjectOpts) deleteAppStacks(apps []*archer.Application, envs []*archer.Environment) error {
+	for _, env := range envs {
+		deleter, err := opts.appStackDeleter(env)
+		if err != nil {
+			return err
+		}
+		for _, app := range apps {
+			opts.prog.Start(fmt.Sprintf(fmtDeleteAppStackStart, app.Name, env.Name))
+			err := deleter.DeleteApp(stack.NameForApp(env.Project, env.Name, app.Name), env.ExecutionRoleARN)
+			var stackNotFound *cloudformation.ErrStackNotFound
+			if errors.As(err, &stackNotFound) {
+				opts.prog.Stop(log.Ssuccessf(fmtSkipAppStack, app.Name, env.Name))
+				continue
+			}
+			if err != nil {
+				opts.prog.Stop(log.Serrorf(fmtDeleteAppStackFailed, app.Name, env.Name, err))
+				return fmt.Errorf("delete stack of application %s in environment %s: %w", app.Name, env.Name, err)
+			}
+			opts.prog.Stop(log.Ssuccessf(fmtDeleteAppStackComplete, app.Name, env.Name))
+		}
+	}
+	return nil
+}
+
+// deleteEnvs deletes the environments the same way as "env delete".
+// Since an environment is removed from the store only if its stack and roles are deleted,
+// the store is queried again to detect the environments that failed to be deleted.
+func (opts *DeleteProjectOpts) deleteEnvs(envs []*archer.Environment) error {
+	for _, env := range envs {
+		deleter := opts.envDeleter(env.Name)
+		if err := deleter.Validate(); err != nil {
+			return err
+		}
+		if err := deleter.Execute(); err != nil {
+			return err
+		}
+	}
+	remaining, err := opts.store.ListEnvironments(opts.ProjectName())
+	if err != nil {
+		return fmt.Errorf("list environments in project %s: %w", opts.ProjectName(), err)
+	}
+	if len(remaining) > 0 {
+		var names []string
+		for _, env := range remaining {
+			names = append(names, env.Name)
+		}
+		return fmt.Errorf("environments %s could not be deleted, re-run the command to retry", strings.Join(names, ", "))
+	}
+	return nil
+}
+
+// clearRepositories deletes the images of every ECR repository of the project so that the repositories can be deleted.
+func (opts *DeleteProjectOpts) clearRepositories(resources []*archer.ProjectRegionalResources) error {
+	for _, r := range resources {
+		var appNames []string
+		for appName := range r.RepositoryURLs {
+			appNames = append(appNames, appName)
+		}
+		sort.Strings(appNames)
+		for _, appName := range appNames {
+			remover, err := opts.imageRemover(r.Region)
+			if err != nil {
+				return err
+			}
+			repoName := fmt.Sprintf(fmtECRRepositoryName, opts.ProjectName(), appName)
+			opts.prog.Start(fmt.Sprintf(fmtClearRepoStart, repoName, r.Region))
+			if err := remover.ClearRepository(repoName); err != nil {
+				opts.prog.Stop(log.Serrorf(fmtClearRepoFailed, repoName, r.Region, err))
+				return fmt.Errorf("empty repository %s in region %s: %w", repoName, r.Region, err)
+			}
+			opts.prog.Stop(log.Ssuccessf(fmtClearRepoComplete, repoName, r.Region))
+		}
+	}
+	return nil
+}
+
+func (opts *DeleteProjectOpts) appStackDeleter(env *archer.Environment) (appStackDeleter, error) {
+	if deleter, ok := opts.appStackDeleters[env.Name]; ok {
+		return deleter, nil
+	}
+	sess, err := opts.sessionProvider().FromEnvRole(env.Name, env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create session from role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	opts.appStackDeleters[env.Name] = cloudformation.New(sess)
+	return opts.appStackDeleters[env.Name], nil
+}
+
+func (opts *DeleteProjectOpts) envDeleter(envName string) actionCommand {
+	if deleter, ok := opts.envDeleters[envName]; ok {
+		return deleter
+	}
+	opts.envDeleters[envName] = &DeleteEnvOpts{
+		EnvName:          envName,
+		SkipConfirmation: true,
+		storeClient:      opts.store,
+		envProfiles:      opts.envProfiles,
+		prog:             opts.prog,
+		GlobalOpts:       opts.GlobalOpts,
+	}
+	return opts.envDeleters[envName]
+}
+
+func (opts *DeleteProjectOpts) imageRemover(region string) (imageRemover, error) {
+	if remover, ok := opts.imageRemovers[region]; ok {
+		return remover, nil
+	}
+	sess, err := opts.sessionProvider().DefaultWithRegion(region)
+	if err != nil {
+		return nil, fmt.Errorf("create session in region %s: %w", region, err)
+	}
+	opts.imageRemovers[region] = ecr.New(sess)
+	return opts.imageRemovers[region], nil
+}
+
+// BuildProjectDeleteCommand builds the command to delete a project.
+func BuildProjectDeleteCommand() *cobra.Command {
+	opts := &DeleteProjectOpts{
+		appStackDeleters: make(map[string]appStackDeleter),
+		envDeleters:      make(map[string]actionCommand),
+		imageRemovers:    make(map[string]imageRemover),
+		GlobalOpts:       NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Deletes a project and all of its resources.",
+		Long: `Deletes every application, environment and pipeline of a project, the project's
+regional resources, IAM roles and hosted zone, and finally its metadata.
+The resources to delete are listed before anything is deleted.
+If the deletion fails, re-run the command to resume it.`,
+		Example: `
+  Delete the project in your workspace.
+  /code $ archer project delete
+
+  Delete the "phonetool" project without prompting.
+  /code $ archer project delete --project phonetool --yes`,
+		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			store, err := store.New()
+			if err != nil {
+				return fmt.Errorf("connect to ecs-cli metadata store: %w", err)
+			}
+			opts.store = store
+
+			secrets, err := secretsmanager.NewStore()
+			if err != nil {
+				return fmt.Errorf("connect to secrets manager: %w", err)
+			}
+			opts.secretsDeleter = secrets
+
+			sess, err := opts.sessionProvider().Default()
+			if err != nil {
+				return fmt.Errorf("error retrieving default session: %w", err)
+			}
+			opts.resourcesDeleter = cloudformation.New(sess)
+			opts.pipelines = describe.NewPipelineDescriber(sess)
+			ws, err := workspace.New()
+			if err != nil {
+				return fmt.Errorf("new workspace: %w", err)
+			}
+			opts.envProfiles = ws
+			opts.prog = termprogress.NewSpinner()
+			return opts.Validate()
+		}),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			return opts.Execute()
+		}),
+	}
+	// An empty project name falls back to the project of the workspace.
+	cmd.Flags().StringVarP(&opts.projectName, projectFlag, projectFlagShort, "", projectFlagDescription)
+	markFlagCompletion(cmd.Flags(), projectFlag, completeProjects)
+	cmd.Flags().BoolVar(&opts.SkipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
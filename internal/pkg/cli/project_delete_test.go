@@ -0,0 +1,284 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteProjectOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inProjectName string
+		mockStore     func(m *climocks.MockprojectService)
+
+		wantedErr error
+	}{
+		"returns error if there is no project": {
+			mockStore: func(m *climocks.MockprojectService) {},
+			wantedErr: errNoProjectInWorkspace,
+		},
+		"returns error if the project does not exist": {
+			inProjectName: "phonetool",
+			mockStore: func(m *climocks.MockprojectService) {
+				m.EXPECT().GetProject("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"valid project": {
+			inProjectName: "phonetool",
+			mockStore: func(m *climocks.MockprojectService) {
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := climocks.NewMockprojectService(ctrl)
+			tc.mockStore(mockStore)
+
+			opts := &DeleteProjectOpts{
+				store:      mockStore,
+				GlobalOpts: &GlobalOpts{projectName: tc.inProjectName},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDeleteProjectOpts_Execute(t *testing.T) {
+	proj := &archer.Project{Name: "phonetool"}
+	testEnv := &archer.Environment{
+		Project:          "phonetool",
+		Name:             "test",
+		Region:           "us-west-2",
+		ExecutionRoleARN: "arn:aws:iam::1111:role/phonetool-test-CFNExecutionRole",
+	}
+	apps := []*archer.Application{{Name: "frontend"}, {Name: "backend"}}
+	resources := []*archer.ProjectRegionalResources{
+		{
+			Region: "us-west-2",
+			RepositoryURLs: map[string]string{
+				"frontend": "1111.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend",
+				"backend":  "1111.dkr.ecr.us-west-2.amazonaws.com/phonetool/backend",
+			},
+		},
+	}
+	mockErr := errors.New("some error")
+
+	// expectPlan sets the expectations for looking up the resources of the project.
+	expectPlan := func(store *climocks.MockprojectService, deleter *climocks.MockprojectResourcesDeleter, secrets *climocks.MocksecretsDeleter, pipelines *climocks.MockpipelineDescriber) {
+		store.EXPECT().GetProject("phonetool").Return(proj, nil)
+		store.EXPECT().ListApplications("phonetool").Return(apps, nil)
+		store.EXPECT().ListEnvironments("phonetool").Return([]*archer.Environment{testEnv}, nil)
+		deleter.EXPECT().GetRegionalProjectResources(proj).Return(resources, nil)
+		secrets.EXPECT().ListSecretNames(map[string]string{"ecs-project": "phonetool"}).Return([]string{"github-token-phonetool-repo"}, nil)
+		deleter.EXPECT().ListPipelineStacks("phonetool").Return([]string{"phonetool-pipeline-phonetool-repo"}, nil)
+		pipelines.EXPECT().Describe("phonetool-pipeline-phonetool-repo").Return(&describe.Pipeline{
+			Name:   "phonetool-pipeline-phonetool-repo",
+			Secret: "github-token-phonetool-repo",
+		}, nil)
+	}
+
+	testCases := map[string]struct {
+		inSkipConfirmation bool
+
+		setupMocks func(
+			prompt *climocks.Mockprompter,
+			store *climocks.MockprojectService,
+			deleter *climocks.MockprojectResourcesDeleter,
+			secrets *climocks.MocksecretsDeleter,
+			pipelines *climocks.MockpipelineDescriber,
+			appStacks *climocks.MockappStackDeleter,
+			env *climocks.MockactionCommand,
+			images *climocks.MockimageRemover)
+
+		wantedErr error
+	}{
+		"does nothing if the user does not confirm": {
+			setupMocks: func(prompt *climocks.Mockprompter, store *climocks.MockprojectService, deleter *climocks.MockprojectResourcesDeleter,
+				secrets *climocks.MocksecretsDeleter, pipelines *climocks.MockpipelineDescriber, appStacks *climocks.MockappStackDeleter, env *climocks.MockactionCommand, images *climocks.MockimageRemover) {
+				expectPlan(store, deleter, secrets, pipelines)
+				prompt.EXPECT().Confirm("Are you sure you want to delete project phonetool and all of its resources?", gomock.Any()).Return(false, nil)
+			},
+		},
+		"halts if an application stack cannot be deleted": {
+			inSkipConfirmation: true,
+			setupMocks: func(prompt *climocks.Mockprompter, store *climocks.MockprojectService, deleter *climocks.MockprojectResourcesDeleter,
+				secrets *climocks.MocksecretsDeleter, pipelines *climocks.MockpipelineDescriber, appStacks *climocks.MockappStackDeleter, env *climocks.MockactionCommand, images *climocks.MockimageRemover) {
+				expectPlan(store, deleter, secrets, pipelines)
+				appStacks.EXPECT().DeleteApp("phonetool-test-frontend", testEnv.ExecutionRoleARN).Return(mockErr)
+			},
+			wantedErr: errors.New("delete stack of application frontend in environment test: some error"),
+		},
+		"skips applications that are not deployed in an environment": {
+			inSkipConfirmation: true,
+			setupMocks: func(prompt *climocks.Mockprompter, store *climocks.MockprojectService, deleter *climocks.MockprojectResourcesDeleter,
+				secrets *climocks.MocksecretsDeleter, pipelines *climocks.MockpipelineDescriber, appStacks *climocks.MockappStackDeleter, env *climocks.MockactionCommand, images *climocks.MockimageRemover) {
+				expectPlan(store, deleter, secrets, pipelines)
+				gomock.InOrder(
+					appStacks.EXPECT().DeleteApp("phonetool-test-frontend", testEnv.ExecutionRoleARN).Return(&cloudformation.ErrStackNotFound{}),
+					appStacks.EXPECT().DeleteApp("phonetool-test-backend", testEnv.ExecutionRoleARN).Return(nil),
+					env.EXPECT().Validate().Return(nil),
+					env.EXPECT().Execute().Return(nil),
+					store.EXPECT().ListEnvironments("phonetool").Return(nil, nil),
+					images.EXPECT().ClearRepository(gomock.Any()).Return(nil).Times(2),
+					deleter.EXPECT().DeleteProjectResources("phonetool").Return(mockErr),
+				)
+			},
+			wantedErr: errors.New("delete regional resources of project phonetool: some error"),
+		},
+		"halts if an environment is left in the store": {
+			inSkipConfirmation: true,
+			setupMocks: func(prompt *climocks.Mockprompter, store *climocks.MockprojectService, deleter *climocks.MockprojectResourcesDeleter,
+				secrets *climocks.MocksecretsDeleter, pipelines *climocks.MockpipelineDescriber, appStacks *climocks.MockappStackDeleter, env *climocks.MockactionCommand, images *climocks.MockimageRemover) {
+				expectPlan(store, deleter, secrets, pipelines)
+				appStacks.EXPECT().DeleteApp(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				env.EXPECT().Validate().Return(nil)
+				env.EXPECT().Execute().Return(nil)
+				store.EXPECT().ListEnvironments("phonetool").Return([]*archer.Environment{testEnv}, nil)
+			},
+			wantedErr: errors.New("environments test could not be deleted, re-run the command to retry"),
+		},
+		"halts if the regional resources cannot be deleted": {
+			inSkipConfirmation: true,
+			setupMocks: func(prompt *climocks.Mockprompter, store *climocks.MockprojectService, deleter *climocks.MockprojectResourcesDeleter,
+				secrets *climocks.MocksecretsDeleter, pipelines *climocks.MockpipelineDescriber, appStacks *climocks.MockappStackDeleter, env *climocks.MockactionCommand, images *climocks.MockimageRemover) {
+				expectPlan(store, deleter, secrets, pipelines)
+				appStacks.EXPECT().DeleteApp(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				env.EXPECT().Validate().Return(nil)
+				env.EXPECT().Execute().Return(nil)
+				store.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+				images.EXPECT().ClearRepository(gomock.Any()).Return(nil).Times(2)
+				deleter.EXPECT().DeleteProjectResources("phonetool").Return(mockErr)
+			},
+			wantedErr: errors.New("delete regional resources of project phonetool: some error"),
+		},
+		"deletes every resource of the project in order": {
+			inSkipConfirmation: true,
+			setupMocks: func(prompt *climocks.Mockprompter, store *climocks.MockprojectService, deleter *climocks.MockprojectResourcesDeleter,
+				secrets *climocks.MocksecretsDeleter, pipelines *climocks.MockpipelineDescriber, appStacks *climocks.MockappStackDeleter, env *climocks.MockactionCommand, images *climocks.MockimageRemover) {
+				expectPlan(store, deleter, secrets, pipelines)
+				gomock.InOrder(
+					appStacks.EXPECT().DeleteApp("phonetool-test-frontend", testEnv.ExecutionRoleARN).Return(nil),
+					appStacks.EXPECT().DeleteApp("phonetool-test-backend", testEnv.ExecutionRoleARN).Return(nil),
+					env.EXPECT().Validate().Return(nil),
+					env.EXPECT().Execute().Return(nil),
+					store.EXPECT().ListEnvironments("phonetool").Return(nil, nil),
+					images.EXPECT().ClearRepository("phonetool/backend").Return(nil),
+					images.EXPECT().ClearRepository("phonetool/frontend").Return(nil),
+					deleter.EXPECT().DeleteProjectResources("phonetool").Return(nil),
+					deleter.EXPECT().DeleteProjectStack("phonetool").Return(nil),
+					secrets.EXPECT().DeleteSecret("github-token-phonetool-repo").Return(nil),
+					deleter.EXPECT().DeletePipeline("phonetool-pipeline-phonetool-repo").Return(nil),
+					store.EXPECT().DeleteProject("phonetool").Return(nil),
+				)
+			},
+		},
+		"deletes the untagged secrets of the project's pipelines": {
+			inSkipConfirmation: true,
+			setupMocks: func(prompt *climocks.Mockprompter, store *climocks.MockprojectService, deleter *climocks.MockprojectResourcesDeleter,
+				secrets *climocks.MocksecretsDeleter, pipelines *climocks.MockpipelineDescriber, appStacks *climocks.MockappStackDeleter, env *climocks.MockactionCommand, images *climocks.MockimageRemover) {
+				store.EXPECT().GetProject("phonetool").Return(proj, nil)
+				store.EXPECT().ListApplications("phonetool").Return(nil, nil)
+				store.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+				deleter.EXPECT().GetRegionalProjectResources(proj).Return(nil, nil)
+				secrets.EXPECT().ListSecretNames(map[string]string{"ecs-project": "phonetool"}).Return(nil, nil)
+				deleter.EXPECT().ListPipelineStacks("phonetool").Return([]string{"phonetool-pipeline-phonetool-repo"}, nil)
+				pipelines.EXPECT().Describe("phonetool-pipeline-phonetool-repo").Return(&describe.Pipeline{
+					Name:   "phonetool-pipeline-phonetool-repo",
+					Secret: "github-token-phonetool-repo",
+				}, nil)
+				gomock.InOrder(
+					store.EXPECT().ListEnvironments("phonetool").Return(nil, nil),
+					deleter.EXPECT().DeleteProjectResources("phonetool").Return(nil),
+					deleter.EXPECT().DeleteProjectStack("phonetool").Return(nil),
+					secrets.EXPECT().DeleteSecret("github-token-phonetool-repo").Return(nil),
+					deleter.EXPECT().DeletePipeline("phonetool-pipeline-phonetool-repo").Return(nil),
+					store.EXPECT().DeleteProject("phonetool").Return(nil),
+				)
+			},
+		},
+		"halts if a pipeline cannot be described": {
+			inSkipConfirmation: true,
+			setupMocks: func(prompt *climocks.Mockprompter, store *climocks.MockprojectService, deleter *climocks.MockprojectResourcesDeleter,
+				secrets *climocks.MocksecretsDeleter, pipelines *climocks.MockpipelineDescriber, appStacks *climocks.MockappStackDeleter, env *climocks.MockactionCommand, images *climocks.MockimageRemover) {
+				store.EXPECT().GetProject("phonetool").Return(proj, nil)
+				store.EXPECT().ListApplications("phonetool").Return(apps, nil)
+				store.EXPECT().ListEnvironments("phonetool").Return([]*archer.Environment{testEnv}, nil)
+				deleter.EXPECT().GetRegionalProjectResources(proj).Return(resources, nil)
+				secrets.EXPECT().ListSecretNames(map[string]string{"ecs-project": "phonetool"}).Return(nil, nil)
+				deleter.EXPECT().ListPipelineStacks("phonetool").Return([]string{"phonetool-pipeline-phonetool-repo"}, nil)
+				pipelines.EXPECT().Describe("phonetool-pipeline-phonetool-repo").Return(nil, mockErr)
+			},
+			wantedErr: errors.New("describe pipeline phonetool-pipeline-phonetool-repo: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPrompt := climocks.NewMockprompter(ctrl)
+			mockStore := climocks.NewMockprojectService(ctrl)
+			mockDeleter := climocks.NewMockprojectResourcesDeleter(ctrl)
+			mockSecrets := climocks.NewMocksecretsDeleter(ctrl)
+			mockPipelines := climocks.NewMockpipelineDescriber(ctrl)
+			mockAppStacks := climocks.NewMockappStackDeleter(ctrl)
+			mockEnv := climocks.NewMockactionCommand(ctrl)
+			mockImages := climocks.NewMockimageRemover(ctrl)
+			mockProg := climocks.NewMockprogress(ctrl)
+			mockProg.EXPECT().Start(gomock.Any()).AnyTimes()
+			mockProg.EXPECT().Stop(gomock.Any()).AnyTimes()
+			tc.setupMocks(mockPrompt, mockStore, mockDeleter, mockSecrets, mockPipelines, mockAppStacks, mockEnv, mockImages)
+
+			opts := &DeleteProjectOpts{
+				SkipConfirmation: tc.inSkipConfirmation,
+				store:            mockStore,
+				resourcesDeleter: mockDeleter,
+				secretsDeleter:   mockSecrets,
+				pipelines:        mockPipelines,
+				appStackDeleters: map[string]appStackDeleter{"test": mockAppStacks},
+				envDeleters:      map[string]actionCommand{"test": mockEnv},
+				imageRemovers:    map[string]imageRemover{"us-west-2": mockImages},
+				prog:             mockProg,
+				GlobalOpts: &GlobalOpts{
+					projectName: "phonetool",
+					prompt:      mockPrompt,
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
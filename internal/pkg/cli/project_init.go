@@ -38,32 +38,37 @@ type InitProjectOpts struct {
 	deployer     projectDeployer
 	prompt       prompter
 	prog         progress
+
+	noPrompt bool // true means prompts are disabled even if the --no-prompt flag isn't set.
 }
 
 // NewInitProjectOpts returns a new InitProjectOpts.
+// Its AWS clients are created with initClients once the global flags choosing the credentials are parsed.
 func NewInitProjectOpts() (*InitProjectOpts, error) {
-	defaultSession, err := session.Default()
+	ws, err := workspace.New()
 	if err != nil {
 		return nil, err
 	}
+	return &InitProjectOpts{
+		ws:     ws,
+		prompt: prompt.New(),
+		prog:   termprogress.NewSpinner(),
+	}, nil
+}
 
-	store, err := store.New()
+func (opts *InitProjectOpts) initClients() error {
+	defaultSession, err := session.Default()
 	if err != nil {
-		return nil, err
+		return err
 	}
-
-	ws, err := workspace.New()
+	store, err := store.New()
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return &InitProjectOpts{
-		identity:     identity.New(defaultSession),
-		projectStore: store,
-		ws:           ws,
-		deployer:     cloudformation.New(defaultSession),
-		prompt:       prompt.New(),
-		prog:         termprogress.NewSpinner(),
-	}, nil
+	opts.identity = identity.New(defaultSession)
+	opts.projectStore = store
+	opts.deployer = cloudformation.New(defaultSession)
+	return nil
 }
 
 // Ask prompts the user for any required arguments that they didn't provide.
@@ -97,7 +102,7 @@ func (opts *InitProjectOpts) Ask() error {
 	}
 
 	log.Infoln("Looks like you have some projects already.")
-	useExistingProject, err := opts.prompt.Confirm("Would you like to use one of your existing projects?", "", prompt.WithTrueDefault())
+	useExistingProject, err := opts.promptForName().Confirm("Would you like to use one of your existing projects?", "", prompt.WithTrueDefault())
 	if err != nil {
 		return fmt.Errorf("prompt to confirm using existing project: %w", err)
 	}
@@ -159,7 +164,7 @@ func (opts *InitProjectOpts) RecommendedActions() []string {
 }
 
 func (opts *InitProjectOpts) askNewProjectName() error {
-	projectName, err := opts.prompt.Get(
+	projectName, err := opts.promptForName().Get(
 		"What would you like to call your project?",
 		"Applications under the same project share the same VPC and ECS Cluster and are discoverable via service discovery.",
 		validateProjectName)
@@ -175,7 +180,7 @@ func (opts *InitProjectOpts) askSelectExistingProjectName(existingProjects []*ar
 	for _, p := range existingProjects {
 		projectNames = append(projectNames, p.Name)
 	}
-	projectName, err := opts.prompt.SelectOne(
+	projectName, err := opts.promptForName().SelectOne(
 		"Which one do you want to add a new application to?",
 		"Applications in the same project share the same VPC, ECS Cluster and are discoverable via service discovery.",
 		projectNames)
@@ -186,6 +191,15 @@ func (opts *InitProjectOpts) askSelectExistingProjectName(existingProjects []*ar
 	return nil
 }
 
+// promptForName returns the prompter to ask for the project's name.
+// In non-interactive mode, the prompter fails with an error naming the missing argument instead.
+func (opts *InitProjectOpts) promptForName() prompter {
+	if opts.noPrompt || promptsDisabled() {
+		return &noPrompter{err: &ErrMissingArg{Arg: nameArg}}
+	}
+	return opts.prompt
+}
+
 // BuildProjectInitCommand builds the command for creating a new project.
 func BuildProjectInitCommand() *cobra.Command {
 	opts, err := NewInitProjectOpts()
@@ -200,7 +214,10 @@ A project is a collection of containerized applications (or micro-services) that
   /code $ archer project init test`,
 		Args: reservedArgs,
 		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			return err
+			if err != nil {
+				return err
+			}
+			return opts.initClients()
 		}),
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			if len(args) == 1 {
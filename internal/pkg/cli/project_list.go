@@ -10,29 +10,56 @@ import (
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/output"
 	"github.com/spf13/cobra"
 )
 
 // ListProjectOpts contains the fields to collect for listing a project.
 type ListProjectOpts struct {
+	ShouldOutputJSON bool
+	OutputFormat     string
+
 	store archer.ProjectLister
 	w     io.Writer
 }
 
+// Validate returns an error if the flag values passed by the user are invalid.
+func (opts *ListProjectOpts) Validate() error {
+	return output.Validate(outputFormat(opts.OutputFormat, opts.ShouldOutputJSON))
+}
+
 // Execute lists the existing projects to the prompt.
 func (opts *ListProjectOpts) Execute() error {
 	projects, err := opts.store.ListProjects()
 	if err != nil {
 		return err
 	}
-
-	for _, proj := range projects {
-		fmt.Fprintln(opts.w, proj.Name)
+	if err := output.Write(opts.w, outputFormat(opts.OutputFormat, opts.ShouldOutputJSON), projectList{Projects: projects}); err != nil {
+		return fmt.Errorf("write projects: %w", err)
 	}
-
 	return nil
 }
 
+// projectList is the output of "project ls".
+// Its JSON and YAML schema is {"projects": [...]} with the fields of archer.Project.
+type projectList struct {
+	Projects []*archer.Project `json:"projects"`
+}
+
+// Header returns the columns of the projects table.
+func (l projectList) Header() []string {
+	return []string{"Name", "Account ID", "Domain"}
+}
+
+// Rows returns one row per project.
+func (l projectList) Rows() [][]string {
+	var rows [][]string
+	for _, proj := range l.Projects {
+		rows = append(rows, []string{proj.Name, valueOrDash(proj.AccountID), valueOrDash(proj.Domain)})
+	}
+	return rows
+}
+
 // BuildProjectListCommand builds the command to list existing projects.
 func BuildProjectListCommand() *cobra.Command {
 	opts := ListProjectOpts{
@@ -43,7 +70,12 @@ func BuildProjectListCommand() *cobra.Command {
 		Short: "Lists all projects in your account.",
 		Example: `
   List all the projects in your account and region
-  /code $ archer project ls`,
+  /code $ archer project ls
+  List the projects in JSON
+  /code $ archer project ls --output json`,
+		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			return opts.Validate()
+		}),
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			ssmStore, err := store.New()
 			if err != nil {
@@ -53,5 +85,6 @@ func BuildProjectListCommand() *cobra.Command {
 			return opts.Execute()
 		}),
 	}
+	addOutputFlags(cmd, &opts.OutputFormat, &opts.ShouldOutputJSON)
 	return cmd
 }
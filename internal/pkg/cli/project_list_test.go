@@ -4,8 +4,8 @@
 package cli
 
 import (
+	"bytes"
 	"fmt"
-	"io/ioutil"
 	"testing"
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
@@ -20,14 +20,14 @@ func TestProjectList_Execute(t *testing.T) {
 	defer ctrl.Finish()
 
 	testCases := map[string]struct {
-		listOpts ListProjectOpts
-		mocking  func()
-		want     error
+		listOpts      ListProjectOpts
+		mocking       func()
+		want          error
+		wantedContent string
 	}{
 		"with projects": {
 			listOpts: ListProjectOpts{
 				store: mockProjectStore,
-				w:     ioutil.Discard,
 			},
 			mocking: func() {
 				mockProjectStore.
@@ -39,11 +39,29 @@ func TestProjectList_Execute(t *testing.T) {
 					}, nil).
 					Times(1)
 			},
+			wantedContent: "Name                Account ID          Domain\n" +
+				"project1            -                   -\n" +
+				"project2            -                   -\n",
+		},
+		"with json projects": {
+			listOpts: ListProjectOpts{
+				OutputFormat: "json",
+				store:        mockProjectStore,
+			},
+			mocking: func() {
+				mockProjectStore.
+					EXPECT().
+					ListProjects().
+					Return([]*archer.Project{
+						&archer.Project{Name: "project1", AccountID: "123456789012", Domain: "example.com", Version: "1.0"},
+					}, nil).
+					Times(1)
+			},
+			wantedContent: `{"projects":[{"name":"project1","account":"123456789012","domain":"example.com","version":"1.0"}]}` + "\n",
 		},
 		"with an error": {
 			listOpts: ListProjectOpts{
 				store: mockProjectStore,
-				w:     ioutil.Discard,
 			},
 			mocking: func() {
 				mockProjectStore.
@@ -58,11 +76,14 @@ func TestProjectList_Execute(t *testing.T) {
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
+			b := &bytes.Buffer{}
 			tc.mocking()
+			tc.listOpts.w = b
 
 			got := tc.listOpts.Execute()
 
 			require.Equal(t, tc.want, got)
+			require.Equal(t, tc.wantedContent, b.String())
 		})
 	}
 }
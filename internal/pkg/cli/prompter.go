@@ -3,11 +3,60 @@
 
 package cli
 
-import "github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/prompt"
+import (
+	"fmt"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/prompt"
+)
 
 type prompter interface {
 	Get(message, help string, validator prompt.ValidatorFunc) (string, error)
 	GetSecret(message, help string) (string, error)
 	SelectOne(message, help string, options []string) (string, error)
+	MultiSelect(message, help string, options []string) ([]string, error)
 	Confirm(message, help string, options ...prompt.ConfirmOption) (bool, error)
 }
+
+// ErrMissingFlag is returned instead of prompting for the value of a flag when prompts are disabled.
+type ErrMissingFlag struct {
+	Flag string
+}
+
+func (e *ErrMissingFlag) Error() string {
+	return fmt.Sprintf("--%s is required in non-interactive mode", e.Flag)
+}
+
+// ErrMissingArg is returned instead of prompting for the value of a positional argument when prompts are disabled.
+type ErrMissingArg struct {
+	Arg string
+}
+
+func (e *ErrMissingArg) Error() string {
+	return fmt.Sprintf("the %s argument is required in non-interactive mode", e.Arg)
+}
+
+// noPrompter is the prompter of commands running in non-interactive mode.
+// Every prompt fails with the error naming the missing input.
+type noPrompter struct {
+	err error
+}
+
+func (p *noPrompter) Get(message, help string, validator prompt.ValidatorFunc) (string, error) {
+	return "", p.err
+}
+
+func (p *noPrompter) GetSecret(message, help string) (string, error) {
+	return "", p.err
+}
+
+func (p *noPrompter) SelectOne(message, help string, options []string) (string, error) {
+	return "", p.err
+}
+
+func (p *noPrompter) MultiSelect(message, help string, options []string) ([]string, error) {
+	return nil, p.err
+}
+
+func (p *noPrompter) Confirm(message, help string, options ...prompt.ConfirmOption) (bool, error) {
+	return false, p.err
+}
@@ -0,0 +1,445 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/addons"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/ec2"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNoPrompter(t *testing.T) {
+	wantedErr := &ErrMissingFlag{Flag: envFlag}
+	p := &noPrompter{err: wantedErr}
+
+	_, err := p.Get("message", "help", nil)
+	require.Equal(t, wantedErr, err)
+	_, err = p.GetSecret("message", "help")
+	require.Equal(t, wantedErr, err)
+	_, err = p.SelectOne("message", "help", []string{"test", "prod"})
+	require.Equal(t, wantedErr, err)
+	_, err = p.MultiSelect("message", "help", []string{"test", "prod"})
+	require.Equal(t, wantedErr, err)
+	_, err = p.Confirm("message", "help")
+	require.Equal(t, wantedErr, err)
+
+	require.EqualError(t, wantedErr, "--env is required in non-interactive mode")
+	require.EqualError(t, &ErrMissingArg{Arg: nameArg}, "the name argument is required in non-interactive mode")
+}
+
+func TestGlobalOpts_promptFor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockPrompt := climocks.NewMockprompter(ctrl)
+
+	interactive := &GlobalOpts{prompt: mockPrompt}
+	require.True(t, interactive.interactive())
+	require.Equal(t, mockPrompt, interactive.promptFor(envFlag))
+	require.Equal(t, mockPrompt, interactive.promptForArg(nameArg))
+
+	nonInteractive := &GlobalOpts{prompt: mockPrompt, noPrompt: true}
+	require.False(t, nonInteractive.interactive())
+	require.Equal(t, &noPrompter{err: &ErrMissingFlag{Flag: envFlag}}, nonInteractive.promptFor(envFlag))
+	require.Equal(t, &noPrompter{err: &ErrMissingArg{Arg: nameArg}}, nonInteractive.promptForArg(nameArg))
+}
+
+// TestAsk_NonInteractive runs the Ask method of every command with prompts disabled.
+// The prompter mock has no expectations: the commands must fail naming the missing input instead of prompting.
+func TestAsk_NonInteractive(t *testing.T) {
+	apps := []*archer.Application{{Name: "frontend"}, {Name: "backend"}}
+	envs := []*archer.Environment{{Name: "test"}, {Name: "prod"}}
+
+	testCases := map[string]struct {
+		setupOpts func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error }
+
+		wantedErr string // Empty if Ask succeeds without prompting.
+	}{
+		"app delete without --name": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				store := climocks.NewMockprojectService(ctrl)
+				store.EXPECT().ListApplications("phonetool").Return(apps, nil)
+				return &DeleteAppOpts{store: store, GlobalOpts: global}
+			},
+			wantedErr: "failed to select application: --name is required in non-interactive mode",
+		},
+		"app exec without --app": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				lister := mocks.NewMockApplicationLister(ctrl)
+				lister.EXPECT().ListApplications("phonetool").Return(apps, nil)
+				return &ExecAppOpts{appLister: lister, GlobalOpts: global}
+			},
+			wantedErr: "failed to select application: --app is required in non-interactive mode",
+		},
+		"app exec without --env": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				store := mocks.NewMockEnvironmentStore(ctrl)
+				store.EXPECT().ListEnvironments("phonetool").Return(envs, nil)
+				return &ExecAppOpts{AppName: "frontend", envStore: store, GlobalOpts: global}
+			},
+			wantedErr: "failed to select environment: --env is required in non-interactive mode",
+		},
+		"app history without --name": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				lister := mocks.NewMockApplicationLister(ctrl)
+				lister.EXPECT().ListApplications("phonetool").Return(apps, nil)
+				return &HistoryAppOpts{appLister: lister, GlobalOpts: global}
+			},
+			wantedErr: "failed to select application: --name is required in non-interactive mode",
+		},
+		"app history without --env": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				lister := mocks.NewMockEnvironmentLister(ctrl)
+				lister.EXPECT().ListEnvironments("phonetool").Return(envs, nil)
+				return &HistoryAppOpts{AppName: "frontend", envLister: lister, GlobalOpts: global}
+			},
+			wantedErr: "failed to select environment: --env is required in non-interactive mode",
+		},
+		"app init without --app-type": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				return &InitAppOpts{GlobalOpts: global}
+			},
+			wantedErr: "failed to get type selection: --app-type is required in non-interactive mode",
+		},
+		"app init without --name": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				return &InitAppOpts{AppType: "Load Balanced Web App", GlobalOpts: global}
+			},
+			wantedErr: "failed to get application name: --name is required in non-interactive mode",
+		},
+		"app init without --dockerfile": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				fs := afero.NewMemMapFs()
+				afero.WriteFile(fs, "frontend/Dockerfile", []byte("FROM nginx"), 0644)
+				return &InitAppOpts{AppType: "Load Balanced Web App", AppName: "frontend", fs: fs, GlobalOpts: global}
+			},
+			wantedErr: "failed to select Dockerfile: --dockerfile is required in non-interactive mode",
+		},
+		"app logs without --name": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				lister := mocks.NewMockApplicationLister(ctrl)
+				lister.EXPECT().ListApplications("phonetool").Return(apps, nil)
+				return &AppLogsOpts{appLister: lister, GlobalOpts: global}
+			},
+			wantedErr: "failed to select application: --name is required in non-interactive mode",
+		},
+		"app logs without --env": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				store := mocks.NewMockEnvironmentStore(ctrl)
+				store.EXPECT().ListEnvironments("phonetool").Return(envs, nil)
+				return &AppLogsOpts{AppName: "frontend", envStore: store, GlobalOpts: global}
+			},
+			wantedErr: "failed to select environment: --env is required in non-interactive mode",
+		},
+		"app package without --name": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				ws := mocks.NewMockWorkspace(ctrl)
+				ws.EXPECT().AppNames().Return([]string{"frontend", "backend"}, nil)
+				return &PackageAppOpts{ws: ws, GlobalOpts: global}
+			},
+			wantedErr: "prompt application name: --name is required in non-interactive mode",
+		},
+		"app package without --env": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				store := climocks.NewMockprojectService(ctrl)
+				store.EXPECT().ListEnvironments("phonetool").Return(envs, nil)
+				return &PackageAppOpts{AppName: "frontend", store: store, GlobalOpts: global}
+			},
+			wantedErr: "prompt environment name: --env is required in non-interactive mode",
+		},
+		"app rollback without --name": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				lister := mocks.NewMockApplicationLister(ctrl)
+				lister.EXPECT().ListApplications("phonetool").Return(apps, nil)
+				return &RollbackAppOpts{appLister: lister, GlobalOpts: global}
+			},
+			wantedErr: "failed to select application: --name is required in non-interactive mode",
+		},
+		"app rollback without --env": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				store := mocks.NewMockEnvironmentStore(ctrl)
+				store.EXPECT().ListEnvironments("phonetool").Return(envs, nil)
+				return &RollbackAppOpts{AppName: "frontend", envStore: store, GlobalOpts: global}
+			},
+			wantedErr: "failed to select environment: --env is required in non-interactive mode",
+		},
+		"app run local without --app": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				ws := climocks.NewMocklocalAppWorkspace(ctrl)
+				ws.EXPECT().AppNames().Return([]string{"frontend", "backend"}, nil)
+				return &RunLocalAppOpts{ws: ws, GlobalOpts: global}
+			},
+			wantedErr: "select application: --app is required in non-interactive mode",
+		},
+		"app run local without --env": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				store := mocks.NewMockEnvironmentStore(ctrl)
+				store.EXPECT().ListEnvironments("phonetool").Return(envs, nil)
+				return &RunLocalAppOpts{AppName: "frontend", envStore: store, GlobalOpts: global}
+			},
+			wantedErr: "failed to select environment: --env is required in non-interactive mode",
+		},
+		"app show without --name": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				store := climocks.NewMockprojectService(ctrl)
+				store.EXPECT().ListApplications("phonetool").Return(apps, nil)
+				return &ShowAppOpts{store: store, GlobalOpts: global}
+			},
+			wantedErr: "failed to select application: --name is required in non-interactive mode",
+		},
+		"app status without --name": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				lister := mocks.NewMockApplicationLister(ctrl)
+				lister.EXPECT().ListApplications("phonetool").Return(apps, nil)
+				return &AppStatusOpts{appLister: lister, GlobalOpts: global}
+			},
+			wantedErr: "failed to select application: --name is required in non-interactive mode",
+		},
+		"app status without --env": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				store := mocks.NewMockEnvironmentStore(ctrl)
+				store.EXPECT().ListEnvironments("phonetool").Return(envs, nil)
+				return &AppStatusOpts{AppName: "frontend", envStore: store, GlobalOpts: global}
+			},
+			wantedErr: "failed to select environment: --env is required in non-interactive mode",
+		},
+		"env delete doesn't prompt": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				return &DeleteEnvOpts{EnvName: "test", GlobalOpts: global}
+			},
+		},
+		"env init without name": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				return &InitEnvOpts{GlobalOpts: global}
+			},
+			wantedErr: "failed to get environment name: the name argument is required in non-interactive mode",
+		},
+		"env init without VPC flags creates the default VPC": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				return &InitEnvOpts{EnvName: "test", GlobalOpts: global}
+			},
+		},
+		"env init importing a VPC without --import-vpc-id": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				lister := climocks.NewMockvpcLister(ctrl)
+				lister.EXPECT().ListVPCs().Return([]ec2.VPC{{ID: "vpc-1"}, {ID: "vpc-2"}}, nil)
+				return &InitEnvOpts{EnvName: "test", ImportPublicSubnetIDs: []string{"subnet-1"}, vpcLister: lister, GlobalOpts: global}
+			},
+			wantedErr: "select VPC: --import-vpc-id is required in non-interactive mode",
+		},
+		"env init importing a VPC without --import-public-subnets": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				lister := climocks.NewMockvpcLister(ctrl)
+				lister.EXPECT().ListSubnets("vpc-1").Return([]ec2.Subnet{{ID: "subnet-1"}, {ID: "subnet-2"}}, nil)
+				return &InitEnvOpts{EnvName: "test", ImportVPCID: "vpc-1", vpcLister: lister, GlobalOpts: global}
+			},
+			wantedErr: "select public subnets: --import-public-subnets is required in non-interactive mode",
+		},
+		"env init importing a VPC without --import-private-subnets": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				lister := climocks.NewMockvpcLister(ctrl)
+				lister.EXPECT().ListSubnets("vpc-1").Return([]ec2.Subnet{{ID: "subnet-1"}, {ID: "subnet-2"}}, nil)
+				return &InitEnvOpts{EnvName: "test", ImportVPCID: "vpc-1", ImportPublicSubnetIDs: []string{"subnet-1"}, vpcLister: lister, GlobalOpts: global}
+			},
+			wantedErr: "select private subnets: --import-private-subnets is required in non-interactive mode",
+		},
+		"env ls without --project": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				return &ListEnvOpts{GlobalOpts: &GlobalOpts{prompt: global.prompt, noPrompt: true}}
+			},
+			wantedErr: "failed to get project name: --project is required in non-interactive mode",
+		},
+		"env show without name": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				store := mocks.NewMockEnvironmentStore(ctrl)
+				store.EXPECT().ListEnvironments("phonetool").Return(envs, nil)
+				return &ShowEnvOpts{envStore: store, GlobalOpts: global}
+			},
+			wantedErr: "failed to select environment: the name argument is required in non-interactive mode",
+		},
+		"env upgrade without name": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				store := mocks.NewMockEnvironmentStore(ctrl)
+				store.EXPECT().ListEnvironments("phonetool").Return(envs, nil)
+				return &UpgradeEnvOpts{envStore: store, GlobalOpts: global}
+			},
+			wantedErr: "failed to select environment: the name argument is required in non-interactive mode",
+		},
+		"pipeline delete without --name": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				lister := climocks.NewMockpipelineLister(ctrl)
+				lister.EXPECT().ListPipelineStacks("phonetool").Return([]string{"phonetool-pipeline-frontend", "phonetool-pipeline-backend"}, nil)
+				return &DeletePipelineOpts{pipelineLister: lister, GlobalOpts: global}
+			},
+			wantedErr: "failed to select pipeline: --name is required in non-interactive mode",
+		},
+		"pipeline init without --environments": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				return &InitPipelineOpts{projectEnvs: []string{"test", "prod"}, GlobalOpts: global}
+			},
+			wantedErr: "failed to confirm adding an environment: --environments is required in non-interactive mode",
+		},
+		"pipeline init without --github-repo": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				return &InitPipelineOpts{Environments: []string{"test"}, GlobalOpts: global}
+			},
+			wantedErr: "failed to get GitHub repository: --github-repo is required in non-interactive mode",
+		},
+		"pipeline init without --github-access-token": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				return &InitPipelineOpts{Environments: []string{"test"}, GitHubRepo: "badgoose/frontend", GlobalOpts: global}
+			},
+			wantedErr: "failed to get GitHub access token: --github-access-token is required in non-interactive mode",
+		},
+		"pipeline show without --name": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				lister := climocks.NewMockpipelineLister(ctrl)
+				lister.EXPECT().ListPipelineStacks("phonetool").Return([]string{"phonetool-pipeline-frontend", "phonetool-pipeline-backend"}, nil)
+				return &ShowPipelineOpts{pipelineLister: lister, GlobalOpts: global}
+			},
+			wantedErr: "failed to select pipeline: --name is required in non-interactive mode",
+		},
+		"pipeline status without --name": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				lister := climocks.NewMockpipelineLister(ctrl)
+				lister.EXPECT().ListPipelineStacks("phonetool").Return([]string{"phonetool-pipeline-frontend", "phonetool-pipeline-backend"}, nil)
+				return &PipelineStatusOpts{pipelineLister: lister, GlobalOpts: global}
+			},
+			wantedErr: "failed to select pipeline: --name is required in non-interactive mode",
+		},
+		"project init without name and existing projects": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				ws := mocks.NewMockWorkspace(ctrl)
+				ws.EXPECT().Summary().Return(nil, errors.New("no existing workspace"))
+				store := mocks.NewMockProjectStore(ctrl)
+				store.EXPECT().ListProjects().Return([]*archer.Project{{Name: "phonetool"}}, nil)
+				return &InitProjectOpts{ws: ws, projectStore: store, prompt: global.prompt, noPrompt: true}
+			},
+			wantedErr: "prompt to confirm using existing project: the name argument is required in non-interactive mode",
+		},
+		"project init without name": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				ws := mocks.NewMockWorkspace(ctrl)
+				ws.EXPECT().Summary().Return(nil, errors.New("no existing workspace"))
+				store := mocks.NewMockProjectStore(ctrl)
+				store.EXPECT().ListProjects().Return(nil, nil)
+				return &InitProjectOpts{ws: ws, projectStore: store, prompt: global.prompt, noPrompt: true}
+			},
+			wantedErr: "prompt get project name: the name argument is required in non-interactive mode",
+		},
+		"secret delete without --name": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				return &DeleteSecretOpts{GlobalOpts: global}
+			},
+			wantedErr: "prompt for secret name: --name is required in non-interactive mode",
+		},
+		"secret init without --name": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				return &InitSecretOpts{GlobalOpts: global}
+			},
+			wantedErr: "prompt for secret name: --name is required in non-interactive mode",
+		},
+		"secret init without --values": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				store := mocks.NewMockEnvironmentStore(ctrl)
+				store.EXPECT().ListEnvironments("phonetool").Return(envs, nil)
+				return &InitSecretOpts{Name: "db-password", envStore: store, GlobalOpts: global}
+			},
+			wantedErr: "prompt for value of secret db-password in environment test: --values is required in non-interactive mode",
+		},
+		"storage init without --app": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				ws := mocks.NewMockWorkspace(ctrl)
+				ws.EXPECT().AppNames().Return([]string{"frontend", "backend"}, nil)
+				return &InitStorageOpts{ws: ws, GlobalOpts: global}
+			},
+			wantedErr: "failed to select application: --app is required in non-interactive mode",
+		},
+		"storage init without --storage-type": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				return &InitStorageOpts{AppName: "frontend", GlobalOpts: global}
+			},
+			wantedErr: "failed to get storage type: --storage-type is required in non-interactive mode",
+		},
+		"storage init without --name": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				return &InitStorageOpts{AppName: "frontend", StorageType: addons.S3StorageType, GlobalOpts: global}
+			},
+			wantedErr: "failed to get storage name: --name is required in non-interactive mode",
+		},
+		"storage init without --partition-key": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				return &InitStorageOpts{AppName: "frontend", StorageType: addons.DynamoDBStorageType, StorageName: "users", GlobalOpts: global}
+			},
+			wantedErr: "failed to get partition key: --partition-key is required in non-interactive mode",
+		},
+		"storage init without --sort-key creates a table without sort key": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				return &InitStorageOpts{AppName: "frontend", StorageType: addons.DynamoDBStorageType, StorageName: "users", PartitionKey: "id:S", GlobalOpts: global}
+			},
+		},
+		"storage init without --engine": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				return &InitStorageOpts{AppName: "frontend", StorageType: addons.AuroraStorageType, StorageName: "db", GlobalOpts: global}
+			},
+			wantedErr: "failed to get database engine: --engine is required in non-interactive mode",
+		},
+		"storage init without --initial-db": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				return &InitStorageOpts{AppName: "frontend", StorageType: addons.AuroraStorageType, StorageName: "db", Engine: addons.AuroraEngines[0], GlobalOpts: global}
+			},
+			wantedErr: "failed to get initial database name: --initial-db is required in non-interactive mode",
+		},
+		"task run without --app": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				lister := mocks.NewMockApplicationLister(ctrl)
+				lister.EXPECT().ListApplications("phonetool").Return(apps, nil)
+				return &RunTaskOpts{appLister: lister, GlobalOpts: global}
+			},
+			wantedErr: "failed to select application: --app is required in non-interactive mode",
+		},
+		"task run without --env": {
+			setupOpts: func(ctrl *gomock.Controller, global *GlobalOpts) interface{ Ask() error } {
+				store := mocks.NewMockEnvironmentStore(ctrl)
+				store.EXPECT().ListEnvironments("phonetool").Return(envs, nil)
+				return &RunTaskOpts{AppName: "frontend", envStore: store, GlobalOpts: global}
+			},
+			wantedErr: "failed to select environment: --env is required in non-interactive mode",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			global := &GlobalOpts{
+				projectName: "phonetool",
+				prompt:      climocks.NewMockprompter(ctrl),
+				noPrompt:    true,
+			}
+			opts := tc.setupOpts(ctrl, global)
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			var errMissingFlag *ErrMissingFlag
+			var errMissingArg *ErrMissingArg
+			require.True(t, errors.As(err, &errMissingFlag) || errors.As(err, &errMissingArg), "the error should name the missing input")
+			require.EqualError(t, err, tc.wantedErr)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/aws/amazon-ecs-cli-v2/cmd/archer/template"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/group"
+)
+
+// BuildSecretCmd is the top level command for secrets.
+func BuildSecretCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "secret",
+		Short: "Secret commands.",
+		Long: `Command for working with secrets.
+A secret is stored encrypted in SSM Parameter Store in each environment, and applications refer to it by name in the "secrets" of their manifest.`,
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			bindProjectName()
+		},
+	}
+	// The flags bound by viper are available to all sub-commands through viper.GetString({flagName})
+	cmd.PersistentFlags().StringP(projectFlag, projectFlagShort, "" /* default */, projectFlagDescription)
+	viper.BindPFlag(projectFlag, cmd.PersistentFlags().Lookup(projectFlag))
+	markFlagCompletion(cmd.PersistentFlags(), projectFlag, completeProjects)
+
+	cmd.AddCommand(BuildSecretInitCmd())
+	cmd.AddCommand(BuildSecretListCmd())
+	cmd.AddCommand(BuildSecretDeleteCmd())
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Develop,
+	}
+	return cmd
+}
+
+// secretEnvs returns the environment if its name is passed in, or every environment of the project otherwise.
+func secretEnvs(envStore archer.EnvironmentStore, projectName, envName string) ([]*archer.Environment, error) {
+	if envName != "" {
+		env, err := envStore.GetEnvironment(projectName, envName)
+		if err != nil {
+			return nil, fmt.Errorf("get environment %s: %w", envName, err)
+		}
+		return []*archer.Environment{env}, nil
+	}
+	envs, err := envStore.ListEnvironments(projectName)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in project %s: %w", projectName, err)
+	}
+	return envs, nil
+}
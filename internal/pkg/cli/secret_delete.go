@@ -0,0 +1,174 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/secrets"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	termprogress "github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/progress"
+	"github.com/spf13/cobra"
+)
+
+const (
+	fmtDeleteSecretPrompt     = "Are you sure you want to delete secret %s from %s?"
+	deleteSecretHelp          = "Applications referring to the secret in their manifest will fail to start new tasks."
+	fmtDeleteEnvSecretStart   = "Deleting secret %s from environment %s."
+	fmtDeleteEnvSecretFailed  = "Failed to delete secret %s from environment %s."
+	fmtDeleteEnvSecretDone    = "Deleted secret %s from environment %s."
+	fmtDeleteEnvSecretMissing = "Secret %s doesn't exist in environment %s."
+)
+
+type secretParamDeleter interface {
+	DeleteSecret(name string) error
+}
+
+// DeleteSecretOpts holds the configuration needed to delete a secret from environments.
+type DeleteSecretOpts struct {
+	// Fields with matching flags.
+	Name             string
+	EnvName          string
+	SkipConfirmation bool
+
+	// Interfaces to interact with dependencies.
+	envStore archer.EnvironmentStore
+	deleters map[string]secretParamDeleter // Keyed by environment name.
+	prog     progress
+
+	*GlobalOpts
+}
+
+// Ask prompts for the name of the secret if it's not passed in.
+func (opts *DeleteSecretOpts) Ask() error {
+	if opts.Name != "" {
+		return nil
+	}
+	name, err := opts.promptFor(nameFlag).Get("Which secret would you like to delete?", deleteSecretHelp, validateSecretName)
+	if err != nil {
+		return fmt.Errorf("prompt for secret name: %w", err)
+	}
+	opts.Name = name
+	return nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (opts *DeleteSecretOpts) Validate() error {
+	if opts.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	if opts.Name != "" {
+		return validateSecretName(opts.Name)
+	}
+	return nil
+}
+
+// Execute deletes the secret from the environment, or from every environment of the project it exists in.
+func (opts *DeleteSecretOpts) Execute() error {
+	shouldDelete, err := opts.shouldDelete()
+	if err != nil {
+		return err
+	}
+	if !shouldDelete {
+		return nil
+	}
+	envs, err := secretEnvs(opts.envStore, opts.ProjectName(), opts.EnvName)
+	if err != nil {
+		return err
+	}
+	for _, env := range envs {
+		deleter, err := opts.deleter(env)
+		if err != nil {
+			return err
+		}
+		opts.prog.Start(fmt.Sprintf(fmtDeleteEnvSecretStart, opts.Name, env.Name))
+		err = deleter.DeleteSecret(manifest.SecretParameterName(opts.ProjectName(), env.Name, opts.Name))
+		var errNotFound *secrets.ErrSecretNotFound
+		if errors.As(err, &errNotFound) && opts.EnvName == "" {
+			// The secret doesn't have to exist in every environment.
+			opts.prog.Stop(fmt.Sprintf(fmtDeleteEnvSecretMissing, opts.Name, env.Name))
+			continue
+		}
+		if err != nil {
+			opts.prog.Stop(log.Serrorf(fmtDeleteEnvSecretFailed, opts.Name, env.Name))
+			return fmt.Errorf("delete secret %s from environment %s: %w", opts.Name, env.Name, err)
+		}
+		opts.prog.Stop(log.Ssuccessf(fmtDeleteEnvSecretDone, opts.Name, env.Name))
+	}
+	return nil
+}
+
+func (opts *DeleteSecretOpts) shouldDelete() (bool, error) {
+	if opts.SkipConfirmation {
+		return true, nil
+	}
+	target := "every environment"
+	if opts.EnvName != "" {
+		target = fmt.Sprintf("environment %s", opts.EnvName)
+	}
+	shouldDelete, err := opts.promptFor(yesFlag).Confirm(fmt.Sprintf(fmtDeleteSecretPrompt, opts.Name, target), deleteSecretHelp)
+	if err != nil {
+		return false, fmt.Errorf("prompt for secret deletion: %w", err)
+	}
+	return shouldDelete, nil
+}
+
+func (opts *DeleteSecretOpts) deleter(env *archer.Environment) (secretParamDeleter, error) {
+	if opts.deleters == nil {
+		opts.deleters = make(map[string]secretParamDeleter)
+	}
+	if deleter, ok := opts.deleters[env.Name]; ok {
+		// Tests mock the client.
+		return deleter, nil
+	}
+	sess, err := opts.sessionProvider().FromEnvRole(env.Name, env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create session from role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	deleter := secrets.New(sess)
+	opts.deleters[env.Name] = deleter
+	return deleter, nil
+}
+
+// BuildSecretDeleteCmd builds the command for deleting a secret from environments.
+func BuildSecretDeleteCmd() *cobra.Command {
+	opts := &DeleteSecretOpts{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Deletes a secret from your environments.",
+		Long:  `Deletes the SSM parameter of a secret created with "secret init" from an environment, or from every environment of your project.`,
+		Example: `
+  Delete the "DB_PASSWORD" secret from every environment without prompting.
+  /code $ archer secret delete --name DB_PASSWORD --yes
+
+  Delete the "DB_PASSWORD" secret from the "test" environment.
+  /code $ archer secret delete --name DB_PASSWORD --env test`,
+		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			store, err := store.New()
+			if err != nil {
+				return fmt.Errorf("connect to ecs-cli metadata store: %w", err)
+			}
+			opts.envStore = store
+			opts.prog = termprogress.NewSpinner()
+			return opts.Validate()
+		}),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&opts.Name, nameFlag, nameFlagShort, "", secretNameFlagDescription)
+	cmd.Flags().StringVarP(&opts.EnvName, envFlag, envFlagShort, "", secretEnvFlagDescription)
+	markFlagCompletion(cmd.Flags(), envFlag, completeEnvs)
+	cmd.Flags().BoolVar(&opts.SkipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
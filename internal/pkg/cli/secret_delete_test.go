@@ -0,0 +1,104 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/secrets"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type deleteSecretMocks struct {
+	envStore *mocks.MockEnvironmentStore
+	prompt   *climocks.Mockprompter
+	test     *climocks.MocksecretParamDeleter
+	prod     *climocks.MocksecretParamDeleter
+	prog     *climocks.Mockprogress
+}
+
+func TestDeleteSecretOpts_Execute(t *testing.T) {
+	notFound := &secrets.ErrSecretNotFound{Name: "/archer/phonetool/test/secrets/DB_PASSWORD"}
+
+	testCases := map[string]struct {
+		inEnvName          string
+		inSkipConfirmation bool
+
+		setupMocks func(m deleteSecretMocks)
+
+		wantedErr error
+	}{
+		"does nothing if the deletion is not confirmed": {
+			setupMocks: func(m deleteSecretMocks) {
+				m.prompt.EXPECT().Confirm("Are you sure you want to delete secret DB_PASSWORD from every environment?", deleteSecretHelp).Return(false, nil)
+				m.envStore.EXPECT().ListEnvironments(gomock.Any()).Times(0)
+			},
+		},
+		"deletes the secret from the environments it exists in": {
+			inSkipConfirmation: true,
+			setupMocks: func(m deleteSecretMocks) {
+				m.envStore.EXPECT().ListEnvironments("phonetool").Return([]*archer.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+				m.prog.EXPECT().Start(gomock.Any()).Times(2)
+				m.test.EXPECT().DeleteSecret("/archer/phonetool/test/secrets/DB_PASSWORD").Return(notFound)
+				m.prod.EXPECT().DeleteSecret("/archer/phonetool/prod/secrets/DB_PASSWORD").Return(nil)
+				m.prog.EXPECT().Stop(gomock.Any()).Times(2)
+			},
+		},
+		"returns error if the secret doesn't exist in the environment": {
+			inEnvName: "test",
+			setupMocks: func(m deleteSecretMocks) {
+				m.prompt.EXPECT().Confirm("Are you sure you want to delete secret DB_PASSWORD from environment test?", deleteSecretHelp).Return(true, nil)
+				m.envStore.EXPECT().GetEnvironment("phonetool", "test").Return(&archer.Environment{Name: "test"}, nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.test.EXPECT().DeleteSecret("/archer/phonetool/test/secrets/DB_PASSWORD").Return(notFound)
+				m.prog.EXPECT().Stop(gomock.Any())
+			},
+			wantedErr: errors.New("delete secret DB_PASSWORD from environment test: couldn't find secret /archer/phonetool/test/secrets/DB_PASSWORD"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := deleteSecretMocks{
+				envStore: mocks.NewMockEnvironmentStore(ctrl),
+				prompt:   climocks.NewMockprompter(ctrl),
+				test:     climocks.NewMocksecretParamDeleter(ctrl),
+				prod:     climocks.NewMocksecretParamDeleter(ctrl),
+				prog:     climocks.NewMockprogress(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &DeleteSecretOpts{
+				Name:             "DB_PASSWORD",
+				EnvName:          tc.inEnvName,
+				SkipConfirmation: tc.inSkipConfirmation,
+				envStore:         m.envStore,
+				deleters: map[string]secretParamDeleter{
+					"test": m.test,
+					"prod": m.prod,
+				},
+				prog:       m.prog,
+				GlobalOpts: &GlobalOpts{projectName: "phonetool", prompt: m.prompt},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/secrets"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	termprogress "github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/progress"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretInitNamePrompt     = "What would you like to name this secret?"
+	secretInitNameHelp       = "The name of the environment variable holding the secret is a good choice, e.g. DB_PASSWORD."
+	fmtSecretInitValuePrompt = "What is the value of secret %s in environment %s?"
+	secretInitValueHelp      = "The value is stored encrypted in SSM Parameter Store. Leave it empty to skip the environment."
+)
+
+const (
+	fmtPutSecretStart    = "Writing secret %s to environment %s."
+	fmtPutSecretFailed   = "Failed to write secret %s to environment %s."
+	fmtPutSecretComplete = "Wrote secret %s to environment %s."
+)
+
+type secretPutter interface {
+	PutSecret(name, value string, tags map[string]string) error
+}
+
+// InitSecretOpts holds the fields needed to create or update a secret in environments.
+type InitSecretOpts struct {
+	// Fields with matching flags.
+	Name   string
+	Values map[string]string // Keyed by environment name.
+
+	// Interfaces for dependencies.
+	envStore archer.EnvironmentStore
+	putters  map[string]secretPutter // Keyed by environment name.
+	prog     progress
+
+	*GlobalOpts
+}
+
+// Ask prompts for the name of the secret and its value in each environment if they're not passed in.
+func (opts *InitSecretOpts) Ask() error {
+	if opts.Name == "" {
+		name, err := opts.promptFor(nameFlag).Get(secretInitNamePrompt, secretInitNameHelp, validateSecretName)
+		if err != nil {
+			return fmt.Errorf("prompt for secret name: %w", err)
+		}
+		opts.Name = name
+	}
+	if len(opts.Values) != 0 {
+		return nil
+	}
+	envs, err := opts.envStore.ListEnvironments(opts.ProjectName())
+	if err != nil {
+		return fmt.Errorf("list environments in project %s: %w", opts.ProjectName(), err)
+	}
+	if len(envs) == 0 {
+		return fmt.Errorf("no environments found in project %s, please run `env init` first", opts.ProjectName())
+	}
+	values := make(map[string]string)
+	for _, env := range envs {
+		value, err := opts.promptFor(valuesFlag).GetSecret(fmt.Sprintf(fmtSecretInitValuePrompt, color.HighlightUserInput(opts.Name), color.HighlightUserInput(env.Name)), secretInitValueHelp)
+		if err != nil {
+			return fmt.Errorf("prompt for value of secret %s in environment %s: %w", opts.Name, env.Name, err)
+		}
+		if value == "" {
+			continue
+		}
+		values[env.Name] = value
+	}
+	if len(values) == 0 {
+		return fmt.Errorf("no value provided for secret %s in any environment", opts.Name)
+	}
+	opts.Values = values
+	return nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (opts *InitSecretOpts) Validate() error {
+	if opts.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	if opts.Name != "" {
+		if err := validateSecretName(opts.Name); err != nil {
+			return err
+		}
+	}
+	for env, value := range opts.Values {
+		if value == "" {
+			return fmt.Errorf("value of secret in environment %s must not be empty", env)
+		}
+	}
+	return nil
+}
+
+// Execute writes the value of the secret to each environment, in the alphabetical order of the environments.
+func (opts *InitSecretOpts) Execute() error {
+	var envNames []string
+	for name := range opts.Values {
+		envNames = append(envNames, name)
+	}
+	sort.Strings(envNames)
+
+	for _, envName := range envNames {
+		env, err := opts.envStore.GetEnvironment(opts.ProjectName(), envName)
+		if err != nil {
+			return fmt.Errorf("get environment %s: %w", envName, err)
+		}
+		putter, err := opts.putter(env)
+		if err != nil {
+			return err
+		}
+		opts.prog.Start(fmt.Sprintf(fmtPutSecretStart, opts.Name, env.Name))
+		if err := putter.PutSecret(manifest.SecretParameterName(opts.ProjectName(), env.Name, opts.Name), opts.Values[envName], map[string]string{
+			stack.ProjectTagKey: opts.ProjectName(),
+			stack.EnvTagKey:     env.Name,
+		}); err != nil {
+			opts.prog.Stop(log.Serrorf(fmtPutSecretFailed, opts.Name, env.Name))
+			return fmt.Errorf("write secret %s to environment %s: %w", opts.Name, env.Name, err)
+		}
+		opts.prog.Stop(log.Ssuccessf(fmtPutSecretComplete, opts.Name, env.Name))
+	}
+	return nil
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (opts *InitSecretOpts) RecommendedActions() []string {
+	return []string{
+		fmt.Sprintf("Refer to the secret in the %s of your application's manifest: %s.", color.HighlightCode("secrets"), color.HighlightCode(fmt.Sprintf("%s: %s", opts.Name, opts.Name))),
+	}
+}
+
+func (opts *InitSecretOpts) putter(env *archer.Environment) (secretPutter, error) {
+	if opts.putters == nil {
+		opts.putters = make(map[string]secretPutter)
+	}
+	if putter, ok := opts.putters[env.Name]; ok {
+		// Tests mock the client.
+		return putter, nil
+	}
+	sess, err := opts.sessionProvider().FromEnvRole(env.Name, env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create session from role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	putter := secrets.New(sess)
+	opts.putters[env.Name] = putter
+	return putter, nil
+}
+
+// BuildSecretInitCmd builds the command for creating or updating a secret in environments.
+func BuildSecretInitCmd() *cobra.Command {
+	opts := &InitSecretOpts{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Creates or updates a secret in your environments.",
+		Long: `Creates or updates a secret in the environments of your project.
+The value of the secret in each environment is stored as a SecureString parameter in SSM Parameter Store,
+written with the environment's manager role. Applications refer to the secret by its name in the "secrets" of their manifest.`,
+		Example: `
+  Create the "DB_PASSWORD" secret in the "test" and "prod" environments.
+  /code $ archer secret init --name DB_PASSWORD --values test=testpassword,prod=prodpassword
+
+  Prompt for the value of the "DB_PASSWORD" secret in each environment.
+  /code $ archer secret init --name DB_PASSWORD`,
+		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			store, err := store.New()
+			if err != nil {
+				return fmt.Errorf("connect to ecs-cli metadata store: %w", err)
+			}
+			opts.envStore = store
+			opts.prog = termprogress.NewSpinner()
+			return opts.Validate()
+		}),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			log.Infoln("Recommended follow-up actions:")
+			for _, followup := range opts.RecommendedActions() {
+				log.Infof("- %s\n", followup)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&opts.Name, nameFlag, nameFlagShort, "", secretNameFlagDescription)
+	cmd.Flags().StringToStringVar(&opts.Values, valuesFlag, nil, secretValuesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,216 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitSecretOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName   string
+		inValues map[string]string
+
+		setupMocks func(envStore *mocks.MockEnvironmentStore, prompt *climocks.Mockprompter)
+
+		wantedName   string
+		wantedValues map[string]string
+		wantedErr    error
+	}{
+		"skips prompts if the name and values are passed in": {
+			inName:   "DB_PASSWORD",
+			inValues: map[string]string{"test": "hunter2"},
+			setupMocks: func(envStore *mocks.MockEnvironmentStore, prompt *climocks.Mockprompter) {
+				envStore.EXPECT().ListEnvironments(gomock.Any()).Times(0)
+				prompt.EXPECT().GetSecret(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedName:   "DB_PASSWORD",
+			wantedValues: map[string]string{"test": "hunter2"},
+		},
+		"prompts for the value in each environment and skips empty ones": {
+			setupMocks: func(envStore *mocks.MockEnvironmentStore, prompt *climocks.Mockprompter) {
+				prompt.EXPECT().Get(secretInitNamePrompt, secretInitNameHelp, gomock.Any()).Return("DB_PASSWORD", nil)
+				envStore.EXPECT().ListEnvironments("phonetool").Return([]*archer.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+				gomock.InOrder(
+					prompt.EXPECT().GetSecret(gomock.Any(), secretInitValueHelp).Return("hunter2", nil),
+					prompt.EXPECT().GetSecret(gomock.Any(), secretInitValueHelp).Return("", nil),
+				)
+			},
+			wantedName:   "DB_PASSWORD",
+			wantedValues: map[string]string{"test": "hunter2"},
+		},
+		"returns error if no value is provided": {
+			inName: "DB_PASSWORD",
+			setupMocks: func(envStore *mocks.MockEnvironmentStore, prompt *climocks.Mockprompter) {
+				envStore.EXPECT().ListEnvironments("phonetool").Return([]*archer.Environment{{Name: "test"}}, nil)
+				prompt.EXPECT().GetSecret(gomock.Any(), gomock.Any()).Return("", nil)
+			},
+			wantedErr: errors.New("no value provided for secret DB_PASSWORD in any environment"),
+		},
+		"returns error if there are no environments": {
+			inName: "DB_PASSWORD",
+			setupMocks: func(envStore *mocks.MockEnvironmentStore, prompt *climocks.Mockprompter) {
+				envStore.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+			},
+			wantedErr: errors.New("no environments found in project phonetool, please run `env init` first"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockEnvStore := mocks.NewMockEnvironmentStore(ctrl)
+			mockPrompt := climocks.NewMockprompter(ctrl)
+			tc.setupMocks(mockEnvStore, mockPrompt)
+
+			opts := &InitSecretOpts{
+				Name:       tc.inName,
+				Values:     tc.inValues,
+				envStore:   mockEnvStore,
+				GlobalOpts: &GlobalOpts{projectName: "phonetool", prompt: mockPrompt},
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, opts.Name)
+			require.Equal(t, tc.wantedValues, opts.Values)
+		})
+	}
+}
+
+func TestInitSecretOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inProjectName string
+		inName        string
+		inValues      map[string]string
+
+		wantedErr error
+	}{
+		"returns error if there is no project": {
+			wantedErr: errNoProjectInWorkspace,
+		},
+		"returns error if the name is invalid": {
+			inProjectName: "phonetool",
+			inName:        "db/password",
+			wantedErr:     errors.New("secret name db/password is invalid: " + errValueBadSecret.Error()),
+		},
+		"returns error if a value is empty": {
+			inProjectName: "phonetool",
+			inName:        "DB_PASSWORD",
+			inValues:      map[string]string{"test": ""},
+			wantedErr:     errors.New("value of secret in environment test must not be empty"),
+		},
+		"valid flags": {
+			inProjectName: "phonetool",
+			inName:        "DB_PASSWORD",
+			inValues:      map[string]string{"test": "hunter2"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &InitSecretOpts{
+				Name:       tc.inName,
+				Values:     tc.inValues,
+				GlobalOpts: &GlobalOpts{projectName: tc.inProjectName},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestInitSecretOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(envStore *mocks.MockEnvironmentStore, test, prod *climocks.MocksecretPutter, prog *climocks.Mockprogress)
+
+		wantedErr error
+	}{
+		"writes the secret to each environment with its tags": {
+			setupMocks: func(envStore *mocks.MockEnvironmentStore, test, prod *climocks.MocksecretPutter, prog *climocks.Mockprogress) {
+				envStore.EXPECT().GetEnvironment("phonetool", "prod").Return(&archer.Environment{Name: "prod"}, nil)
+				envStore.EXPECT().GetEnvironment("phonetool", "test").Return(&archer.Environment{Name: "test"}, nil)
+				prog.EXPECT().Start(gomock.Any()).Times(2)
+				prog.EXPECT().Stop(gomock.Any()).Times(2)
+				gomock.InOrder(
+					prod.EXPECT().PutSecret("/archer/phonetool/prod/secrets/DB_PASSWORD", "prodpassword", map[string]string{
+						"ecs-project":     "phonetool",
+						"ecs-environment": "prod",
+					}).Return(nil),
+					test.EXPECT().PutSecret("/archer/phonetool/test/secrets/DB_PASSWORD", "testpassword", map[string]string{
+						"ecs-project":     "phonetool",
+						"ecs-environment": "test",
+					}).Return(nil),
+				)
+			},
+		},
+		"wraps error from writing the secret": {
+			setupMocks: func(envStore *mocks.MockEnvironmentStore, test, prod *climocks.MocksecretPutter, prog *climocks.Mockprogress) {
+				envStore.EXPECT().GetEnvironment("phonetool", "prod").Return(&archer.Environment{Name: "prod"}, nil)
+				prog.EXPECT().Start(gomock.Any())
+				prod.EXPECT().PutSecret(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+				prog.EXPECT().Stop(gomock.Any())
+				test.EXPECT().PutSecret(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("write secret DB_PASSWORD to environment prod: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockEnvStore := mocks.NewMockEnvironmentStore(ctrl)
+			mockTest := climocks.NewMocksecretPutter(ctrl)
+			mockProd := climocks.NewMocksecretPutter(ctrl)
+			mockProg := climocks.NewMockprogress(ctrl)
+			tc.setupMocks(mockEnvStore, mockTest, mockProd, mockProg)
+
+			opts := &InitSecretOpts{
+				Name:     "DB_PASSWORD",
+				Values:   map[string]string{"test": "testpassword", "prod": "prodpassword"},
+				envStore: mockEnvStore,
+				putters: map[string]secretPutter{
+					"test": mockTest,
+					"prod": mockProd,
+				},
+				prog:       mockProg,
+				GlobalOpts: &GlobalOpts{projectName: "phonetool"},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
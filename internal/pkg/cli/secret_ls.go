@@ -0,0 +1,159 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/secrets"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/output"
+	"github.com/spf13/cobra"
+)
+
+type secretLister interface {
+	ListSecrets(path string) ([]secrets.Secret, error)
+}
+
+// envSecret is a secret of an environment, without its value.
+type envSecret struct {
+	Name         string    `json:"name"`
+	Environment  string    `json:"environment"`
+	Version      int64     `json:"version"`
+	LastModified time.Time `json:"lastModified"`
+}
+
+// ListSecretsOpts holds the configuration needed to list the secrets of environments.
+type ListSecretsOpts struct {
+	// Fields with matching flags.
+	EnvName          string
+	ShouldOutputJSON bool
+	OutputFormat     string
+
+	// Interfaces to interact with dependencies.
+	envStore archer.EnvironmentStore
+	listers  map[string]secretLister // Keyed by environment name.
+	w        io.Writer
+
+	*GlobalOpts
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (opts *ListSecretsOpts) Validate() error {
+	if opts.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	if err := output.Validate(outputFormat(opts.OutputFormat, opts.ShouldOutputJSON)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Execute writes the secrets of each environment without their values.
+func (opts *ListSecretsOpts) Execute() error {
+	envs, err := secretEnvs(opts.envStore, opts.ProjectName(), opts.EnvName)
+	if err != nil {
+		return err
+	}
+	var envSecrets []envSecret
+	for _, env := range envs {
+		lister, err := opts.lister(env)
+		if err != nil {
+			return err
+		}
+		path := manifest.SecretsPath(opts.ProjectName(), env.Name)
+		params, err := lister.ListSecrets(path)
+		if err != nil {
+			return fmt.Errorf("list secrets of environment %s: %w", env.Name, err)
+		}
+		for _, secret := range params {
+			envSecrets = append(envSecrets, envSecret{
+				Name:         strings.TrimPrefix(secret.Name, path),
+				Environment:  env.Name,
+				Version:      secret.Version,
+				LastModified: secret.LastModified,
+			})
+		}
+	}
+
+	if err := output.Write(opts.w, outputFormat(opts.OutputFormat, opts.ShouldOutputJSON), secretList{Secrets: envSecrets}); err != nil {
+		return fmt.Errorf("write secrets: %w", err)
+	}
+	return nil
+}
+
+// secretList is the output of "secret ls".
+// Its JSON and YAML schema is {"secrets": [...]} with the fields of envSecret.
+type secretList struct {
+	Secrets []envSecret `json:"secrets"`
+}
+
+// Header returns the columns of the secrets table.
+func (l secretList) Header() []string {
+	return []string{"Name", "Environment", "Version", "Last Modified"}
+}
+
+// Rows returns one row per secret in each environment.
+func (l secretList) Rows() [][]string {
+	var rows [][]string
+	for _, secret := range l.Secrets {
+		rows = append(rows, []string{secret.Name, secret.Environment, strconv.FormatInt(secret.Version, 10), secret.LastModified.Local().Format(time.RFC3339)})
+	}
+	return rows
+}
+
+func (opts *ListSecretsOpts) lister(env *archer.Environment) (secretLister, error) {
+	if opts.listers == nil {
+		opts.listers = make(map[string]secretLister)
+	}
+	if lister, ok := opts.listers[env.Name]; ok {
+		// Tests mock the client.
+		return lister, nil
+	}
+	sess, err := opts.sessionProvider().FromEnvRole(env.Name, env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create session from role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	lister := secrets.New(sess)
+	opts.listers[env.Name] = lister
+	return lister, nil
+}
+
+// BuildSecretListCmd builds the command for listing the secrets of environments.
+func BuildSecretListCmd() *cobra.Command {
+	opts := &ListSecretsOpts{
+		w:          os.Stdout,
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists the secrets of your environments.",
+		Long:  `Lists the secrets created with "secret init" in the environments of your project. Their values are not shown.`,
+		Example: `
+  Lists the secrets of the "prod" environment.
+  /code $ archer secret ls --env prod`,
+		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			store, err := store.New()
+			if err != nil {
+				return fmt.Errorf("connect to ecs-cli metadata store: %w", err)
+			}
+			opts.envStore = store
+			return opts.Validate()
+		}),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&opts.EnvName, envFlag, envFlagShort, "", secretEnvFlagDescription)
+	markFlagCompletion(cmd.Flags(), envFlag, completeEnvs)
+	addOutputFlags(cmd, &opts.OutputFormat, &opts.ShouldOutputJSON)
+	return cmd
+}
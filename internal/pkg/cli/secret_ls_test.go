@@ -0,0 +1,97 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/secrets"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListSecretsOpts_Execute(t *testing.T) {
+	lastModified := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	testCases := map[string]struct {
+		inEnvName string
+
+		setupMocks func(envStore *mocks.MockEnvironmentStore, test, prod *climocks.MocksecretLister)
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"lists the secrets of every environment": {
+			setupMocks: func(envStore *mocks.MockEnvironmentStore, test, prod *climocks.MocksecretLister) {
+				envStore.EXPECT().ListEnvironments("phonetool").Return([]*archer.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+				test.EXPECT().ListSecrets("/archer/phonetool/test/secrets/").Return([]secrets.Secret{
+					{Name: "/archer/phonetool/test/secrets/DB_PASSWORD", Version: 2, LastModified: lastModified},
+				}, nil)
+				prod.EXPECT().ListSecrets("/archer/phonetool/prod/secrets/").Return(nil, nil)
+			},
+			wantedContent: `{"secrets":[{"name":"DB_PASSWORD","environment":"test","version":2,"lastModified":"2020-01-02T03:04:05Z"}]}` + "\n",
+		},
+		"lists the secrets of the environment": {
+			inEnvName: "prod",
+			setupMocks: func(envStore *mocks.MockEnvironmentStore, test, prod *climocks.MocksecretLister) {
+				envStore.EXPECT().GetEnvironment("phonetool", "prod").Return(&archer.Environment{Name: "prod"}, nil)
+				test.EXPECT().ListSecrets(gomock.Any()).Times(0)
+				prod.EXPECT().ListSecrets("/archer/phonetool/prod/secrets/").Return([]secrets.Secret{
+					{Name: "/archer/phonetool/prod/secrets/DB_PASSWORD", Version: 1, LastModified: lastModified},
+				}, nil)
+			},
+			wantedContent: `{"secrets":[{"name":"DB_PASSWORD","environment":"prod","version":1,"lastModified":"2020-01-02T03:04:05Z"}]}` + "\n",
+		},
+		"wraps error from listing the secrets": {
+			inEnvName: "prod",
+			setupMocks: func(envStore *mocks.MockEnvironmentStore, test, prod *climocks.MocksecretLister) {
+				envStore.EXPECT().GetEnvironment("phonetool", "prod").Return(&archer.Environment{Name: "prod"}, nil)
+				prod.EXPECT().ListSecrets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list secrets of environment prod: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockEnvStore := mocks.NewMockEnvironmentStore(ctrl)
+			mockTest := climocks.NewMocksecretLister(ctrl)
+			mockProd := climocks.NewMocksecretLister(ctrl)
+			tc.setupMocks(mockEnvStore, mockTest, mockProd)
+			b := &bytes.Buffer{}
+
+			opts := &ListSecretsOpts{
+				EnvName:          tc.inEnvName,
+				ShouldOutputJSON: true,
+				envStore:         mockEnvStore,
+				listers: map[string]secretLister{
+					"test": mockTest,
+					"prod": mockProd,
+				},
+				w:          b,
+				GlobalOpts: &GlobalOpts{projectName: "phonetool"},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
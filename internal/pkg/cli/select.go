@@ -0,0 +1,84 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+)
+
+var (
+	errNoAppsInProject      = errors.New("no applications found in the project, please run `app init` first")
+	errNoPipelinesInProject = errors.New("no pipelines found in the project, please run `pipeline update` first")
+)
+
+// selectApplication returns the name of an application in the project.
+// If the project has a single application, it is selected without prompting.
+func selectApplication(p prompter, lister archer.ApplicationLister, projectName, msg, help string) (string, error) {
+	apps, err := lister.ListApplications(projectName)
+	if err != nil {
+		return "", fmt.Errorf("list applications in project %s: %w", projectName, err)
+	}
+	if len(apps) == 0 {
+		return "", errNoAppsInProject
+	}
+	if len(apps) == 1 {
+		return apps[0].Name, nil
+	}
+	var names []string
+	for _, app := range apps {
+		names = append(names, app.Name)
+	}
+	name, err := p.SelectOne(msg, help, names)
+	if err != nil {
+		return "", fmt.Errorf("failed to select application: %w", err)
+	}
+	return name, nil
+}
+
+// selectEnvironment returns the name of an environment in the project.
+// If the project has a single environment, it is selected without prompting.
+func selectEnvironment(p prompter, lister archer.EnvironmentLister, projectName, msg, help string) (string, error) {
+	envs, err := lister.ListEnvironments(projectName)
+	if err != nil {
+		return "", fmt.Errorf("list environments in project %s: %w", projectName, err)
+	}
+	if len(envs) == 0 {
+		return "", fmt.Errorf("no environments found in project %s, please run `env init` first", projectName)
+	}
+	if len(envs) == 1 {
+		return envs[0].Name, nil
+	}
+	var names []string
+	for _, env := range envs {
+		names = append(names, env.Name)
+	}
+	name, err := p.SelectOne(msg, help, names)
+	if err != nil {
+		return "", fmt.Errorf("failed to select environment: %w", err)
+	}
+	return name, nil
+}
+
+// selectPipeline returns the name of a pipeline deployed for the project.
+// If the project has a single pipeline, it is selected without prompting.
+func selectPipeline(p prompter, lister pipelineLister, projectName, msg, help string) (string, error) {
+	names, err := lister.ListPipelineStacks(projectName)
+	if err != nil {
+		return "", fmt.Errorf("list pipelines in project %s: %w", projectName, err)
+	}
+	if len(names) == 0 {
+		return "", errNoPipelinesInProject
+	}
+	if len(names) == 1 {
+		return names[0], nil
+	}
+	name, err := p.SelectOne(msg, help, names)
+	if err != nil {
+		return "", fmt.Errorf("failed to select pipeline: %w", err)
+	}
+	return name, nil
+}
@@ -0,0 +1,31 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/amazon-ecs-cli-v2/cmd/archer/template"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/group"
+	"github.com/spf13/cobra"
+)
+
+// BuildStorageCmd is the top level command for storage.
+func BuildStorageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "storage",
+		Short: "Storage commands.",
+		Long: `Command for working with storage.
+Storage resources such as DynamoDB tables, S3 buckets and Aurora Serverless clusters are
+added to an application as addons and deployed alongside the application.`,
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			bindProjectName()
+		},
+	}
+
+	cmd.AddCommand(BuildStorageInitCmd())
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Develop,
+	}
+	return cmd
+}
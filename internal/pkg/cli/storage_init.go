@@ -0,0 +1,437 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/addons"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	fmtStorageVariableName = "%s_NAME"   // Environment variable holding the name of a table or bucket.
+	fmtStorageSecretName   = "%s_SECRET" // Secret holding the credentials of a database cluster.
+	fmtSecretsManagerARN   = "arn:aws:secretsmanager:%s:%s:secret:%s"
+	addonFileExtension     = ".yml"
+	ddbKeySeparator        = ":"
+)
+
+var errNoAppsInWorkspace = errors.New("no applications found in the workspace, please run `app init` first")
+
+// storageAddon is a storage resource that can be added to an application as an addon.
+type storageAddon interface {
+	Template() (string, error)
+	ResourceName(project, env, app string) string
+}
+
+// InitStorageOpts holds the configuration needed to add a storage resource to an application.
+type InitStorageOpts struct {
+	// Fields with matching flags.
+	StorageType string
+	StorageName string
+	AppName     string
+
+	// DynamoDB table configuration.
+	PartitionKey string
+	SortKey      string
+	LSIs         []string
+
+	// S3 bucket configuration.
+	Versioning bool
+
+	// Aurora Serverless cluster configuration.
+	Engine        string
+	InitialDBName string
+
+	// Interfaces to interact with dependencies.
+	ws        archer.Workspace
+	envLister archer.EnvironmentLister
+
+	// Outputs stored on successful actions.
+	addonPath string
+
+	*GlobalOpts
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (opts *InitStorageOpts) Ask() error {
+	if opts.AppName == "" {
+		if err := opts.askAppName(); err != nil {
+			return err
+		}
+	}
+	if opts.StorageType == "" {
+		if err := opts.askStorageType(); err != nil {
+			return err
+		}
+	}
+	if opts.StorageName == "" {
+		if err := opts.askStorageName(); err != nil {
+			return err
+		}
+	}
+	switch opts.StorageType {
+	case addons.DynamoDBStorageType:
+		return opts.askDynamoDBProps()
+	case addons.AuroraStorageType:
+		return opts.askAuroraProps()
+	}
+	return nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (opts *InitStorageOpts) Validate() error {
+	if opts.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	if opts.StorageType != "" {
+		if err := validateStorageType(opts.StorageType); err != nil {
+			return err
+		}
+	}
+	if opts.StorageName != "" {
+		if err := validateStorageName(opts.StorageName); err != nil {
+			return err
+		}
+	}
+	for _, key := range append([]string{opts.PartitionKey, opts.SortKey}, opts.LSIs...) {
+		if key == "" {
+			continue
+		}
+		if err := validateDDBKey(key); err != nil {
+			return err
+		}
+	}
+	if len(opts.LSIs) > 0 && opts.SortKey == "" {
+		return errors.New("local secondary indexes require the table to have a sort key")
+	}
+	if opts.Engine != "" {
+		if err := validateAuroraEngine(opts.Engine); err != nil {
+			return err
+		}
+	}
+	if opts.InitialDBName != "" {
+		if err := validateDBName(opts.InitialDBName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Execute writes the addon template of the storage resource and adds its name to the application's manifest
+// for every environment in the project.
+func (opts *InitStorageOpts) Execute() error {
+	envs, err := opts.envLister.ListEnvironments(opts.ProjectName())
+	if err != nil {
+		return fmt.Errorf("list environments in project %s: %w", opts.ProjectName(), err)
+	}
+
+	storage := opts.storage()
+	tpl, err := storage.Template()
+	if err != nil {
+		return fmt.Errorf("generate addon template for %s: %w", opts.StorageName, err)
+	}
+	addonPath, err := opts.ws.WriteFile([]byte(tpl), filepath.Join(opts.AppName, workspace.AddonsDirectoryName, opts.StorageName+addonFileExtension))
+	if err != nil {
+		return fmt.Errorf("write addon for %s: %w", opts.StorageName, err)
+	}
+	opts.addonPath = addonPath
+	log.Successf("Wrote the addon template for %s at '%s'\n", color.HighlightUserInput(opts.StorageName), color.HighlightResource(opts.addonPath))
+
+	if len(envs) == 0 {
+		log.Warningf("Couldn't find any environments in project %s, the manifest of %s won't reference %s.\n",
+			opts.ProjectName(), opts.AppName, opts.StorageName)
+		return nil
+	}
+	if err := opts.updateManifest(storage, envs); err != nil {
+		return err
+	}
+	log.Successf("Updated the manifest of %s app to reference %s in every environment\n",
+		color.HighlightUserInput(opts.AppName), color.HighlightUserInput(opts.StorageName))
+	return nil
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (opts *InitStorageOpts) RecommendedActions() []string {
+	return []string{
+		fmt.Sprintf("Update the addon template %s to change the defaults.", color.HighlightResource(opts.addonPath)),
+		fmt.Sprintf("Run %s to create %s with your application.",
+			color.HighlightCode(fmt.Sprintf("archer app deploy --name %s", opts.AppName)), opts.StorageName),
+	}
+}
+
+// updateManifest adds the name of the storage resource in each environment to the application's manifest.
+func (opts *InitStorageOpts) updateManifest(storage storageAddon, envs []*archer.Environment) error {
+	filename := opts.ws.AppManifestFileName(opts.AppName)
+	mft, err := opts.ws.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("read manifest for app %s: %w", opts.AppName, err)
+	}
+	for _, env := range envs {
+		name := storage.ResourceName(opts.ProjectName(), env.Name, opts.AppName)
+		var variables, secrets map[string]string
+		if opts.StorageType == addons.AuroraStorageType {
+			secrets = map[string]string{
+				fmt.Sprintf(fmtStorageSecretName, strings.ToUpper(opts.StorageName)): fmt.Sprintf(fmtSecretsManagerARN, env.Region, env.AccountID, name),
+			}
+		} else {
+			variables = map[string]string{
+				fmt.Sprintf(fmtStorageVariableName, strings.ToUpper(opts.StorageName)): name,
+			}
+		}
+		mft, err = manifest.AddEnvOverrides(mft, env.Name, variables, secrets)
+		if err != nil {
+			return fmt.Errorf("add %s to the manifest of app %s: %w", opts.StorageName, opts.AppName, err)
+		}
+	}
+	if _, err := opts.ws.WriteFile(mft, filename); err != nil {
+		return fmt.Errorf("write manifest for app %s: %w", opts.AppName, err)
+	}
+	return nil
+}
+
+func (opts *InitStorageOpts) storage() storageAddon {
+	switch opts.StorageType {
+	case addons.DynamoDBStorageType:
+		props := &addons.DynamoDBProps{
+			Name:         opts.StorageName,
+			PartitionKey: toDDBAttribute(opts.PartitionKey),
+		}
+		if opts.SortKey != "" {
+			sortKey := toDDBAttribute(opts.SortKey)
+			props.SortKey = &sortKey
+		}
+		for _, lsi := range opts.LSIs {
+			props.LSIs = append(props.LSIs, toDDBAttribute(lsi))
+		}
+		return addons.NewDynamoDBTable(props)
+	case addons.AuroraStorageType:
+		return addons.NewAuroraCluster(&addons.AuroraProps{
+			Name:          opts.StorageName,
+			Engine:        opts.Engine,
+			InitialDBName: opts.InitialDBName,
+		})
+	default:
+		return addons.NewS3Bucket(&addons.S3Props{
+			Name:       opts.StorageName,
+			Versioning: opts.Versioning,
+		})
+	}
+}
+
+func (opts *InitStorageOpts) askAppName() error {
+	apps, err := opts.ws.AppNames()
+	if err != nil {
+		return fmt.Errorf("list applications in the workspace: %w", err)
+	}
+	if len(apps) == 0 {
+		return errNoAppsInWorkspace
+	}
+	if len(apps) == 1 {
+		opts.AppName = apps[0]
+		return nil
+	}
+	app, err := opts.promptFor(appFlag).SelectOne(
+		"Which application would you like to add storage to?",
+		"The storage resource is created and deleted along with the application.",
+		apps)
+	if err != nil {
+		return fmt.Errorf("failed to select application: %w", err)
+	}
+	opts.AppName = app
+	return nil
+}
+
+func (opts *InitStorageOpts) askStorageType() error {
+	storageType, err := opts.promptFor(storageTypeFlag).SelectOne(
+		fmt.Sprintf("What type of storage would you like to add to %s?", opts.AppName),
+		"The type of AWS resource that your application stores data in.",
+		addons.StorageTypes)
+	if err != nil {
+		return fmt.Errorf("failed to get storage type: %w", err)
+	}
+	opts.StorageType = storageType
+	return nil
+}
+
+func (opts *InitStorageOpts) askStorageName() error {
+	name, err := opts.promptFor(nameFlag).Get(
+		fmt.Sprintf("What would you like to call this %s?", opts.StorageType),
+		`The name is the logical ID of the resource in the addon template.
+The resource is named after your project, environment and application followed by this name.`,
+		validateStorageName)
+	if err != nil {
+		return fmt.Errorf("failed to get storage name: %w", err)
+	}
+	opts.StorageName = name
+	return nil
+}
+
+func (opts *InitStorageOpts) askDynamoDBProps() error {
+	if opts.PartitionKey == "" {
+		key, err := opts.askDDBKey(partitionKeyFlag, "partition key", "The partition key determines the partition in which an item is stored.")
+		if err != nil {
+			return err
+		}
+		opts.PartitionKey = key
+	}
+	if opts.SortKey != "" || len(opts.LSIs) > 0 || !opts.interactive() {
+		// Without flags, non-interactive commands create a table without sort key.
+		return nil
+	}
+	addSortKey, err := opts.prompt.Confirm(
+		fmt.Sprintf("Would you like to add a sort key to %s?", opts.StorageName),
+		"A sort key stores items with the same partition key sorted by the sort key value.")
+	if err != nil {
+		return fmt.Errorf("failed to confirm sort key: %w", err)
+	}
+	if !addSortKey {
+		return nil
+	}
+	sortKey, err := opts.askDDBKey(sortKeyFlag, "sort key", "Items with the same partition key are sorted by the sort key value.")
+	if err != nil {
+		return err
+	}
+	opts.SortKey = sortKey
+	for {
+		addLSI, err := opts.prompt.Confirm(
+			fmt.Sprintf("Would you like to add a local secondary index to %s?", opts.StorageName),
+			"A local secondary index has the same partition key as the table but an alternate sort key.")
+		if err != nil {
+			return fmt.Errorf("failed to confirm local secondary index: %w", err)
+		}
+		if !addLSI {
+			return nil
+		}
+		lsi, err := opts.askDDBKey(lsiFlag, "sort key of the index", "The index is named after its sort key.")
+		if err != nil {
+			return err
+		}
+		opts.LSIs = append(opts.LSIs, lsi)
+	}
+}
+
+// askDDBKey prompts for the name and data type of a DynamoDB key and returns them in the "name:type" format.
+func (opts *InitStorageOpts) askDDBKey(flag, keyDesc, help string) (string, error) {
+	name, err := opts.promptFor(flag).Get(
+		fmt.Sprintf("What is the name of the %s?", keyDesc),
+		help,
+		func(val interface{}) error {
+			return validateDDBKey(fmt.Sprintf("%v%s%s", val, ddbKeySeparator, addons.DDBStringType))
+		})
+	if err != nil {
+		return "", fmt.Errorf("failed to get %s: %w", keyDesc, err)
+	}
+	dataType, err := opts.promptFor(flag).SelectOne(
+		fmt.Sprintf("What is the data type of %s?", name),
+		"S is a string, N is a number and B is binary data.",
+		addons.DDBAttributeTypes)
+	if err != nil {
+		return "", fmt.Errorf("failed to get data type of %s: %w", keyDesc, err)
+	}
+	return name + ddbKeySeparator + dataType, nil
+}
+
+func (opts *InitStorageOpts) askAuroraProps() error {
+	if opts.Engine == "" {
+		engine, err := opts.promptFor(engineFlag).SelectOne(
+			"Which database engine would you like to use?",
+			"The database engine of the Aurora Serverless cluster.",
+			addons.AuroraEngines)
+		if err != nil {
+			return fmt.Errorf("failed to get database engine: %w", err)
+		}
+		opts.Engine = engine
+	}
+	if opts.InitialDBName == "" {
+		name, err := opts.promptFor(initialDBFlag).Get(
+			"What would you like to name the initial database?",
+			"The database is created when the cluster is created.",
+			validateDBName)
+		if err != nil {
+			return fmt.Errorf("failed to get initial database name: %w", err)
+		}
+		opts.InitialDBName = name
+	}
+	return nil
+}
+
+// toDDBAttribute converts a key in the "name:type" format to a DynamoDB attribute.
+func toDDBAttribute(key string) addons.DDBAttribute {
+	i := strings.LastIndex(key, ddbKeySeparator)
+	return addons.DDBAttribute{
+		Name:     key[:i],
+		DataType: key[i+1:],
+	}
+}
+
+// BuildStorageInitCmd builds the command for adding a storage resource to an application.
+func BuildStorageInitCmd() *cobra.Command {
+	opts := &InitStorageOpts{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Adds a storage resource to an application.",
+		Long: `Adds a DynamoDB table, an S3 bucket or an Aurora Serverless cluster to an application.
+The resource is written as an addon template under the application's addons directory
+and its name is added to the application's manifest.`,
+		Example: `
+  Add a DynamoDB table named "Users" to the "frontend" application.
+  /code $ archer storage init --app frontend --storage-type DynamoDB --name Users --partition-key id:S
+  Add a versioned S3 bucket named "Assets" to the "frontend" application.
+  /code $ archer storage init --app frontend --storage-type S3 --name Assets --versioning`,
+		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			ws, err := workspace.New()
+			if err != nil {
+				return fmt.Errorf("workspace cannot be created: %w", err)
+			}
+			opts.ws = ws
+
+			store, err := store.New()
+			if err != nil {
+				return fmt.Errorf("couldn't connect to project datastore: %w", err)
+			}
+			opts.envLister = store
+			return opts.Validate()
+		}),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+		PostRunE: func(cmd *cobra.Command, args []string) error {
+			log.Infoln("Recommended follow-up actions:")
+			for _, followup := range opts.RecommendedActions() {
+				log.Infof("- %s\n", followup)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&opts.AppName, appFlag, appFlagShort, "", appFlagDescription)
+	markFlagCompletion(cmd.Flags(), appFlag, completeApps)
+	cmd.Flags().StringVarP(&opts.StorageType, storageTypeFlag, storageTypeFlagShort, "", storageTypeFlagDescription)
+	cmd.Flags().StringVarP(&opts.StorageName, nameFlag, nameFlagShort, "", storageNameFlagDescription)
+	cmd.Flags().StringVar(&opts.PartitionKey, partitionKeyFlag, "", partitionKeyFlagDescription)
+	cmd.Flags().StringVar(&opts.SortKey, sortKeyFlag, "", sortKeyFlagDescription)
+	cmd.Flags().StringSliceVar(&opts.LSIs, lsiFlag, nil, lsiFlagDescription)
+	cmd.Flags().BoolVar(&opts.Versioning, versioningFlag, false, versioningFlagDescription)
+	cmd.Flags().StringVar(&opts.Engine, engineFlag, "", engineFlagDescription)
+	cmd.Flags().StringVar(&opts.InitialDBName, initialDBFlag, "", initialDBFlagDescription)
+	return cmd
+}
@@ -0,0 +1,366 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/addons"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitStorageOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName     string
+		inStorageType string
+		inStorageName string
+		inSortKey     string
+
+		mockWorkspace func(m *mocks.MockWorkspace)
+		mockPrompt    func(m *climocks.Mockprompter)
+
+		wantedOpts InitStorageOpts
+		wantedErr  error
+	}{
+		"returns an error if there are no apps in the workspace": {
+			mockWorkspace: func(m *mocks.MockWorkspace) {
+				m.EXPECT().AppNames().Return(nil, nil)
+			},
+			mockPrompt: func(m *climocks.Mockprompter) {},
+			wantedErr:  errNoAppsInWorkspace,
+		},
+		"selects the only app in the workspace and prompts for an S3 bucket": {
+			mockWorkspace: func(m *mocks.MockWorkspace) {
+				m.EXPECT().AppNames().Return([]string{"frontend"}, nil)
+			},
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().SelectOne("What type of storage would you like to add to frontend?", gomock.Any(), addons.StorageTypes).
+					Return(addons.S3StorageType, nil)
+				m.EXPECT().Get("What would you like to call this S3?", gomock.Any(), gomock.Any()).Return("Assets", nil)
+			},
+			wantedOpts: InitStorageOpts{
+				AppName:     "frontend",
+				StorageType: addons.S3StorageType,
+				StorageName: "Assets",
+			},
+		},
+		"prompts for the app and the keys of a DynamoDB table": {
+			inStorageType: addons.DynamoDBStorageType,
+			inStorageName: "Users",
+			mockWorkspace: func(m *mocks.MockWorkspace) {
+				m.EXPECT().AppNames().Return([]string{"backend", "frontend"}, nil)
+			},
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().SelectOne("Which application would you like to add storage to?", gomock.Any(), []string{"backend", "frontend"}).
+					Return("frontend", nil)
+				gomock.InOrder(
+					m.EXPECT().Get("What is the name of the partition key?", gomock.Any(), gomock.Any()).Return("id", nil),
+					m.EXPECT().SelectOne("What is the data type of id?", gomock.Any(), addons.DDBAttributeTypes).Return("S", nil),
+					m.EXPECT().Confirm("Would you like to add a sort key to Users?", gomock.Any()).Return(true, nil),
+					m.EXPECT().Get("What is the name of the sort key?", gomock.Any(), gomock.Any()).Return("joined", nil),
+					m.EXPECT().SelectOne("What is the data type of joined?", gomock.Any(), addons.DDBAttributeTypes).Return("N", nil),
+					m.EXPECT().Confirm("Would you like to add a local secondary index to Users?", gomock.Any()).Return(true, nil),
+					m.EXPECT().Get("What is the name of the sort key of the index?", gomock.Any(), gomock.Any()).Return("email", nil),
+					m.EXPECT().SelectOne("What is the data type of email?", gomock.Any(), addons.DDBAttributeTypes).Return("S", nil),
+					m.EXPECT().Confirm("Would you like to add a local secondary index to Users?", gomock.Any()).Return(false, nil),
+				)
+			},
+			wantedOpts: InitStorageOpts{
+				AppName:      "frontend",
+				StorageType:  addons.DynamoDBStorageType,
+				StorageName:  "Users",
+				PartitionKey: "id:S",
+				SortKey:      "joined:N",
+				LSIs:         []string{"email:S"},
+			},
+		},
+		"does not prompt for indexes if the sort key is passed": {
+			inAppName:     "frontend",
+			inStorageType: addons.DynamoDBStorageType,
+			inStorageName: "Users",
+			inSortKey:     "joined:N",
+			mockWorkspace: func(m *mocks.MockWorkspace) {},
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().Get("What is the name of the partition key?", gomock.Any(), gomock.Any()).Return("id", nil)
+				m.EXPECT().SelectOne("What is the data type of id?", gomock.Any(), addons.DDBAttributeTypes).Return("S", nil)
+			},
+			wantedOpts: InitStorageOpts{
+				AppName:      "frontend",
+				StorageType:  addons.DynamoDBStorageType,
+				StorageName:  "Users",
+				PartitionKey: "id:S",
+				SortKey:      "joined:N",
+			},
+		},
+		"prompts for the engine and database of an Aurora cluster": {
+			inAppName:     "frontend",
+			inStorageType: addons.AuroraStorageType,
+			inStorageName: "DB",
+			mockWorkspace: func(m *mocks.MockWorkspace) {},
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().SelectOne("Which database engine would you like to use?", gomock.Any(), addons.AuroraEngines).
+					Return(addons.AuroraPostgreSQLEngine, nil)
+				m.EXPECT().Get("What would you like to name the initial database?", gomock.Any(), gomock.Any()).Return("main", nil)
+			},
+			wantedOpts: InitStorageOpts{
+				AppName:       "frontend",
+				StorageType:   addons.AuroraStorageType,
+				StorageName:   "DB",
+				Engine:        addons.AuroraPostgreSQLEngine,
+				InitialDBName: "main",
+			},
+		},
+		"wraps prompt errors": {
+			inAppName:     "frontend",
+			mockWorkspace: func(m *mocks.MockWorkspace) {},
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("failed to get storage type: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWorkspace := mocks.NewMockWorkspace(ctrl)
+			mockPrompt := climocks.NewMockprompter(ctrl)
+			tc.mockWorkspace(mockWorkspace)
+			tc.mockPrompt(mockPrompt)
+
+			opts := &InitStorageOpts{
+				AppName:     tc.inAppName,
+				StorageType: tc.inStorageType,
+				StorageName: tc.inStorageName,
+				SortKey:     tc.inSortKey,
+
+				ws: mockWorkspace,
+				GlobalOpts: &GlobalOpts{
+					prompt: mockPrompt,
+				},
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOpts.AppName, opts.AppName)
+			require.Equal(t, tc.wantedOpts.StorageType, opts.StorageType)
+			require.Equal(t, tc.wantedOpts.StorageName, opts.StorageName)
+			require.Equal(t, tc.wantedOpts.PartitionKey, opts.PartitionKey)
+			require.Equal(t, tc.wantedOpts.SortKey, opts.SortKey)
+			require.Equal(t, tc.wantedOpts.LSIs, opts.LSIs)
+			require.Equal(t, tc.wantedOpts.Engine, opts.Engine)
+			require.Equal(t, tc.wantedOpts.InitialDBName, opts.InitialDBName)
+		})
+	}
+}
+
+func TestInitStorageOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inProjectName string
+		inOpts        InitStorageOpts
+
+		wantedErr error
+	}{
+		"no project in workspace": {
+			wantedErr: errNoProjectInWorkspace,
+		},
+		"invalid storage type": {
+			inProjectName: "phonetool",
+			inOpts:        InitStorageOpts{StorageType: "Redis"},
+			wantedErr:     errors.New(`invalid storage type Redis: must be one of "DynamoDB", "S3", "Aurora Serverless"`),
+		},
+		"invalid storage name": {
+			inProjectName: "phonetool",
+			inOpts:        InitStorageOpts{StorageName: "my-table"},
+			wantedErr:     errors.New("storage name my-table is invalid: " + errValueNotAlphanum.Error()),
+		},
+		"invalid partition key": {
+			inProjectName: "phonetool",
+			inOpts:        InitStorageOpts{PartitionKey: "id"},
+			wantedErr:     errors.New("key id is invalid: " + errValueBadDDBKey.Error()),
+		},
+		"indexes without a sort key": {
+			inProjectName: "phonetool",
+			inOpts:        InitStorageOpts{PartitionKey: "id:S", LSIs: []string{"email:S"}},
+			wantedErr:     errors.New("local secondary indexes require the table to have a sort key"),
+		},
+		"invalid engine": {
+			inProjectName: "phonetool",
+			inOpts:        InitStorageOpts{Engine: "Oracle"},
+			wantedErr:     errors.New(`invalid database engine Oracle: must be one of "MySQL", "PostgreSQL"`),
+		},
+		"valid DynamoDB table": {
+			inProjectName: "phonetool",
+			inOpts: InitStorageOpts{
+				StorageType:  addons.DynamoDBStorageType,
+				StorageName:  "Users",
+				PartitionKey: "id:S",
+				SortKey:      "joined:N",
+				LSIs:         []string{"email:S"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := tc.inOpts
+			opts.GlobalOpts = &GlobalOpts{projectName: tc.inProjectName}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestInitStorageOpts_Execute(t *testing.T) {
+	const manifest = `name: frontend
+type: Load Balanced Web App
+`
+	testCases := map[string]struct {
+		inStorageType string
+		inStorageName string
+
+		mockWorkspace func(m *mocks.MockWorkspace)
+		mockEnvLister func(m *mocks.MockEnvironmentLister)
+
+		wantedErr error
+	}{
+		"wraps error if environments cannot be listed": {
+			inStorageType: addons.S3StorageType,
+			inStorageName: "Assets",
+			mockWorkspace: func(m *mocks.MockWorkspace) {},
+			mockEnvLister: func(m *mocks.MockEnvironmentLister) {
+				m.EXPECT().ListEnvironments("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list environments in project phonetool: some error"),
+		},
+		"writes only the addon if there are no environments": {
+			inStorageType: addons.S3StorageType,
+			inStorageName: "Assets",
+			mockWorkspace: func(m *mocks.MockWorkspace) {
+				m.EXPECT().WriteFile(gomock.Any(), "frontend/addons/Assets.yml").Return("ecs-project/frontend/addons/Assets.yml", nil)
+			},
+			mockEnvLister: func(m *mocks.MockEnvironmentLister) {
+				m.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+			},
+		},
+		"adds the bucket name to the manifest of each environment": {
+			inStorageType: addons.S3StorageType,
+			inStorageName: "Assets",
+			mockWorkspace: func(m *mocks.MockWorkspace) {
+				m.EXPECT().WriteFile(gomock.Any(), "frontend/addons/Assets.yml").Return("ecs-project/frontend/addons/Assets.yml", nil)
+				m.EXPECT().AppManifestFileName("frontend").Return("frontend-app.yml")
+				m.EXPECT().ReadFile("frontend-app.yml").Return([]byte(manifest), nil)
+				m.EXPECT().WriteFile([]byte(`name: frontend
+type: Load Balanced Web App
+environments:
+  test:
+    variables:
+      ASSETS_NAME: phonetool-test-frontend-assets
+  prod:
+    variables:
+      ASSETS_NAME: phonetool-prod-frontend-assets
+`), "frontend-app.yml").Return("ecs-project/frontend-app.yml", nil)
+			},
+			mockEnvLister: func(m *mocks.MockEnvironmentLister) {
+				m.EXPECT().ListEnvironments("phonetool").Return([]*archer.Environment{
+					{Name: "test", Region: "us-west-2", AccountID: "1111"},
+					{Name: "prod", Region: "us-east-1", AccountID: "2222"},
+				}, nil)
+			},
+		},
+		"adds the secret ARN of an Aurora cluster to the manifest": {
+			inStorageType: addons.AuroraStorageType,
+			inStorageName: "DB",
+			mockWorkspace: func(m *mocks.MockWorkspace) {
+				m.EXPECT().WriteFile(gomock.Any(), "frontend/addons/DB.yml").Return("ecs-project/frontend/addons/DB.yml", nil)
+				m.EXPECT().AppManifestFileName("frontend").Return("frontend-app.yml")
+				m.EXPECT().ReadFile("frontend-app.yml").Return([]byte(manifest), nil)
+				m.EXPECT().WriteFile([]byte(`name: frontend
+type: Load Balanced Web App
+environments:
+  test:
+    secrets:
+      DB_SECRET: arn:aws:secretsmanager:us-west-2:1111:secret:phonetool-test-frontend-DB
+`), "frontend-app.yml").Return("ecs-project/frontend-app.yml", nil)
+			},
+			mockEnvLister: func(m *mocks.MockEnvironmentLister) {
+				m.EXPECT().ListEnvironments("phonetool").Return([]*archer.Environment{
+					{Name: "test", Region: "us-west-2", AccountID: "1111"},
+				}, nil)
+			},
+		},
+		"wraps error if the manifest cannot be read": {
+			inStorageType: addons.S3StorageType,
+			inStorageName: "Assets",
+			mockWorkspace: func(m *mocks.MockWorkspace) {
+				m.EXPECT().WriteFile(gomock.Any(), "frontend/addons/Assets.yml").Return("ecs-project/frontend/addons/Assets.yml", nil)
+				m.EXPECT().AppManifestFileName("frontend").Return("frontend-app.yml")
+				m.EXPECT().ReadFile("frontend-app.yml").Return(nil, errors.New("some error"))
+			},
+			mockEnvLister: func(m *mocks.MockEnvironmentLister) {
+				m.EXPECT().ListEnvironments("phonetool").Return([]*archer.Environment{{Name: "test"}}, nil)
+			},
+			wantedErr: errors.New("read manifest for app frontend: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWorkspace := mocks.NewMockWorkspace(ctrl)
+			mockEnvLister := mocks.NewMockEnvironmentLister(ctrl)
+			tc.mockWorkspace(mockWorkspace)
+			tc.mockEnvLister(mockEnvLister)
+
+			opts := &InitStorageOpts{
+				AppName:       "frontend",
+				StorageType:   tc.inStorageType,
+				StorageName:   tc.inStorageName,
+				Engine:        addons.AuroraMySQLEngine,
+				InitialDBName: "main",
+
+				ws:         mockWorkspace,
+				envLister:  mockEnvLister,
+				GlobalOpts: &GlobalOpts{projectName: "phonetool"},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, strings.HasSuffix(opts.addonPath, tc.inStorageName+".yml"))
+		})
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/aws/amazon-ecs-cli-v2/cmd/archer/template"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/group"
+)
+
+// BuildTaskCmd is the top level command for one-off tasks.
+func BuildTaskCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "task",
+		Short: "Task commands.",
+		Long: `Command for working with one-off tasks.
+A task runs a command, such as a database migration, with the same image, network and secrets as an application.`,
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			bindProjectName()
+		},
+	}
+	// The flags bound by viper are available to all sub-commands through viper.GetString({flagName})
+	cmd.PersistentFlags().StringP(projectFlag, projectFlagShort, "" /* default */, projectFlagDescription)
+	viper.BindPFlag(projectFlag, cmd.PersistentFlags().Lookup(projectFlag))
+	markFlagCompletion(cmd.PersistentFlags(), projectFlag, completeProjects)
+
+	cmd.AddCommand(BuildTaskRunCmd())
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Develop,
+	}
+	return cmd
+}
@@ -0,0 +1,327 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/ecs"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/build/docker"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/build/ecr"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	termprogress "github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/progress"
+	"github.com/spf13/cobra"
+)
+
+const (
+	fmtTaskRepoName           = "%s/%s-task"    // The ECR repository of ad-hoc task images is named after the project and app.
+	fmtTaskDefinitionFamily   = "%s-%s-%s-task" // The task definitions of ad-hoc images are named after the project, env and app.
+	fmtTaskImageTag           = "task-%d"
+	taskStatusPollingInterval = 3 * time.Second
+)
+
+const (
+	fmtTaskRunStart    = "Starting a task of %s in environment %s."
+	fmtTaskRunFailed   = "Failed to start a task of %s in environment %s."
+	fmtTaskRunComplete = "Started task %s."
+)
+
+type appTaskDescriber interface {
+	Describe(projectName, envName, appName string) (*describe.AppTask, error)
+}
+
+type taskRunner interface {
+	RunTask(in *ecs.RunTaskInput) (string, error)
+	Task(cluster, taskARN, containerName string) (*ecs.Task, error)
+	RegisterTaskDefinitionWithImage(taskDefinition, family, containerName, image string) (string, error)
+}
+
+type taskRepository interface {
+	CreateRepository(name string, pullAccountIDs ...string) (string, error)
+	GetECRAuth() (ecr.Auth, error)
+}
+
+// errTaskExitCode occurs when the container of a one-off task exits with a non-zero code.
+type errTaskExitCode struct {
+	taskID   string
+	exitCode int
+}
+
+func (e *errTaskExitCode) Error() string {
+	return fmt.Sprintf("task %s exited with code %d", e.taskID, e.exitCode)
+}
+
+// ExitCode returns the exit code of the task's container, so that the CLI exits with the same code.
+func (e *errTaskExitCode) ExitCode() int {
+	return e.exitCode
+}
+
+// RunTaskOpts holds the configuration needed to run a one-off task of an application.
+type RunTaskOpts struct {
+	// Fields with matching flags.
+	AppName        string
+	EnvName        string
+	Command        string
+	DockerfilePath string
+	ImageTag       string
+
+	// Interfaces to interact with dependencies.
+	appLister     archer.ApplicationLister
+	envStore      archer.EnvironmentStore
+	describer     appTaskDescriber
+	runner        taskRunner
+	logsSvc       logEventsGetter
+	repository    taskRepository
+	dockerService dockerService
+	prog          progress
+	w             io.Writer
+
+	pollInterval time.Duration
+
+	*GlobalOpts
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (opts *RunTaskOpts) Ask() error {
+	if opts.AppName == "" {
+		name, err := selectApplication(opts.promptFor(appFlag), opts.appLister, opts.ProjectName(),
+			"Which application's task would you like to run?",
+			"The task runs with the task definition, network and secrets of the application.")
+		if err != nil {
+			return err
+		}
+		opts.AppName = name
+	}
+	if opts.EnvName == "" {
+		name, err := selectEnvironment(opts.promptFor(envFlag), opts.envStore, opts.ProjectName(),
+			fmt.Sprintf("Which environment of %s would you like to run the task in?", opts.AppName),
+			"The task runs in the private subnets of the environment.")
+		if err != nil {
+			return err
+		}
+		opts.EnvName = name
+	}
+	return nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (opts *RunTaskOpts) Validate() error {
+	if opts.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	if opts.DockerfilePath != "" {
+		if _, err := os.Stat(opts.DockerfilePath); err != nil {
+			return fmt.Errorf("find Dockerfile %s: %w", opts.DockerfilePath, err)
+		}
+	}
+	return nil
+}
+
+// Execute runs a task of the application in the environment, streams its logs until it stops,
+// and returns an error carrying the exit code of the container if it's not zero.
+func (opts *RunTaskOpts) Execute() error {
+	env, err := opts.envStore.GetEnvironment(opts.ProjectName(), opts.EnvName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", opts.EnvName, err)
+	}
+	if err := opts.initClients(env); err != nil {
+		return err
+	}
+
+	appTask, err := opts.describer.Describe(opts.ProjectName(), opts.EnvName, opts.AppName)
+	if err != nil {
+		return fmt.Errorf("describe tasks of application %s in environment %s: %w", opts.AppName, opts.EnvName, err)
+	}
+	taskDefinition := appTask.TaskDefinition
+	if opts.DockerfilePath != "" {
+		if taskDefinition, err = opts.taskDefinitionWithImage(env, taskDefinition); err != nil {
+			return err
+		}
+	}
+
+	in := &ecs.RunTaskInput{
+		Cluster:        appTask.Cluster,
+		TaskDefinition: taskDefinition,
+		ContainerName:  opts.AppName,
+		Subnets:        appTask.Subnets,
+		SecurityGroups: appTask.SecurityGroups,
+	}
+	if opts.Command != "" {
+		in.Command = []string{"/bin/sh", "-c", opts.Command}
+	}
+	opts.prog.Start(fmt.Sprintf(fmtTaskRunStart, color.HighlightUserInput(opts.AppName), color.HighlightUserInput(opts.EnvName)))
+	taskARN, err := opts.runner.RunTask(in)
+	if err != nil {
+		opts.prog.Stop(log.Serrorf(fmtTaskRunFailed, opts.AppName, opts.EnvName))
+		return err
+	}
+	opts.prog.Stop(log.Ssuccessf(fmtTaskRunComplete, taskARN))
+
+	task, err := opts.streamLogs(appTask.Cluster, taskARN)
+	if err != nil {
+		return err
+	}
+	if task.ExitCode == nil {
+		return fmt.Errorf("task %s stopped before its container exited: %s", task.ID, task.StoppedReason)
+	}
+	if *task.ExitCode != 0 {
+		return &errTaskExitCode{taskID: task.ID, exitCode: int(*task.ExitCode)}
+	}
+	log.Successf("Task %s exited with code 0.\n", task.ID)
+	return nil
+}
+
+// streamLogs prints the log events of the task until it stops, and returns the stopped task.
+func (opts *RunTaskOpts) streamLogs(cluster, taskARN string) (*ecs.Task, error) {
+	in := &cloudwatchlogs.LogEventsInput{
+		LogGroupName: fmt.Sprintf(fmtAppLogGroupName, opts.ProjectName(), opts.EnvName, opts.AppName),
+	}
+	// IDs of the events already printed at the latest timestamp, since polling from
+	// that timestamp again returns them.
+	printed := make(map[string]bool)
+	printEvents := func() error {
+		events, err := opts.logsSvc.LogEvents(in)
+		if err != nil {
+			return fmt.Errorf("get logs of task %s: %w", taskARN, err)
+		}
+		for _, event := range events {
+			if printed[event.ID] {
+				continue
+			}
+			fmt.Fprintln(opts.w, event.Message)
+			if event.Timestamp > in.StartTime {
+				in.StartTime = event.Timestamp
+				printed = make(map[string]bool)
+			}
+			printed[event.ID] = true
+		}
+		return nil
+	}
+
+	for {
+		task, err := opts.runner.Task(cluster, taskARN, opts.AppName)
+		if err != nil {
+			return nil, err
+		}
+		in.LogStreamNamePrefix = fmt.Sprintf(fmtAppLogStreamNamePrefix, opts.AppName, task.ID)
+		// Poll the logs once more after the task stops so that its last events are printed.
+		if err := printEvents(); err != nil {
+			return nil, err
+		}
+		if task.LastStatus == ecs.TaskStatusStopped {
+			return task, nil
+		}
+		time.Sleep(opts.pollInterval)
+	}
+}
+
+// taskDefinitionWithImage builds and pushes the image of the Dockerfile to the task repository of the application,
+// and returns a copy of the application's task definition that runs this image.
+func (opts *RunTaskOpts) taskDefinitionWithImage(env *archer.Environment, taskDefinition string) (string, error) {
+	if opts.ImageTag == "" {
+		opts.ImageTag = fmt.Sprintf(fmtTaskImageTag, time.Now().Unix())
+	}
+	repoName := fmt.Sprintf(fmtTaskRepoName, opts.ProjectName(), opts.AppName)
+	uri, err := opts.repository.CreateRepository(repoName, env.AccountID)
+	if err != nil {
+		return "", fmt.Errorf("create ECR repository %s: %w", repoName, err)
+	}
+	buildPath := filepath.Dir(opts.DockerfilePath)
+	if err := opts.dockerService.Build(uri, opts.ImageTag, buildPath); err != nil {
+		return "", fmt.Errorf("build Dockerfile at %s with tag %s: %w", buildPath, opts.ImageTag, err)
+	}
+	auth, err := opts.repository.GetECRAuth()
+	if err != nil {
+		return "", fmt.Errorf("get ECR auth data: %w", err)
+	}
+	if err := opts.dockerService.Login(uri, auth); err != nil {
+		return "", err
+	}
+	if err := opts.dockerService.Push(uri, opts.ImageTag); err != nil {
+		return "", err
+	}
+
+	family := fmt.Sprintf(fmtTaskDefinitionFamily, opts.ProjectName(), opts.EnvName, opts.AppName)
+	arn, err := opts.runner.RegisterTaskDefinitionWithImage(taskDefinition, family, opts.AppName, fmt.Sprintf("%s:%s", uri, opts.ImageTag))
+	if err != nil {
+		return "", err
+	}
+	return arn, nil
+}
+
+func (opts *RunTaskOpts) initClients(env *archer.Environment) error {
+	if opts.runner != nil {
+		// Tests mock the clients.
+		return nil
+	}
+	sess, err := opts.sessionProvider().FromEnvRole(env.Name, env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return fmt.Errorf("create session from role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	opts.describer = describe.NewAppTaskDescriber(sess)
+	opts.runner = ecs.New(sess)
+	opts.logsSvc = cloudwatchlogs.New(sess)
+
+	// Images are pushed to the tools account in the region of the environment.
+	defaultSess, err := opts.sessionProvider().DefaultWithRegion(env.Region)
+	if err != nil {
+		return fmt.Errorf("create ECR session with region %s: %w", env.Region, err)
+	}
+	opts.repository = ecr.New(defaultSess)
+	return nil
+}
+
+// BuildTaskRunCmd builds the command for running a one-off task of an application.
+func BuildTaskRunCmd() *cobra.Command {
+	opts := &RunTaskOpts{
+		dockerService: docker.New(),
+		prog:          termprogress.NewSpinner(),
+		w:             os.Stdout,
+		pollInterval:  taskStatusPollingInterval,
+		GlobalOpts:    NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Runs a one-off task of an application.",
+		Long: `Runs a one-off task with the task definition, network and secrets of an application in an environment.
+The logs of the task are streamed until it stops, and the command exits with the exit code of the container.`,
+		Example: `
+  Runs the database migrations of the "api" application in the "test" environment.
+  /code $ archer task run --app api --env test --command "./manage.py migrate"
+  Runs a script with an ad-hoc image.
+  /code $ archer task run --app api --env test --dockerfile scripts/Dockerfile --command "./backfill.sh"`,
+		PreRunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			store, err := store.New()
+			if err != nil {
+				return fmt.Errorf("connect to ecs-cli metadata store: %w", err)
+			}
+			opts.appLister = store
+			opts.envStore = store
+			return opts.Validate()
+		}),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&opts.AppName, appFlag, appFlagShort, "", appFlagDescription)
+	cmd.Flags().StringVarP(&opts.EnvName, envFlag, envFlagShort, "", envFlagDescription)
+	markFlagCompletion(cmd.Flags(), appFlag, completeApps)
+	markFlagCompletion(cmd.Flags(), envFlag, completeEnvs)
+	cmd.Flags().StringVar(&opts.Command, commandFlag, "", commandFlagDescription)
+	cmd.Flags().StringVarP(&opts.DockerfilePath, dockerFileFlag, dockerFileFlagShort, "", taskDockerfileFlagDescription)
+	cmd.Flags().StringVar(&opts.ImageTag, imageTagFlag, "", taskImageTagFlagDescription)
+	return cmd
+}
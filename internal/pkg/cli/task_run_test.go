@@ -0,0 +1,215 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/ecs"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/build/ecr"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/aws/amazon-ecs-cli-v2/mocks"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunTaskOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inProjectName    string
+		inDockerfilePath string
+
+		wantedErr string
+	}{
+		"returns error if there is no project": {
+			wantedErr: errNoProjectInWorkspace.Error(),
+		},
+		"returns error if the Dockerfile does not exist": {
+			inProjectName:    "phonetool",
+			inDockerfilePath: "testdata/missing/Dockerfile",
+			wantedErr:        "find Dockerfile testdata/missing/Dockerfile: stat testdata/missing/Dockerfile: no such file or directory",
+		},
+		"valid flags": {
+			inProjectName: "phonetool",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &RunTaskOpts{
+				DockerfilePath: tc.inDockerfilePath,
+				GlobalOpts:     &GlobalOpts{projectName: tc.inProjectName},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRunTaskOpts_Execute(t *testing.T) {
+	testEnv := &archer.Environment{Project: "phonetool", Name: "test", AccountID: "2222", Region: "us-west-2"}
+	appTask := &describe.AppTask{
+		Cluster:        "phonetool-test-Cluster",
+		TaskDefinition: "arn:aws:ecs:us-west-2:2222:task-definition/phonetool-test-api:3",
+		Subnets:        []string{"subnet-1", "subnet-2"},
+		SecurityGroups: []string{"sg-1"},
+	}
+	const taskARN = "arn:aws:ecs:us-west-2:2222:task/phonetool-test-Cluster/abc123"
+	runningTask := &ecs.Task{ID: "abc123", ARN: taskARN, LastStatus: "RUNNING"}
+	stoppedTask := func(exitCode *int64) *ecs.Task {
+		return &ecs.Task{ID: "abc123", ARN: taskARN, LastStatus: "STOPPED", StoppedReason: "Essential container in task exited", ExitCode: exitCode}
+	}
+	logsIn := &cloudwatchlogs.LogEventsInput{
+		LogGroupName:        "/ecs/phonetool-test-api",
+		LogStreamNamePrefix: "ecs/api/abc123",
+	}
+
+	testCases := map[string]struct {
+		inCommand        string
+		inDockerfilePath string
+
+		setupMocks func(
+			describer *climocks.MockappTaskDescriber,
+			runner *climocks.MocktaskRunner,
+			logs *climocks.MocklogEventsGetter,
+			repo *climocks.MocktaskRepository,
+			docker *climocks.MockdockerService)
+
+		wantedErr    error
+		wantedOutput string
+	}{
+		"runs the task with the command and streams its logs": {
+			inCommand: "./manage.py migrate",
+			setupMocks: func(describer *climocks.MockappTaskDescriber, runner *climocks.MocktaskRunner, logs *climocks.MocklogEventsGetter,
+				repo *climocks.MocktaskRepository, docker *climocks.MockdockerService) {
+				describer.EXPECT().Describe("phonetool", "test", "api").Return(appTask, nil)
+				gomock.InOrder(
+					runner.EXPECT().RunTask(&ecs.RunTaskInput{
+						Cluster:        "phonetool-test-Cluster",
+						TaskDefinition: "arn:aws:ecs:us-west-2:2222:task-definition/phonetool-test-api:3",
+						ContainerName:  "api",
+						Command:        []string{"/bin/sh", "-c", "./manage.py migrate"},
+						Subnets:        []string{"subnet-1", "subnet-2"},
+						SecurityGroups: []string{"sg-1"},
+					}).Return(taskARN, nil),
+					runner.EXPECT().Task("phonetool-test-Cluster", taskARN, "api").Return(runningTask, nil),
+					logs.EXPECT().LogEvents(logsIn).Return([]*cloudwatchlogs.Event{
+						{ID: "1", Timestamp: 10, Message: "Applying migrations"},
+					}, nil),
+					runner.EXPECT().Task("phonetool-test-Cluster", taskARN, "api").Return(stoppedTask(aws.Int64(0)), nil),
+					logs.EXPECT().LogEvents(gomock.Any()).Return([]*cloudwatchlogs.Event{
+						{ID: "1", Timestamp: 10, Message: "Applying migrations"},
+						{ID: "2", Timestamp: 20, Message: "Done"},
+					}, nil),
+				)
+			},
+			wantedOutput: "Applying migrations\nDone\n",
+		},
+		"returns the exit code of the container": {
+			setupMocks: func(describer *climocks.MockappTaskDescriber, runner *climocks.MocktaskRunner, logs *climocks.MocklogEventsGetter,
+				repo *climocks.MocktaskRepository, docker *climocks.MockdockerService) {
+				describer.EXPECT().Describe("phonetool", "test", "api").Return(appTask, nil)
+				runner.EXPECT().RunTask(gomock.Any()).Return(taskARN, nil)
+				runner.EXPECT().Task("phonetool-test-Cluster", taskARN, "api").Return(stoppedTask(aws.Int64(3)), nil)
+				logs.EXPECT().LogEvents(gomock.Any()).Return(nil, nil)
+			},
+			wantedErr: &errTaskExitCode{taskID: "abc123", exitCode: 3},
+		},
+		"returns an error if the container never started": {
+			setupMocks: func(describer *climocks.MockappTaskDescriber, runner *climocks.MocktaskRunner, logs *climocks.MocklogEventsGetter,
+				repo *climocks.MocktaskRepository, docker *climocks.MockdockerService) {
+				describer.EXPECT().Describe("phonetool", "test", "api").Return(appTask, nil)
+				runner.EXPECT().RunTask(gomock.Any()).Return(taskARN, nil)
+				runner.EXPECT().Task("phonetool-test-Cluster", taskARN, "api").Return(stoppedTask(nil), nil)
+				logs.EXPECT().LogEvents(gomock.Any()).Return(nil, nil)
+			},
+			wantedErr: errors.New("task abc123 stopped before its container exited: Essential container in task exited"),
+		},
+		"runs an ad-hoc image built from the Dockerfile": {
+			inDockerfilePath: "scripts/Dockerfile",
+			setupMocks: func(describer *climocks.MockappTaskDescriber, runner *climocks.MocktaskRunner, logs *climocks.MocklogEventsGetter,
+				repo *climocks.MocktaskRepository, docker *climocks.MockdockerService) {
+				const uri = "1111.dkr.ecr.us-west-2.amazonaws.com/phonetool/api-task"
+				describer.EXPECT().Describe("phonetool", "test", "api").Return(appTask, nil)
+				gomock.InOrder(
+					repo.EXPECT().CreateRepository("phonetool/api-task", "2222").Return(uri, nil),
+					docker.EXPECT().Build(uri, "adhoc", "scripts").Return(nil),
+					repo.EXPECT().GetECRAuth().Return(ecr.Auth{Username: "AWS", Password: "secret"}, nil),
+					docker.EXPECT().Login(uri, ecr.Auth{Username: "AWS", Password: "secret"}).Return(nil),
+					docker.EXPECT().Push(uri, "adhoc").Return(nil),
+					runner.EXPECT().RegisterTaskDefinitionWithImage(appTask.TaskDefinition, "phonetool-test-api-task", "api", uri+":adhoc").
+						Return("arn:aws:ecs:us-west-2:2222:task-definition/phonetool-test-api-task:1", nil),
+					runner.EXPECT().RunTask(gomock.Any()).DoAndReturn(func(in *ecs.RunTaskInput) (string, error) {
+						require.Equal(t, "arn:aws:ecs:us-west-2:2222:task-definition/phonetool-test-api-task:1", in.TaskDefinition)
+						return taskARN, nil
+					}),
+					runner.EXPECT().Task("phonetool-test-Cluster", taskARN, "api").Return(stoppedTask(aws.Int64(0)), nil),
+					logs.EXPECT().LogEvents(gomock.Any()).Return(nil, nil),
+				)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockEnvStore := mocks.NewMockEnvironmentStore(ctrl)
+			mockEnvStore.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+			mockDescriber := climocks.NewMockappTaskDescriber(ctrl)
+			mockRunner := climocks.NewMocktaskRunner(ctrl)
+			mockLogs := climocks.NewMocklogEventsGetter(ctrl)
+			mockRepo := climocks.NewMocktaskRepository(ctrl)
+			mockDocker := climocks.NewMockdockerService(ctrl)
+			mockProg := climocks.NewMockprogress(ctrl)
+			mockProg.EXPECT().Start(gomock.Any()).AnyTimes()
+			mockProg.EXPECT().Stop(gomock.Any()).AnyTimes()
+			tc.setupMocks(mockDescriber, mockRunner, mockLogs, mockRepo, mockDocker)
+			b := &bytes.Buffer{}
+
+			opts := &RunTaskOpts{
+				AppName:        "api",
+				EnvName:        "test",
+				Command:        tc.inCommand,
+				DockerfilePath: tc.inDockerfilePath,
+				ImageTag:       "adhoc",
+				envStore:       mockEnvStore,
+				describer:      mockDescriber,
+				runner:         mockRunner,
+				logsSvc:        mockLogs,
+				repository:     mockRepo,
+				dockerService:  mockDocker,
+				prog:           mockProg,
+				w:              b,
+				GlobalOpts:     &GlobalOpts{projectName: "phonetool"},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
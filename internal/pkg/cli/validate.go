@@ -6,9 +6,11 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"net"
 	"regexp"
 	"strings"
 
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/addons"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
 )
 
@@ -18,9 +20,26 @@ var (
 	errValueBadFormat    = errors.New("value must start with a letter and contain only lower-case letters, numbers, and hyphens")
 	errValueNotAString   = errors.New("value must be a string")
 	errInvalidGitHubRepo = errors.New("value must be a valid GitHub repository, e.g. https://github.com/myCompany/myRepo")
+	errValueNotAlphanum  = errors.New("value must start with a letter and contain only letters and numbers")
+	errValueBadDDBKey    = errors.New("value must be an attribute name followed by its type S, N or B, e.g. id:S")
+	errValueBadDBName    = errors.New("value must start with a letter and contain only letters, numbers, and underscores, up to 63 characters")
+	errValueBadSecret    = errors.New("value must start with a letter and contain only letters, numbers, underscores, periods, and hyphens")
+	errValueBadCIDR      = fmt.Errorf("value must be an IPv4 CIDR block between /%d and /%d, e.g. 10.0.0.0/16", minCIDRPrefix, maxCIDRPrefix)
 )
 
-var githubRepoExp = regexp.MustCompile(`(https:\/\/github\.com\/|)(?P<owner>.+)\/(?P<repo>.+)`)
+// Sizes of the CIDR blocks allowed for VPCs and subnets.
+const (
+	minCIDRPrefix = 16
+	maxCIDRPrefix = 28
+)
+
+var (
+	githubRepoExp  = regexp.MustCompile(`(https:\/\/github\.com\/|)(?P<owner>.+)\/(?P<repo>.+)`)
+	storageNameExp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]*$`)
+	ddbKeyExp      = regexp.MustCompile(`^[a-zA-Z0-9_.\-]+:[SNB]$`)
+	dbNameExp      = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]{0,62}$`)
+	secretNameExp  = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_.\-]*$`)
+)
 
 func validateProjectName(val interface{}) error {
 	if err := basicNameValidation(val); err != nil {
@@ -53,6 +72,31 @@ func validateApplicationType(val interface{}) error {
 	return fmt.Errorf("invalid app type %s: must be one of %s", appType, strings.Join(prettyTypes, ", "))
 }
 
+func validateStorageType(val interface{}) error {
+	return validateOneOf(val, "storage type", addons.StorageTypes)
+}
+
+func validateAuroraEngine(val interface{}) error {
+	return validateOneOf(val, "database engine", addons.AuroraEngines)
+}
+
+func validateOneOf(val interface{}, desc string, options []string) error {
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	for _, option := range options {
+		if s == option {
+			return nil
+		}
+	}
+	var prettyOptions []string
+	for _, option := range options {
+		prettyOptions = append(prettyOptions, fmt.Sprintf(`"%s"`, option))
+	}
+	return fmt.Errorf("invalid %s %s: must be one of %s", desc, s, strings.Join(prettyOptions, ", "))
+}
+
 func validateEnvironmentName(val interface{}) error {
 	if err := basicNameValidation(val); err != nil {
 		return fmt.Errorf("environment name %v is invalid: %w", val, err)
@@ -96,3 +140,72 @@ func validateGitHubRepo(val interface{}) error {
 	}
 	return nil
 }
+
+func validateStorageName(val interface{}) error {
+	name, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	// The name is used as the logical ID of the storage resource in its addon template.
+	if !storageNameExp.MatchString(name) {
+		return fmt.Errorf("storage name %v is invalid: %w", val, errValueNotAlphanum)
+	}
+	return nil
+}
+
+func validateDDBKey(val interface{}) error {
+	key, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if !ddbKeyExp.MatchString(key) {
+		return fmt.Errorf("key %v is invalid: %w", val, errValueBadDDBKey)
+	}
+	return nil
+}
+
+func validateDBName(val interface{}) error {
+	name, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if !dbNameExp.MatchString(name) {
+		return fmt.Errorf("database name %v is invalid: %w", val, errValueBadDBName)
+	}
+	return nil
+}
+
+func validateSecretName(val interface{}) error {
+	name, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	// The name is the last segment of the secret's SSM parameter name.
+	if !secretNameExp.MatchString(name) {
+		return fmt.Errorf("secret name %v is invalid: %w", val, errValueBadSecret)
+	}
+	return nil
+}
+
+func validateCIDR(val interface{}) error {
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if _, err := parseCIDR(s); err != nil {
+		return fmt.Errorf("CIDR block %v is invalid: %w", val, err)
+	}
+	return nil
+}
+
+// parseCIDR parses an IPv4 CIDR block of a size allowed for VPCs and subnets.
+func parseCIDR(s string) (*net.IPNet, error) {
+	ip, block, err := net.ParseCIDR(s)
+	if err != nil || ip.To4() == nil {
+		return nil, errValueBadCIDR
+	}
+	if prefix, _ := block.Mask.Size(); prefix < minCIDRPrefix || prefix > maxCIDRPrefix {
+		return nil, errValueBadCIDR
+	}
+	return block, nil
+}
@@ -173,3 +173,144 @@ func TestValidateGitHubRepo(t *testing.T) {
 	}
 }
 
+
+func TestValidateStorageName(t *testing.T) {
+	testCases := map[string]testCase{
+		"logical ID": {
+			input: "MyTable2",
+			want:  nil,
+		},
+		"number as input": {
+			input: 1234,
+			want:  errValueNotAString,
+		},
+		"contains hyphens": {
+			input: "my-table",
+			want:  errValueNotAlphanum,
+		},
+		"does not start with letter": {
+			input: "1table",
+			want:  errValueNotAlphanum,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateStorageName(tc.input)
+
+			require.True(t, errors.Is(got, tc.want))
+		})
+	}
+}
+
+func TestValidateDDBKey(t *testing.T) {
+	testCases := map[string]testCase{
+		"string key": {
+			input: "id:S",
+			want:  nil,
+		},
+		"number key": {
+			input: "created_at:N",
+			want:  nil,
+		},
+		"missing type": {
+			input: "id",
+			want:  errValueBadDDBKey,
+		},
+		"unknown type": {
+			input: "id:BOOL",
+			want:  errValueBadDDBKey,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateDDBKey(tc.input)
+
+			require.True(t, errors.Is(got, tc.want))
+		})
+	}
+}
+
+func TestValidateDBName(t *testing.T) {
+	testCases := map[string]testCase{
+		"valid name": {
+			input: "main_db",
+			want:  nil,
+		},
+		"contains hyphens": {
+			input: "main-db",
+			want:  errValueBadDBName,
+		},
+		"too long": {
+			input: strings.Repeat("d", 64),
+			want:  errValueBadDBName,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateDBName(tc.input)
+
+			require.True(t, errors.Is(got, tc.want))
+		})
+	}
+}
+
+func TestValidateSecretName(t *testing.T) {
+	testCases := map[string]testCase{
+		"valid name": {
+			input: "DB_PASSWORD",
+			want:  nil,
+		},
+		"contains a slash": {
+			input: "db/password",
+			want:  errValueBadSecret,
+		},
+		"starts with a number": {
+			input: "1password",
+			want:  errValueBadSecret,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateSecretName(tc.input)
+
+			require.True(t, errors.Is(got, tc.want))
+		})
+	}
+}
+
+func TestValidateCIDR(t *testing.T) {
+	testCases := map[string]testCase{
+		"valid block": {
+			input: "10.0.0.0/16",
+			want:  nil,
+		},
+		"not a block": {
+			input: "10.0.0.0",
+			want:  errValueBadCIDR,
+		},
+		"IPv6 block": {
+			input: "2001:db8::/56",
+			want:  errValueBadCIDR,
+		},
+		"block too large": {
+			input: "10.0.0.0/8",
+			want:  errValueBadCIDR,
+		},
+		"block too small": {
+			input: "10.0.0.0/29",
+			want:  errValueBadCIDR,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateCIDR(tc.input)
+
+			require.True(t, errors.Is(got, tc.want))
+		})
+	}
+}
@@ -6,6 +6,7 @@
 package deploy
 
 import (
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/addons"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
 )
@@ -16,4 +17,11 @@ type CreateLBFargateAppInput struct {
 	Env          *archer.Environment
 	ImageRepoURL string
 	ImageTag     string
+	Addons       *AddonsStack // Optional nested stack holding the application's addons.
+}
+
+// AddonsStack holds the fields required to attach the addons of an application as a nested stack.
+type AddonsStack struct {
+	TemplateURL string          // The S3 URL of the merged addons template.
+	Outputs     []addons.Output // The outputs of the addons template.
 }
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -26,7 +27,7 @@ func (cf CloudFormation) DeployApp(template, stackName, changeSetName, cfExecuti
 	_, err := cf.client.CreateStack(&cloudformation.CreateStackInput{
 		StackName:    aws.String(stackName),
 		TemplateBody: aws.String(template),
-		Capabilities: aws.StringSlice([]string{cloudformation.CapabilityCapabilityIam}),
+		Capabilities: aws.StringSlice([]string{cloudformation.CapabilityCapabilityIam, cloudformation.CapabilityCapabilityNamedIam}),
 		Tags:         cfnTags,
 		RoleARN:      aws.String(cfExecutionRole),
 	})
@@ -59,7 +60,7 @@ func (cf CloudFormation) DeployApp(template, stackName, changeSetName, cfExecuti
 		ChangeSetName: aws.String(changeSetName),
 		StackName:     aws.String(stackName),
 		TemplateBody:  aws.String(template),
-		Capabilities:  aws.StringSlice([]string{cloudformation.CapabilityCapabilityIam}),
+		Capabilities:  aws.StringSlice([]string{cloudformation.CapabilityCapabilityIam, cloudformation.CapabilityCapabilityNamedIam}),
 		ChangeSetType: aws.String(cloudformation.ChangeSetTypeUpdate),
 		Tags:          cfnTags,
 		RoleARN:       aws.String(cfExecutionRole),
@@ -111,3 +112,67 @@ func (cf CloudFormation) DeployApp(template, stackName, changeSetName, cfExecuti
 
 	return nil
 }
+
+// CreateAppChangeSet creates a change set that deploys the application's template to its stack, creating the stack
+// if it doesn't exist, and returns its changes for review. The change set is not executed.
+//
+// If the template does not modify any resource, returns nil.
+func (cf CloudFormation) CreateAppChangeSet(template, stackName, cfExecutionRole string, tags map[string]string) (*deploy.ChangeSet, error) {
+	csType, err := cf.changeSetType(stackName)
+	if err != nil {
+		return nil, err
+	}
+	var cfnTags []*cloudformation.Tag
+	for k, v := range tags {
+		cfnTags = append(cfnTags, &cloudformation.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+	in, err := createChangeSetInput(stackName, template,
+		withChangeSetType(csType),
+		withTags(cfnTags),
+		withRoleARN(cfExecutionRole))
+	if err != nil {
+		return nil, err
+	}
+	return cf.createChangeSetForReview(in)
+}
+
+// RollbackApp redeploys a template previously uploaded to templateURL to the application's existing stack,
+// and waits until the stack is updated. If the template does not modify any resource, it's a no-op.
+func (cf CloudFormation) RollbackApp(templateURL, stackName, cfExecutionRole string, tags map[string]string) error {
+	var cfnTags []*cloudformation.Tag
+	for k, v := range tags {
+		cfnTags = append(cfnTags, &cloudformation.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+	in, err := createChangeSetInput(stackName, "",
+		withTemplateURL(templateURL),
+		withChangeSetType(cloudformation.ChangeSetTypeUpdate),
+		withTags(cfnTags),
+		withRoleARN(cfExecutionRole))
+	if err != nil {
+		return err
+	}
+	cs, err := cf.createChangeSetForReview(in)
+	if err != nil {
+		return err
+	}
+	if cs == nil {
+		return nil
+	}
+	return cf.ExecuteChangeSet(cs)
+}
+
+// DeleteApp deletes the CloudFormation stack of an application in an environment.
+// The stack is deleted with the environment's CloudFormation execution role.
+// If the stack doesn't exist, it returns an ErrStackNotFound error.
+func (cf CloudFormation) DeleteApp(stackName, cfExecutionRole string) error {
+	if _, err := cf.describeStack(&cloudformation.DescribeStacksInput{StackName: aws.String(stackName)}); err != nil {
+		return err
+	}
+	return cf.delete(stackName, withDeleteRoleARN(cfExecutionRole))
+}
@@ -8,6 +8,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/stretchr/testify/require"
@@ -204,3 +207,269 @@ func TestDeployApp(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateAppChangeSet(t *testing.T) {
+	mockError := errors.New("some error")
+	existingStack := func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+		return &cloudformation.DescribeStacksOutput{
+			Stacks: []*cloudformation.Stack{{StackStatus: aws.String(cloudformation.StackStatusUpdateComplete)}},
+		}, nil
+	}
+	missingStack := func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+		return nil, awserr.New("ValidationError", "Stack with id phonetool-test-frontend does not exist", nil)
+	}
+
+	testCases := map[string]struct {
+		mockDescribeStacks                              func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error)
+		mockWaitUntilChangeSetCreateCompleteWithContext func(t *testing.T, in *cloudformation.DescribeChangeSetInput) error
+		mockDescribeChangeSet                           func(t *testing.T, in *cloudformation.DescribeChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error)
+		mockDeleteChangeSet                             func(t *testing.T, in *cloudformation.DeleteChangeSetInput) (*cloudformation.DeleteChangeSetOutput, error)
+
+		wantedType      string
+		wantedChangeSet *deploy.ChangeSet
+		wantedErr       error
+	}{
+		"should return error if the stack is being updated": {
+			mockDescribeStacks: func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+				return &cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{{StackStatus: aws.String(cloudformation.StackStatusUpdateInProgress)}},
+				}, nil
+			},
+			wantedErr: &ErrStackUpdateInProgress{stackName: "phonetool-test-frontend", stackStatus: cloudformation.StackStatusUpdateInProgress},
+		},
+		"should return error if the stack cannot be described": {
+			mockDescribeStacks: func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+				return nil, mockError
+			},
+			wantedErr: mockError,
+		},
+		"should delete the change set and return nil if there are no changes": {
+			mockDescribeStacks: existingStack,
+			mockWaitUntilChangeSetCreateCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeChangeSetInput) error {
+				return mockError
+			},
+			mockDescribeChangeSet: func(t *testing.T, in *cloudformation.DescribeChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error) {
+				return &cloudformation.DescribeChangeSetOutput{}, nil
+			},
+			mockDeleteChangeSet: func(t *testing.T, in *cloudformation.DeleteChangeSetInput) (*cloudformation.DeleteChangeSetOutput, error) {
+				require.Equal(t, "changeSetID", *in.ChangeSetName)
+				return &cloudformation.DeleteChangeSetOutput{}, nil
+			},
+			wantedType: cloudformation.ChangeSetTypeUpdate,
+		},
+		"should return the changes of a new stack": {
+			mockDescribeStacks: missingStack,
+			mockWaitUntilChangeSetCreateCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeChangeSetInput) error {
+				return nil
+			},
+			mockDescribeChangeSet: func(t *testing.T, in *cloudformation.DescribeChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error) {
+				return &cloudformation.DescribeChangeSetOutput{
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+					Changes: []*cloudformation.Change{
+						{
+							ResourceChange: &cloudformation.ResourceChange{
+								Action:            aws.String(cloudformation.ChangeActionAdd),
+								LogicalResourceId: aws.String("Service"),
+								ResourceType:      aws.String("AWS::ECS::Service"),
+							},
+						},
+					},
+				}, nil
+			},
+			wantedType: cloudformation.ChangeSetTypeCreate,
+			wantedChangeSet: &deploy.ChangeSet{
+				StackName: "phonetool-test-frontend",
+				ID:        "changeSetID",
+				NewStack:  true,
+				Changes: []*deploy.ResourceChange{
+					{
+						Resource: deploy.Resource{LogicalName: "Service", Type: "AWS::ECS::Service"},
+						Action:   cloudformation.ChangeActionAdd,
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cf := CloudFormation{
+				client: mockCloudFormation{
+					t:                  t,
+					mockDescribeStacks: tc.mockDescribeStacks,
+					mockCreateChangeSet: func(t *testing.T, in *cloudformation.CreateChangeSetInput) (*cloudformation.CreateChangeSetOutput, error) {
+						require.Equal(t, "phonetool-test-frontend", *in.StackName)
+						require.Equal(t, "mockTemplate", *in.TemplateBody)
+						require.Equal(t, "mockExecutionRole", *in.RoleARN)
+						require.Equal(t, tc.wantedType, *in.ChangeSetType)
+						require.Equal(t, "ecs-project", *in.Tags[0].Key)
+						return &cloudformation.CreateChangeSetOutput{
+							Id:      aws.String("changeSetID"),
+							StackId: aws.String("stackID"),
+						}, nil
+					},
+					mockWaitUntilChangeSetCreateCompleteWithContext: tc.mockWaitUntilChangeSetCreateCompleteWithContext,
+					mockDescribeChangeSet:                           tc.mockDescribeChangeSet,
+					mockDeleteChangeSet:                             tc.mockDeleteChangeSet,
+				},
+			}
+
+			cs, err := cf.CreateAppChangeSet("mockTemplate", "phonetool-test-frontend", "mockExecutionRole", map[string]string{
+				"ecs-project": "phonetool",
+			})
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedChangeSet, cs)
+		})
+	}
+}
+
+func TestRollbackApp(t *testing.T) {
+	testCases := map[string]struct {
+		mockDescribeChangeSet func(t *testing.T, in *cloudformation.DescribeChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error)
+		mockExecuteChangeSet  func(t *testing.T, in *cloudformation.ExecuteChangeSetInput) (*cloudformation.ExecuteChangeSetOutput, error)
+
+		wantedErr error
+	}{
+		"should execute the change set and wait for the stack update": {
+			mockDescribeChangeSet: func(t *testing.T, in *cloudformation.DescribeChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error) {
+				return &cloudformation.DescribeChangeSetOutput{
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+					Changes: []*cloudformation.Change{
+						{
+							ResourceChange: &cloudformation.ResourceChange{
+								Action:            aws.String(cloudformation.ChangeActionModify),
+								LogicalResourceId: aws.String("TaskDefinition"),
+								ResourceType:      aws.String("AWS::ECS::TaskDefinition"),
+							},
+						},
+					},
+				}, nil
+			},
+			mockExecuteChangeSet: func(t *testing.T, in *cloudformation.ExecuteChangeSetInput) (*cloudformation.ExecuteChangeSetOutput, error) {
+				require.Equal(t, "changeSetID", *in.ChangeSetName)
+				return &cloudformation.ExecuteChangeSetOutput{}, nil
+			},
+		},
+		"should wrap error from executing the change set": {
+			mockDescribeChangeSet: func(t *testing.T, in *cloudformation.DescribeChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error) {
+				return &cloudformation.DescribeChangeSetOutput{
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+					Changes:         []*cloudformation.Change{{ResourceChange: &cloudformation.ResourceChange{}}},
+				}, nil
+			},
+			mockExecuteChangeSet: func(t *testing.T, in *cloudformation.ExecuteChangeSetInput) (*cloudformation.ExecuteChangeSetOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedErr: errors.New("failed to execute changeSet name=changeSetID, stackID=phonetool-test-frontend: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cf := CloudFormation{
+				client: mockCloudFormation{
+					t: t,
+					mockCreateChangeSet: func(t *testing.T, in *cloudformation.CreateChangeSetInput) (*cloudformation.CreateChangeSetOutput, error) {
+						require.Nil(t, in.TemplateBody)
+						require.Equal(t, "https://bucket.s3.amazonaws.com/revisions/template.yml", *in.TemplateURL)
+						require.Equal(t, cloudformation.ChangeSetTypeUpdate, *in.ChangeSetType)
+						require.Equal(t, "mockExecutionRole", *in.RoleARN)
+						return &cloudformation.CreateChangeSetOutput{
+							Id:      aws.String("changeSetID"),
+							StackId: aws.String("stackID"),
+						}, nil
+					},
+					mockWaitUntilChangeSetCreateCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeChangeSetInput) error {
+						return nil
+					},
+					mockDescribeChangeSet: tc.mockDescribeChangeSet,
+					mockExecuteChangeSet:  tc.mockExecuteChangeSet,
+					mockWaitUntilStackUpdateCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeStacksInput) error {
+						require.Equal(t, "phonetool-test-frontend", *in.StackName)
+						return nil
+					},
+				},
+			}
+
+			err := cf.RollbackApp("https://bucket.s3.amazonaws.com/revisions/template.yml", "phonetool-test-frontend", "mockExecutionRole", map[string]string{
+				"ecs-project": "phonetool",
+			})
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDeleteApp(t *testing.T) {
+	existingStack := func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+		require.Equal(t, "phonetool-test-frontend", *in.StackName)
+		return &cloudformation.DescribeStacksOutput{
+			Stacks: []*cloudformation.Stack{{StackStatus: aws.String(cloudformation.StackStatusUpdateComplete)}},
+		}, nil
+	}
+
+	testCases := map[string]struct {
+		mockDescribeStacks func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error)
+		mockDeleteStack    func(t *testing.T, in *cloudformation.DeleteStackInput) (*cloudformation.DeleteStackOutput, error)
+
+		wantErr error
+	}{
+		"should return an error if the stack doesn't exist": {
+			mockDescribeStacks: func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+				return nil, awserr.New("ValidationError", "Stack with id phonetool-test-frontend does not exist", nil)
+			},
+			mockDeleteStack: func(t *testing.T, in *cloudformation.DeleteStackInput) (*cloudformation.DeleteStackOutput, error) {
+				require.FailNow(t, "should not delete a missing stack")
+				return nil, nil
+			},
+			wantErr: &ErrStackNotFound{stackName: "phonetool-test-frontend"},
+		},
+		"should wrap error returned from DeleteStack": {
+			mockDescribeStacks: existingStack,
+			mockDeleteStack: func(t *testing.T, in *cloudformation.DeleteStackInput) (*cloudformation.DeleteStackOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantErr: errors.New("deleting stack phonetool-test-frontend: some error"),
+		},
+		"should delete the stack with the execution role": {
+			mockDescribeStacks: existingStack,
+			mockDeleteStack: func(t *testing.T, in *cloudformation.DeleteStackInput) (*cloudformation.DeleteStackOutput, error) {
+				require.Equal(t, "phonetool-test-frontend", *in.StackName)
+				require.Equal(t, "mockExecutionRole", *in.RoleARN)
+				return nil, nil
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cf := CloudFormation{
+				client: &mockCloudFormation{
+					t:                  t,
+					mockDescribeStacks: tc.mockDescribeStacks,
+					mockDeleteStack:    tc.mockDeleteStack,
+					mockWaitUntilStackDeleteCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeStacksInput) error {
+						return nil
+					},
+				},
+			}
+
+			err := cf.DeleteApp(stack.NameForApp("phonetool", "test", "frontend"), "mockExecutionRole")
+
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
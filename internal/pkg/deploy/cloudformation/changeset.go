@@ -5,8 +5,10 @@ package cloudformation
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -147,6 +149,14 @@ func withParameters(params []*cloudformation.Parameter) createChangeSetOpt {
 	}
 }
 
+// withTemplateURL deploys the template stored in S3 at the URL instead of the template body.
+func withTemplateURL(url string) createChangeSetOpt {
+	return func(in *cloudformation.CreateChangeSetInput) {
+		in.TemplateBody = nil
+		in.TemplateURL = aws.String(url)
+	}
+}
+
 func withChangeSetType(csType string) createChangeSetOpt {
 	return func(in *cloudformation.CreateChangeSetInput) {
 		in.ChangeSetType = aws.String(csType)
@@ -158,3 +168,142 @@ func withTags(tags []*cloudformation.Tag) createChangeSetOpt {
 		in.Tags = tags
 	}
 }
+
+func withRoleARN(roleARN string) createChangeSetOpt {
+	return func(in *cloudformation.CreateChangeSetInput) {
+		in.RoleARN = aws.String(roleARN)
+	}
+}
+
+// changeSetType returns the type of change set that deploys the stack: CREATE if the stack doesn't exist, UPDATE otherwise.
+func (cf CloudFormation) changeSetType(stackName string) (string, error) {
+	existing, err := cf.describeStack(&cloudformation.DescribeStacksInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		var stackNotFound *ErrStackNotFound
+		if errors.As(err, &stackNotFound) {
+			return cloudformation.ChangeSetTypeCreate, nil
+		}
+		return "", err
+	}
+	if StackStatus(aws.StringValue(existing.StackStatus)).InProgress() {
+		return "", &ErrStackUpdateInProgress{
+			stackName:   stackName,
+			stackStatus: aws.StringValue(existing.StackStatus),
+		}
+	}
+	return cloudformation.ChangeSetTypeUpdate, nil
+}
+
+// createChangeSetForReview creates the change set and waits until its changes are computed, without executing it.
+// If the change set does not modify any resource, it's deleted and nil is returned.
+func (cf CloudFormation) createChangeSetForReview(in *cloudformation.CreateChangeSetInput) (*deploy.ChangeSet, error) {
+	set, err := cf.createChangeSet(in)
+	if err != nil {
+		return nil, err
+	}
+	if err := set.waitForCreation(); err != nil {
+		if err := set.describe(); err != nil {
+			return nil, fmt.Errorf("describing failed change set: %w", err)
+		}
+		// The template changes did not modify any resource, delete the change set so that it doesn't count
+		// towards the limit of failed change sets.
+		if len(set.changes) == 0 {
+			set.delete()
+			return nil, nil
+		}
+		return nil, err
+	}
+	if err := set.describe(); err != nil {
+		return nil, err
+	}
+	return &deploy.ChangeSet{
+		StackName: aws.StringValue(in.StackName),
+		ID:        set.name,
+		NewStack:  aws.StringValue(in.ChangeSetType) == cloudformation.ChangeSetTypeCreate,
+		Changes:   resourceChanges(set.changes),
+	}, nil
+}
+
+// ExecuteChangeSet applies a change set created for review and waits until the stack is created or updated.
+func (cf CloudFormation) ExecuteChangeSet(cs *deploy.ChangeSet) error {
+	set := &changeSet{
+		name:    cs.ID,
+		stackID: cs.StackName,
+		c:       cf.client,
+		waiters: cf.waiters,
+	}
+	if err := set.execute(); err != nil {
+		return err
+	}
+	describeStackInput := &cloudformation.DescribeStacksInput{
+		StackName: aws.String(cs.StackName),
+	}
+	if cs.NewStack {
+		if err := cf.client.WaitUntilStackCreateCompleteWithContext(context.Background(), describeStackInput, cf.waiters...); err != nil {
+			return fmt.Errorf("failed to create stack %s: %w", cs.StackName, err)
+		}
+		return nil
+	}
+	if err := cf.client.WaitUntilStackUpdateCompleteWithContext(context.Background(), describeStackInput, cf.waiters...); err != nil {
+		return fmt.Errorf("failed to update stack %s: %w", cs.StackName, err)
+	}
+	return nil
+}
+
+// DeleteChangeSet deletes a change set created for review without applying it.
+// The stack of a change set that would have created it is left in the REVIEW_IN_PROGRESS status,
+// and is deleted as well.
+func (cf CloudFormation) DeleteChangeSet(cs *deploy.ChangeSet) error {
+	set := &changeSet{
+		name:    cs.ID,
+		stackID: cs.StackName,
+		c:       cf.client,
+	}
+	if err := set.delete(); err != nil {
+		return err
+	}
+	if cs.NewStack {
+		return cf.delete(cs.StackName)
+	}
+	return nil
+}
+
+// resourceChanges returns the resource-level changes of a change set.
+func resourceChanges(changes []*cloudformation.Change) []*deploy.ResourceChange {
+	var resourceChanges []*deploy.ResourceChange
+	for _, change := range changes {
+		rc := change.ResourceChange
+		if rc == nil {
+			continue
+		}
+		var properties []string
+		seen := make(map[string]bool)
+		for _, detail := range rc.Details {
+			if detail.Target == nil {
+				continue
+			}
+			path := aws.StringValue(detail.Target.Attribute)
+			if name := aws.StringValue(detail.Target.Name); name != "" {
+				path = fmt.Sprintf("%s.%s", path, name)
+			}
+			if seen[path] {
+				// Several details can target the same property, e.g. a static and a dynamic evaluation.
+				continue
+			}
+			seen[path] = true
+			properties = append(properties, path)
+		}
+		resourceChanges = append(resourceChanges, &deploy.ResourceChange{
+			Resource: deploy.Resource{
+				LogicalName: aws.StringValue(rc.LogicalResourceId),
+				Type:        aws.StringValue(rc.ResourceType),
+			},
+			Action:      aws.StringValue(rc.Action),
+			Replacement: aws.StringValue(rc.Replacement),
+			Properties:  properties,
+		})
+	}
+	return resourceChanges
+}
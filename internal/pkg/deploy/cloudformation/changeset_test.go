@@ -0,0 +1,153 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecuteChangeSet(t *testing.T) {
+	mockError := errors.New("some error")
+	availableChangeSet := func(t *testing.T, in *cloudformation.DescribeChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error) {
+		return &cloudformation.DescribeChangeSetOutput{
+			ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+		}, nil
+	}
+	executeChangeSet := func(t *testing.T, in *cloudformation.ExecuteChangeSetInput) (*cloudformation.ExecuteChangeSetOutput, error) {
+		require.Equal(t, "changeSetID", *in.ChangeSetName)
+		require.Equal(t, "phonetool-test-frontend", *in.StackName)
+		return &cloudformation.ExecuteChangeSetOutput{}, nil
+	}
+
+	testCases := map[string]struct {
+		inNewStack bool
+
+		mockWaitUntilStackCreateCompleteWithContext func(t *testing.T, in *cloudformation.DescribeStacksInput) error
+		mockWaitUntilStackUpdateCompleteWithContext func(t *testing.T, in *cloudformation.DescribeStacksInput) error
+
+		wantedErr error
+	}{
+		"should wait for the stack creation of a new stack": {
+			inNewStack: true,
+			mockWaitUntilStackCreateCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeStacksInput) error {
+				return mockError
+			},
+			wantedErr: errors.New("failed to create stack phonetool-test-frontend: some error"),
+		},
+		"should wait for the stack update of an existing stack": {
+			mockWaitUntilStackUpdateCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeStacksInput) error {
+				require.Equal(t, "phonetool-test-frontend", *in.StackName)
+				return nil
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cf := CloudFormation{
+				client: mockCloudFormation{
+					t:                     t,
+					mockDescribeChangeSet: availableChangeSet,
+					mockExecuteChangeSet:  executeChangeSet,
+					mockWaitUntilStackCreateCompleteWithContext: tc.mockWaitUntilStackCreateCompleteWithContext,
+					mockWaitUntilStackUpdateCompleteWithContext: tc.mockWaitUntilStackUpdateCompleteWithContext,
+				},
+			}
+
+			err := cf.ExecuteChangeSet(&deploy.ChangeSet{
+				StackName: "phonetool-test-frontend",
+				ID:        "changeSetID",
+				NewStack:  tc.inNewStack,
+			})
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDeleteChangeSet(t *testing.T) {
+	testCases := map[string]struct {
+		inNewStack bool
+
+		wantedStackDeleted bool
+	}{
+		"should only delete the change set of an existing stack": {},
+		"should delete the stack left in review by a creation change set": {
+			inNewStack:         true,
+			wantedStackDeleted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			stackDeleted := false
+			cf := CloudFormation{
+				client: mockCloudFormation{
+					t: t,
+					mockDeleteChangeSet: func(t *testing.T, in *cloudformation.DeleteChangeSetInput) (*cloudformation.DeleteChangeSetOutput, error) {
+						require.Equal(t, "changeSetID", *in.ChangeSetName)
+						return &cloudformation.DeleteChangeSetOutput{}, nil
+					},
+					mockDeleteStack: func(t *testing.T, in *cloudformation.DeleteStackInput) (*cloudformation.DeleteStackOutput, error) {
+						require.Equal(t, "phonetool-test-frontend", *in.StackName)
+						stackDeleted = true
+						return &cloudformation.DeleteStackOutput{}, nil
+					},
+					mockWaitUntilStackDeleteCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeStacksInput) error {
+						return nil
+					},
+				},
+			}
+
+			err := cf.DeleteChangeSet(&deploy.ChangeSet{
+				StackName: "phonetool-test-frontend",
+				ID:        "changeSetID",
+				NewStack:  tc.inNewStack,
+			})
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStackDeleted, stackDeleted)
+		})
+	}
+}
+
+func TestResourceChanges(t *testing.T) {
+	changes := []*cloudformation.Change{
+		{
+			ResourceChange: &cloudformation.ResourceChange{
+				Action:            aws.String(cloudformation.ChangeActionModify),
+				LogicalResourceId: aws.String("TaskDefinition"),
+				ResourceType:      aws.String("AWS::ECS::TaskDefinition"),
+				Replacement:       aws.String(cloudformation.ReplacementTrue),
+				Details: []*cloudformation.ResourceChangeDetail{
+					{Target: &cloudformation.ResourceTargetDefinition{Attribute: aws.String("Properties"), Name: aws.String("ContainerDefinitions")}},
+					{Target: &cloudformation.ResourceTargetDefinition{Attribute: aws.String("Properties"), Name: aws.String("ContainerDefinitions")}},
+					{Target: &cloudformation.ResourceTargetDefinition{Attribute: aws.String("Tags")}},
+				},
+			},
+		},
+		{}, // Changes without a resource change are skipped.
+	}
+
+	got := resourceChanges(changes)
+
+	require.Equal(t, []*deploy.ResourceChange{
+		{
+			Resource:    deploy.Resource{LogicalName: "TaskDefinition", Type: "AWS::ECS::TaskDefinition"},
+			Action:      cloudformation.ChangeActionModify,
+			Replacement: cloudformation.ReplacementTrue,
+			Properties:  []string{"Properties.ContainerDefinitions", "Tags"},
+		},
+	}, got)
+}
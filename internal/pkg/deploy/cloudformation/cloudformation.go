@@ -84,7 +84,7 @@ func (cf CloudFormation) streamResourceEvents(done <-chan struct{}, events chan
 			return
 		}
 		var transformedEvents []deploy.ResourceEvent
-		for _, cfEvent := range cfEvents {
+		for _, cfEvent := range latestOperationEvents(stackName, cfEvents) {
 			transformedEvents = append(transformedEvents, deploy.ResourceEvent{
 				Resource: deploy.Resource{
 					LogicalName: aws.StringValue(cfEvent.LogicalResourceId),
@@ -140,6 +140,72 @@ func (cf CloudFormation) describeStackEvents(stackName string) ([]*cloudformatio
 	return events, nil
 }
 
+// latestOperationEvents returns the events of the latest creation or update of the stack, so that the
+// events of previous deployments are ignored. The events must be in chronological order.
+func latestOperationEvents(stackName string, events []*cloudformation.StackEvent) []*cloudformation.StackEvent {
+	for i := len(events) - 1; i >= 0; i-- {
+		if aws.StringValue(events[i].LogicalResourceId) != stackName {
+			continue
+		}
+		switch aws.StringValue(events[i].ResourceStatus) {
+		case cloudformation.ResourceStatusCreateInProgress, cloudformation.ResourceStatusUpdateInProgress:
+			return events[i:]
+		}
+	}
+	return events
+}
+
+// waitForStackOperation waits until the ongoing creation or update of the stack halts.
+// If the stack isn't being deployed, for example because its change set was empty, returns nil right away.
+// If the operation fails, returns an ErrStackDeploymentFailed with the first resource that failed.
+func (cf CloudFormation) waitForStackOperation(stackName string) error {
+	describeStackInput := &cloudformation.DescribeStacksInput{
+		StackName: aws.String(stackName),
+	}
+	existingStack, err := cf.describeStack(describeStackInput)
+	if err != nil {
+		return err
+	}
+	status := aws.StringValue(existingStack.StackStatus)
+	switch {
+	case status == cloudformation.StackStatusCreateInProgress:
+		err = cf.client.WaitUntilStackCreateCompleteWithContext(context.Background(), describeStackInput, cf.waiters...)
+	case StackStatus(status).InProgress():
+		err = cf.client.WaitUntilStackUpdateCompleteWithContext(context.Background(), describeStackInput, cf.waiters...)
+	default:
+		return nil
+	}
+	if err != nil {
+		return cf.deploymentFailure(stackName, err)
+	}
+	return nil
+}
+
+// deploymentFailure returns an ErrStackDeploymentFailed with the first resource that failed during the latest
+// operation on the stack. The first failure is the root cause, the following ones are usually cancellations.
+func (cf CloudFormation) deploymentFailure(stackName string, parentErr error) error {
+	failure := &ErrStackDeploymentFailed{
+		stackName: stackName,
+		parentErr: parentErr,
+	}
+	events, err := cf.describeStackEvents(stackName)
+	if err != nil {
+		return failure
+	}
+	for _, event := range latestOperationEvents(stackName, events) {
+		status := aws.StringValue(event.ResourceStatus)
+		if aws.StringValue(event.LogicalResourceId) == stackName || !strings.HasSuffix(status, "FAILED") {
+			continue
+		}
+		failure.resource = aws.StringValue(event.LogicalResourceId)
+		failure.resourceType = aws.StringValue(event.ResourceType)
+		failure.status = status
+		failure.reason = aws.StringValue(event.ResourceStatusReason)
+		break
+	}
+	return failure
+}
+
 func (cf CloudFormation) waitForStackCreation(stackConfig stackConfiguration) (*cloudformation.Stack, error) {
 	describeStackInput := &cloudformation.DescribeStacksInput{
 		StackName: aws.String(stackConfig.StackName()),
@@ -345,6 +411,15 @@ func stackSetExists(err error) bool {
 	return false
 }
 
+// stackSetDoesNotExist returns true if the underlying error is a stack set not found error.
+func stackSetDoesNotExist(err error) bool {
+	var aerr awserr.Error
+	if errors.As(err, &aerr) {
+		return aerr.Code() == cloudformation.ErrCodeStackSetNotFoundException
+	}
+	return false
+}
+
 func withDeleteRoleARN(roleARN string) func(in *cloudformation.DeleteStackInput) {
 	return func(in *cloudformation.DeleteStackInput) {
 		in.RoleARN = aws.String(roleARN)
@@ -425,6 +425,16 @@ func Test_Environment_Deployment_Integration(t *testing.T) {
 					output.OutputValue,
 					"Cluster value should not be nil")
 			},
+			"EnvironmentSecurityGroup": func(output *awsCF.Output) {
+				require.Equal(t,
+					fmt.Sprintf("%s-EnvironmentSecurityGroup", envStackName),
+					*output.ExportName,
+					"Should export EnvironmentSecurityGroup as stackname-EnvironmentSecurityGroup")
+
+				require.NotNil(t,
+					output.OutputValue,
+					"EnvironmentSecurityGroup value should not be nil")
+			},
 			"PrivateSubnets": func(output *awsCF.Output) {
 				require.Equal(t,
 					fmt.Sprintf("%s-PrivateSubnets", envStackName),
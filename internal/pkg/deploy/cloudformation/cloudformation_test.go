@@ -43,6 +43,8 @@ type mockCloudFormation struct {
 	mockUpdateStackSet                              func(t *testing.T, in *cloudformation.UpdateStackSetInput) (*cloudformation.UpdateStackSetOutput, error)
 	mockListStackInstances                          func(t *testing.T, in *cloudformation.ListStackInstancesInput) (*cloudformation.ListStackInstancesOutput, error)
 	mockCreateStackInstances                        func(t *testing.T, in *cloudformation.CreateStackInstancesInput) (*cloudformation.CreateStackInstancesOutput, error)
+	mockDeleteStackInstances                        func(t *testing.T, in *cloudformation.DeleteStackInstancesInput) (*cloudformation.DeleteStackInstancesOutput, error)
+	mockDeleteStackSet                              func(t *testing.T, in *cloudformation.DeleteStackSetInput) (*cloudformation.DeleteStackSetOutput, error)
 	mockDescribeStackSetOperation                   func(t *testing.T, in *cloudformation.DescribeStackSetOperationInput) (*cloudformation.DescribeStackSetOperationOutput, error)
 	mockDescribeStackEvents                         func(t *testing.T, in *cloudformation.DescribeStackEventsInput) (*cloudformation.DescribeStackEventsOutput, error)
 	mockCreateStack                                 func(t *testing.T, in *cloudformation.CreateStackInput) (*cloudformation.CreateStackOutput, error)
@@ -96,6 +98,14 @@ func (cf mockCloudFormation) CreateStackInstances(in *cloudformation.CreateStack
 	return cf.mockCreateStackInstances(cf.t, in)
 }
 
+func (cf mockCloudFormation) DeleteStackInstances(in *cloudformation.DeleteStackInstancesInput) (*cloudformation.DeleteStackInstancesOutput, error) {
+	return cf.mockDeleteStackInstances(cf.t, in)
+}
+
+func (cf mockCloudFormation) DeleteStackSet(in *cloudformation.DeleteStackSetInput) (*cloudformation.DeleteStackSetOutput, error) {
+	return cf.mockDeleteStackSet(cf.t, in)
+}
+
 func (cf mockCloudFormation) DescribeStackSetOperation(in *cloudformation.DescribeStackSetOperationInput) (*cloudformation.DescribeStackSetOperationOutput, error) {
 	return cf.mockDescribeStackSetOperation(cf.t, in)
 }
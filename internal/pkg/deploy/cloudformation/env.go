@@ -5,6 +5,10 @@
 package cloudformation
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/aws-sdk-go/aws"
@@ -55,6 +59,104 @@ func (cf CloudFormation) DeleteEnvironment(projectName, envName string) error {
 	return cf.delete(*out.StackId, withDeleteRoleARN(env.ExecutionRoleARN))
 }
 
+// CreateEnvironmentUpgrade creates a change set that updates the stack of the environment to the latest template version.
+// The parameters the stack was created with, such as its DNS settings, are preserved.
+//
+// If the environment already runs the latest template or the change set is empty, returns nil.
+// Otherwise, returns the pending upgrade that can be applied with ExecuteEnvironmentUpgrade or discarded
+// with CancelEnvironmentUpgrade.
+func (cf CloudFormation) CreateEnvironmentUpgrade(env *archer.Environment) (*deploy.EnvironmentUpgrade, error) {
+	conf := stack.NewEnvStackConfig(&deploy.CreateEnvironmentInput{
+		Project: env.Project,
+		Name:    env.Name,
+		Prod:    env.Prod,
+	}, cf.box)
+
+	existing, err := cf.describeStack(&cloudformation.DescribeStacksInput{
+		StackName: aws.String(conf.StackName()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if StackStatus(aws.StringValue(existing.StackStatus)).InProgress() {
+		return nil, &ErrStackUpdateInProgress{
+			stackName:   conf.StackName(),
+			stackStatus: aws.StringValue(existing.StackStatus),
+		}
+	}
+	deployed, err := conf.ToEnv(existing)
+	if err != nil {
+		return nil, err
+	}
+	if deployed.TemplateVersion == deploy.LatestEnvTemplateVersion {
+		return nil, nil
+	}
+
+	template, err := conf.Template()
+	if err != nil {
+		return nil, fmt.Errorf("template creation: %w", err)
+	}
+	var params []*cloudformation.Parameter
+	for _, param := range existing.Parameters {
+		params = append(params, &cloudformation.Parameter{
+			ParameterKey:     param.ParameterKey,
+			UsePreviousValue: aws.Bool(true),
+		})
+	}
+	in, err := createChangeSetInput(conf.StackName(), template,
+		withChangeSetType(cloudformation.ChangeSetTypeUpdate),
+		withTags(existing.Tags),
+		withParameters(params),
+		withRoleARN(deployed.ExecutionRoleARN))
+	if err != nil {
+		return nil, err
+	}
+	cs, err := cf.createChangeSetForReview(in)
+	if err != nil {
+		return nil, err
+	}
+	if cs == nil {
+		return nil, nil
+	}
+	upgrade := &deploy.EnvironmentUpgrade{
+		StackName:   cs.StackName,
+		ChangeSetID: cs.ID,
+		FromVersion: deployed.TemplateVersion,
+		ToVersion:   deploy.LatestEnvTemplateVersion,
+		Changes:     cs.Changes,
+	}
+	return upgrade, nil
+}
+
+// ExecuteEnvironmentUpgrade applies the change set of the upgrade and waits until the environment stack is updated.
+func (cf CloudFormation) ExecuteEnvironmentUpgrade(upgrade *deploy.EnvironmentUpgrade) error {
+	set := &changeSet{
+		name:    upgrade.ChangeSetID,
+		stackID: upgrade.StackName,
+		c:       cf.client,
+		waiters: cf.waiters,
+	}
+	if err := set.execute(); err != nil {
+		return err
+	}
+	if err := cf.client.WaitUntilStackUpdateCompleteWithContext(context.Background(), &cloudformation.DescribeStacksInput{
+		StackName: aws.String(upgrade.StackName),
+	}, cf.waiters...); err != nil {
+		return fmt.Errorf("failed to update stack %s: %w", upgrade.StackName, err)
+	}
+	return nil
+}
+
+// CancelEnvironmentUpgrade deletes the change set of the upgrade without applying it.
+func (cf CloudFormation) CancelEnvironmentUpgrade(upgrade *deploy.EnvironmentUpgrade) error {
+	set := &changeSet{
+		name:    upgrade.ChangeSetID,
+		stackID: upgrade.StackName,
+		c:       cf.client,
+	}
+	return set.delete()
+}
+
 // streamEnvironmentResponse sends a CreateEnvironmentResponse to the response channel once the stack creation halts.
 // The done channel is closed once this method exits to notify other streams that they should stop working.
 func (cf CloudFormation) streamEnvironmentResponse(done chan struct{}, resp chan deploy.CreateEnvironmentResponse, stack *stack.EnvStackConfig) {
@@ -136,10 +136,11 @@ func TestStreamEnvironmentCreation(t *testing.T) {
 			},
 			wantedResult: deploy.CreateEnvironmentResponse{
 				Env: &archer.Environment{
-					Project:   "phonetool",
-					Name:      "test",
-					Region:    "eu-west-3",
-					AccountID: "902697171733",
+					Project:         "phonetool",
+					Name:            "test",
+					Region:          "eu-west-3",
+					AccountID:       "902697171733",
+					TemplateVersion: deploy.LegacyEnvTemplateVersion,
 				},
 				Err: nil,
 			},
@@ -263,6 +264,239 @@ func TestCloudFormation_DeleteEnvironment(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_CreateEnvironmentUpgrade(t *testing.T) {
+	testEnv := &archer.Environment{
+		Project: "phonetool",
+		Name:    "test",
+	}
+	stackWithVersion := func(version string) *cloudformation.DescribeStacksOutput {
+		outputs := []*cloudformation.Output{
+			{
+				OutputKey:   aws.String("CFNExecutionRoleARN"),
+				OutputValue: aws.String("execution-role"),
+			},
+		}
+		if version != "" {
+			outputs = append(outputs, &cloudformation.Output{
+				OutputKey:   aws.String("TemplateVersion"),
+				OutputValue: aws.String(version),
+			})
+		}
+		return &cloudformation.DescribeStacksOutput{
+			Stacks: []*cloudformation.Stack{
+				{
+					StackId:     aws.String("arn:aws:cloudformation:us-west-2:1111:stack/phonetool-test"),
+					StackStatus: aws.String(cloudformation.StackStatusCreateComplete),
+					Parameters: []*cloudformation.Parameter{
+						{
+							ParameterKey:   aws.String("ProjectDNSName"),
+							ParameterValue: aws.String("example.com"),
+						},
+					},
+					Tags: []*cloudformation.Tag{
+						{
+							Key:   aws.String("ecs-project"),
+							Value: aws.String("phonetool"),
+						},
+					},
+					Outputs: outputs,
+				},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		mockDescribeStacks                              func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error)
+		mockCreateChangeSet                             func(t *testing.T, in *cloudformation.CreateChangeSetInput) (*cloudformation.CreateChangeSetOutput, error)
+		mockWaitUntilChangeSetCreateCompleteWithContext func(t *testing.T, in *cloudformation.DescribeChangeSetInput) error
+		mockDescribeChangeSet                           func(t *testing.T, in *cloudformation.DescribeChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error)
+		mockDeleteChangeSet                             func(t *testing.T, in *cloudformation.DeleteChangeSetInput) (*cloudformation.DeleteChangeSetOutput, error)
+
+		wantedUpgrade *deploy.EnvironmentUpgrade
+		wantedErr     error
+	}{
+		"returns nil if the environment runs the latest template": {
+			mockDescribeStacks: func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+				return stackWithVersion(deploy.LatestEnvTemplateVersion), nil
+			},
+		},
+		"returns an error if the stack is being updated": {
+			mockDescribeStacks: func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+				out := stackWithVersion("")
+				out.Stacks[0].StackStatus = aws.String(cloudformation.StackStatusUpdateInProgress)
+				return out, nil
+			},
+			wantedErr: fmt.Errorf("stack phonetool-test is currently being updated (status %s) and cannot be deployed to", cloudformation.StackStatusUpdateInProgress),
+		},
+		"returns nil if the change set is empty": {
+			mockDescribeStacks: func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+				return stackWithVersion(""), nil
+			},
+			mockCreateChangeSet: func(t *testing.T, in *cloudformation.CreateChangeSetInput) (*cloudformation.CreateChangeSetOutput, error) {
+				return &cloudformation.CreateChangeSetOutput{Id: aws.String("changeset"), StackId: aws.String("stack")}, nil
+			},
+			mockWaitUntilChangeSetCreateCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeChangeSetInput) error {
+				return errors.New("no changes")
+			},
+			mockDescribeChangeSet: func(t *testing.T, in *cloudformation.DescribeChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error) {
+				return &cloudformation.DescribeChangeSetOutput{}, nil
+			},
+			mockDeleteChangeSet: func(t *testing.T, in *cloudformation.DeleteChangeSetInput) (*cloudformation.DeleteChangeSetOutput, error) {
+				require.Equal(t, "changeset", *in.ChangeSetName)
+				return nil, nil
+			},
+		},
+		"returns the changes of a legacy environment with its previous parameters": {
+			mockDescribeStacks: func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+				require.Equal(t, "phonetool-test", *in.StackName)
+				return stackWithVersion(""), nil
+			},
+			mockCreateChangeSet: func(t *testing.T, in *cloudformation.CreateChangeSetInput) (*cloudformation.CreateChangeSetOutput, error) {
+				require.Equal(t, "phonetool-test", *in.StackName)
+				require.Equal(t, cloudformation.ChangeSetTypeUpdate, *in.ChangeSetType)
+				require.Equal(t, "execution-role", *in.RoleARN)
+				require.Equal(t, "template", *in.TemplateBody)
+				require.Equal(t, []*cloudformation.Parameter{
+					{
+						ParameterKey:     aws.String("ProjectDNSName"),
+						UsePreviousValue: aws.Bool(true),
+					},
+				}, in.Parameters)
+				require.Equal(t, "phonetool", *in.Tags[0].Value)
+				return &cloudformation.CreateChangeSetOutput{Id: aws.String("changeset"), StackId: aws.String("stack")}, nil
+			},
+			mockWaitUntilChangeSetCreateCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeChangeSetInput) error {
+				return nil
+			},
+			mockDescribeChangeSet: func(t *testing.T, in *cloudformation.DescribeChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error) {
+				return &cloudformation.DescribeChangeSetOutput{
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+					Changes: []*cloudformation.Change{
+						{
+							ResourceChange: &cloudformation.ResourceChange{
+								Action:            aws.String(cloudformation.ChangeActionModify),
+								LogicalResourceId: aws.String("Cluster"),
+								ResourceType:      aws.String("AWS::ECS::Cluster"),
+								Replacement:       aws.String(cloudformation.ReplacementFalse),
+							},
+						},
+					},
+				}, nil
+			},
+			wantedUpgrade: &deploy.EnvironmentUpgrade{
+				StackName:   "phonetool-test",
+				ChangeSetID: "changeset",
+				FromVersion: deploy.LegacyEnvTemplateVersion,
+				ToVersion:   deploy.LatestEnvTemplateVersion,
+				Changes: []*deploy.ResourceChange{
+					{
+						Resource: deploy.Resource{
+							LogicalName: "Cluster",
+							Type:        "AWS::ECS::Cluster",
+						},
+						Action:      cloudformation.ChangeActionModify,
+						Replacement: cloudformation.ReplacementFalse,
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			box := packd.NewMemoryBox()
+			box.AddString("environment/cf.yml", "template")
+			box.AddString("custom-resources/dns-cert-validator.js", "customresources")
+			box.AddString("custom-resources/dns-delegation.js", "customresources")
+			cf := CloudFormation{
+				client: &mockCloudFormation{
+					t:                   t,
+					mockDescribeStacks:  tc.mockDescribeStacks,
+					mockCreateChangeSet: tc.mockCreateChangeSet,
+					mockWaitUntilChangeSetCreateCompleteWithContext: tc.mockWaitUntilChangeSetCreateCompleteWithContext,
+					mockDescribeChangeSet:                           tc.mockDescribeChangeSet,
+					mockDeleteChangeSet:                             tc.mockDeleteChangeSet,
+				},
+				box: box,
+			}
+
+			// WHEN
+			upgrade, err := cf.CreateEnvironmentUpgrade(testEnv)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedUpgrade, upgrade)
+		})
+	}
+}
+
+func TestCloudFormation_ExecuteEnvironmentUpgrade(t *testing.T) {
+	upgrade := &deploy.EnvironmentUpgrade{
+		StackName:   "phonetool-test",
+		ChangeSetID: "changeset",
+	}
+	testCases := map[string]struct {
+		mockExecuteChangeSet                        func(t *testing.T, in *cloudformation.ExecuteChangeSetInput) (*cloudformation.ExecuteChangeSetOutput, error)
+		mockWaitUntilStackUpdateCompleteWithContext func(t *testing.T, in *cloudformation.DescribeStacksInput) error
+
+		wantedErr error
+	}{
+		"returns an error if the update fails": {
+			mockExecuteChangeSet: func(t *testing.T, in *cloudformation.ExecuteChangeSetInput) (*cloudformation.ExecuteChangeSetOutput, error) {
+				return nil, nil
+			},
+			mockWaitUntilStackUpdateCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeStacksInput) error {
+				return errors.New("some error")
+			},
+			wantedErr: errors.New("failed to update stack phonetool-test: some error"),
+		},
+		"executes the change set and waits for the update": {
+			mockExecuteChangeSet: func(t *testing.T, in *cloudformation.ExecuteChangeSetInput) (*cloudformation.ExecuteChangeSetOutput, error) {
+				require.Equal(t, "changeset", *in.ChangeSetName)
+				require.Equal(t, "phonetool-test", *in.StackName)
+				return nil, nil
+			},
+			mockWaitUntilStackUpdateCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeStacksInput) error {
+				require.Equal(t, "phonetool-test", *in.StackName)
+				return nil
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			cf := CloudFormation{
+				client: &mockCloudFormation{
+					t: t,
+					mockDescribeChangeSet: func(t *testing.T, in *cloudformation.DescribeChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error) {
+						return &cloudformation.DescribeChangeSetOutput{
+							ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+						}, nil
+					},
+					mockExecuteChangeSet:                        tc.mockExecuteChangeSet,
+					mockWaitUntilStackUpdateCompleteWithContext: tc.mockWaitUntilStackUpdateCompleteWithContext,
+				},
+			}
+
+			// WHEN
+			err := cf.ExecuteEnvironmentUpgrade(upgrade)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func emptyEnvBox() packd.Box {
 	return packd.NewMemoryBox()
 }
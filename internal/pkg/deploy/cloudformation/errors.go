@@ -96,3 +96,26 @@ func (err *ErrStackSetOutOfDate) Is(target error) bool {
 	}
 	return err.projectName == t.projectName
 }
+
+// ErrStackDeploymentFailed occurs when the creation or the update of a stack fails.
+// It holds the first resource that failed and the reason given by CloudFormation, if there is one.
+type ErrStackDeploymentFailed struct {
+	stackName    string
+	resource     string // Logical ID of the first resource that failed.
+	resourceType string
+	status       string
+	reason       string
+	parentErr    error
+}
+
+func (err *ErrStackDeploymentFailed) Error() string {
+	if err.resource == "" {
+		return fmt.Sprintf("deploy stack %s: %v", err.stackName, err.parentErr)
+	}
+	return fmt.Sprintf("deploy stack %s: resource %s of type %s is %s: %s", err.stackName, err.resource, err.resourceType, err.status, err.reason)
+}
+
+// Unwrap returns the original error of the waiter.
+func (err *ErrStackDeploymentFailed) Unwrap() error {
+	return err.parentErr
+}
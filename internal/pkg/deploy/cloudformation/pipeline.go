@@ -6,9 +6,9 @@
 package cloudformation
 
 import (
-	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
@@ -16,7 +16,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 )
 
-// DeployPipeline sets up a CodePipeline for deploying applications.
+// stackSetInstancePrefix is the prefix of the names of the stacks created by a StackSet.
+const stackSetInstancePrefix = "StackSet-"
+
+// DeployPipeline sets up a CodePipeline for deploying applications by creating the pipeline's stack,
+// or updating it if it already exists. It doesn't wait for the stack operation to complete,
+// use StreamPipelineCreation to follow it.
 // Project-level regional resources (such as KMS keys for de/encrypting &
 // S3 buckets for storing pipeline artifacts) should be provisioned using
 // `AddPipelineResourcesToProject()` before calling this function.
@@ -25,25 +30,108 @@ func (cf CloudFormation) DeployPipeline(in *deploy.CreatePipelineInput) error {
 
 	// First attempt to create the pipeline stack
 	err := cf.create(pipelineConfig)
-	if err == nil {
-		_, err := cf.waitForStackCreation(pipelineConfig)
-		if err != nil {
-			return err
-		}
-	}
-
-	// If the stack already exists - we update it
 	var alreadyExists *ErrStackAlreadyExists
 	if !errors.As(err, &alreadyExists) {
 		return err
 	}
 
+	// If the stack already exists - we update it
 	if err := cf.update(pipelineConfig); err != nil {
 		return fmt.Errorf("updating pipeline: %w", err)
 	}
+	return nil
+}
+
+// StreamPipelineCreation streams resource update events while the pipeline's stack is created or updated.
+// Once the CloudFormation stack operation halts, the update channel is closed and a
+// CreatePipelineResponse is sent to the second channel.
+func (cf CloudFormation) StreamPipelineCreation(in *deploy.CreatePipelineInput) (<-chan []deploy.ResourceEvent, <-chan deploy.CreatePipelineResponse) {
+	done := make(chan struct{})
+	events := make(chan []deploy.ResourceEvent)
+	resp := make(chan deploy.CreatePipelineResponse, 1)
+
+	stackName := stack.NewPipelineStackConfig(in).StackName()
+	go cf.streamResourceEvents(done, events, stackName)
+	go cf.streamPipelineResponse(done, resp, stackName)
+	return events, resp
+}
+
+// streamPipelineResponse sends a CreatePipelineResponse to the response channel once the stack operation halts.
+// The done channel is closed once this method exits to notify other streams that they should stop working.
+func (cf CloudFormation) streamPipelineResponse(done chan struct{}, resp chan deploy.CreatePipelineResponse, stackName string) {
+	defer close(done)
+	resp <- deploy.CreatePipelineResponse{
+		Err: cf.waitForStackOperation(stackName),
+	}
+}
+
+// CreatePipelineChangeSet creates a change set that deploys the pipeline, creating its stack if it doesn't exist,
+// and returns its changes for review. The change set is not executed.
+//
+// If the pipeline is unchanged, returns nil.
+func (cf CloudFormation) CreatePipelineChangeSet(in *deploy.CreatePipelineInput) (*deploy.ChangeSet, error) {
+	pipelineConfig := stack.NewPipelineStackConfig(in)
+	csType, err := cf.changeSetType(pipelineConfig.StackName())
+	if err != nil {
+		return nil, err
+	}
+	template, err := pipelineConfig.Template()
+	if err != nil {
+		return nil, fmt.Errorf("template creation: %w", err)
+	}
+	csIn, err := createChangeSetInput(pipelineConfig.StackName(), template,
+		withChangeSetType(csType),
+		withTags(pipelineConfig.Tags()),
+		withParameters(pipelineConfig.Parameters()))
+	if err != nil {
+		return nil, err
+	}
+	return cf.createChangeSetForReview(csIn)
+}
+
+// ListPipelineStacks returns the names of the pipeline stacks deployed for the project.
+// Pipeline stacks are the only project stacks that are not tagged with an environment,
+// apart from the project stack and the StackSet's stack instances.
+func (cf CloudFormation) ListPipelineStacks(projectName string) ([]string, error) {
+	projectStackName := stack.NewProjectStackConfig(&deploy.CreateProjectInput{
+		Project: projectName,
+	}, cf.box).StackName()
+
+	var names []string
+	var nextToken *string
+	for {
+		out, err := cf.client.DescribeStacks(&cloudformation.DescribeStacksInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe stacks: %w", err)
+		}
+		for _, s := range out.Stacks {
+			name := aws.StringValue(s.StackName)
+			if name == projectStackName || strings.HasPrefix(name, stackSetInstancePrefix) {
+				continue
+			}
+			tags := make(map[string]string)
+			for _, t := range s.Tags {
+				tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+			}
+			if tags[stack.ProjectTagKey] != projectName {
+				continue
+			}
+			if _, ok := tags[stack.EnvTagKey]; ok {
+				continue
+			}
+			names = append(names, name)
+		}
+		nextToken = out.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	return names, nil
+}
 
-	return cf.client.WaitUntilStackUpdateCompleteWithContext(context.Background(),
-		&cloudformation.DescribeStacksInput{
-			StackName: aws.String(pipelineConfig.StackName()),
-		}, cf.waiters...)
+// DeletePipeline deletes the CloudFormation stack of a pipeline.
+func (cf CloudFormation) DeletePipeline(stackName string) error {
+	return cf.delete(stackName)
 }
@@ -188,6 +188,9 @@ func TestPipelineCreation(t *testing.T) {
 			ArtifactBuckets: artifactBuckets,
 		}
 		require.NoError(t, projectDeployer.DeployPipeline(pipelineInput))
+		_, responses := projectDeployer.StreamPipelineCreation(pipelineInput)
+		resp := <-responses
+		require.NoError(t, resp.Err)
 
 		// Ensure that the new stack exists
 		assertStackExists(t, projCfClient, pipelineStackName)
@@ -0,0 +1,215 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListPipelineStacks(t *testing.T) {
+	projectTag := &cloudformation.Tag{Key: aws.String("ecs-project"), Value: aws.String("phonetool")}
+	testCases := map[string]struct {
+		mockDescribeStacks func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error)
+
+		wantedNames []string
+		wantedErr   error
+	}{
+		"wraps error from DescribeStacks": {
+			mockDescribeStacks: func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedErr: errors.New("describe stacks: some error"),
+		},
+		"returns only the pipeline stacks of the project": {
+			mockDescribeStacks: func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+				if in.NextToken == nil {
+					return &cloudformation.DescribeStacksOutput{
+						Stacks: []*cloudformation.Stack{
+							{StackName: aws.String("phonetool-infrastructure-roles"), Tags: []*cloudformation.Tag{projectTag}},
+							{StackName: aws.String("StackSet-phonetool-infrastructure-1234"), Tags: []*cloudformation.Tag{projectTag}},
+							{StackName: aws.String("phonetool-test"), Tags: []*cloudformation.Tag{
+								projectTag,
+								{Key: aws.String("ecs-environment"), Value: aws.String("test")},
+							}},
+						},
+						NextToken: aws.String("next"),
+					}, nil
+				}
+				return &cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{StackName: aws.String("phonetool-pipeline-phonetool-frontend"), Tags: []*cloudformation.Tag{projectTag}},
+						{StackName: aws.String("other-pipeline-other-frontend"), Tags: []*cloudformation.Tag{
+							{Key: aws.String("ecs-project"), Value: aws.String("other")},
+						}},
+						{StackName: aws.String("unrelated")},
+					},
+				}, nil
+			},
+			wantedNames: []string{"phonetool-pipeline-phonetool-frontend"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cf := CloudFormation{
+				client: &mockCloudFormation{
+					t:                  t,
+					mockDescribeStacks: tc.mockDescribeStacks,
+				},
+				box: boxWithTemplateFile(),
+			}
+
+			names, err := cf.ListPipelineStacks("phonetool")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedNames, names)
+		})
+	}
+}
+
+func TestStreamPipelineCreation(t *testing.T) {
+	const stackName = "phonetool-pipeline-frontend"
+	stackEvent := func(logicalID, resourceType, status, reason string) *cloudformation.StackEvent {
+		return &cloudformation.StackEvent{
+			LogicalResourceId:    aws.String(logicalID),
+			ResourceType:         aws.String(resourceType),
+			ResourceStatus:       aws.String(status),
+			ResourceStatusReason: aws.String(reason),
+		}
+	}
+	describeStacksWithStatus := func(status string) func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+		return func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+			require.Equal(t, stackName, aws.StringValue(in.StackName))
+			return &cloudformation.DescribeStacksOutput{
+				Stacks: []*cloudformation.Stack{
+					{StackName: aws.String(stackName), StackStatus: aws.String(status)},
+				},
+			}, nil
+		}
+	}
+
+	testCases := map[string]struct {
+		mockDescribeStacks                          func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error)
+		mockWaitUntilStackCreateCompleteWithContext func(t *testing.T, in *cloudformation.DescribeStacksInput) error
+		mockWaitUntilStackUpdateCompleteWithContext func(t *testing.T, in *cloudformation.DescribeStacksInput) error
+		mockDescribeStackEvents                     func(t *testing.T, in *cloudformation.DescribeStackEventsInput) (*cloudformation.DescribeStackEventsOutput, error)
+
+		wantedEvents []deploy.ResourceEvent
+		wantedErr    error
+	}{
+		"returns the first resource that failed during the update": {
+			mockDescribeStacks: describeStacksWithStatus(cloudformation.StackStatusUpdateInProgress),
+			mockWaitUntilStackUpdateCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeStacksInput) error {
+				require.Equal(t, stackName, aws.StringValue(in.StackName))
+				return errors.New("waiter state transitioned to Failure")
+			},
+			mockDescribeStackEvents: func(t *testing.T, in *cloudformation.DescribeStackEventsInput) (*cloudformation.DescribeStackEventsOutput, error) {
+				// Stack events are returned in reverse chronological order.
+				return &cloudformation.DescribeStackEventsOutput{
+					StackEvents: []*cloudformation.StackEvent{
+						stackEvent("IntegTestProjecttest", "AWS::CodeBuild::Project", cloudformation.ResourceStatusUpdateFailed, "Resource update cancelled"),
+						stackEvent("BuildProject", "AWS::CodeBuild::Project", cloudformation.ResourceStatusUpdateFailed, "Invalid compute type. Status Code: 400"),
+						stackEvent(stackName, "AWS::CloudFormation::Stack", cloudformation.ResourceStatusUpdateInProgress, "User Initiated"),
+						stackEvent("PipelineRole", "AWS::IAM::Role", cloudformation.ResourceStatusCreateFailed, "Role already exists"),
+						stackEvent(stackName, "AWS::CloudFormation::Stack", cloudformation.ResourceStatusCreateInProgress, "User Initiated"),
+					},
+				}, nil
+			},
+			wantedEvents: []deploy.ResourceEvent{
+				{
+					Resource:     deploy.Resource{LogicalName: stackName, Type: "AWS::CloudFormation::Stack"},
+					Status:       cloudformation.ResourceStatusUpdateInProgress,
+					StatusReason: "User Initiated",
+				},
+				{
+					Resource:     deploy.Resource{LogicalName: "BuildProject", Type: "AWS::CodeBuild::Project"},
+					Status:       cloudformation.ResourceStatusUpdateFailed,
+					StatusReason: "Invalid compute type",
+				},
+				{
+					Resource:     deploy.Resource{LogicalName: "IntegTestProjecttest", Type: "AWS::CodeBuild::Project"},
+					Status:       cloudformation.ResourceStatusUpdateFailed,
+					StatusReason: "Resource update cancelled",
+				},
+			},
+			wantedErr: errors.New("deploy stack phonetool-pipeline-frontend: resource BuildProject of type AWS::CodeBuild::Project is UPDATE_FAILED: Invalid compute type. Status Code: 400"),
+		},
+		"returns the waiter error if the failed resource can't be found": {
+			mockDescribeStacks: describeStacksWithStatus(cloudformation.StackStatusCreateInProgress),
+			mockWaitUntilStackCreateCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeStacksInput) error {
+				return errors.New("waiter state transitioned to Failure")
+			},
+			mockDescribeStackEvents: func(t *testing.T, in *cloudformation.DescribeStackEventsInput) (*cloudformation.DescribeStackEventsOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedErr: errors.New("deploy stack phonetool-pipeline-frontend: waiter state transitioned to Failure"),
+		},
+		"doesn't wait if the stack isn't being deployed": {
+			mockDescribeStacks: describeStacksWithStatus(cloudformation.StackStatusUpdateComplete),
+			mockDescribeStackEvents: func(t *testing.T, in *cloudformation.DescribeStackEventsInput) (*cloudformation.DescribeStackEventsOutput, error) {
+				return &cloudformation.DescribeStackEventsOutput{}, nil
+			},
+		},
+		"waits for the creation of the stack": {
+			mockDescribeStacks: describeStacksWithStatus(cloudformation.StackStatusCreateInProgress),
+			mockWaitUntilStackCreateCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeStacksInput) error {
+				require.Equal(t, stackName, aws.StringValue(in.StackName))
+				return nil
+			},
+			mockDescribeStackEvents: func(t *testing.T, in *cloudformation.DescribeStackEventsInput) (*cloudformation.DescribeStackEventsOutput, error) {
+				return &cloudformation.DescribeStackEventsOutput{
+					StackEvents: []*cloudformation.StackEvent{
+						stackEvent("Pipeline", "AWS::CodePipeline::Pipeline", cloudformation.ResourceStatusCreateComplete, ""),
+					},
+				}, nil
+			},
+			wantedEvents: []deploy.ResourceEvent{
+				{
+					Resource: deploy.Resource{LogicalName: "Pipeline", Type: "AWS::CodePipeline::Pipeline"},
+					Status:   cloudformation.ResourceStatusCreateComplete,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			cf := CloudFormation{
+				client: &mockCloudFormation{
+					t:                       t,
+					mockDescribeStacks:      tc.mockDescribeStacks,
+					mockDescribeStackEvents: tc.mockDescribeStackEvents,
+					mockWaitUntilStackCreateCompleteWithContext: tc.mockWaitUntilStackCreateCompleteWithContext,
+					mockWaitUntilStackUpdateCompleteWithContext: tc.mockWaitUntilStackUpdateCompleteWithContext,
+				},
+			}
+
+			// WHEN
+			events, resp := cf.StreamPipelineCreation(&deploy.CreatePipelineInput{
+				ProjectName: "phonetool",
+				Name:        "pipeline-frontend",
+			})
+
+			// THEN
+			require.Equal(t, tc.wantedEvents, <-events)
+			got := <-resp
+			if tc.wantedErr != nil {
+				require.EqualError(t, got.Err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, got.Err)
+		})
+	}
+}
@@ -119,6 +119,69 @@ func (cf CloudFormation) GetRegionalProjectResources(project *archer.Project) ([
 	return resources, nil
 }
 
+// ProjectStacks holds the status of the stacks of a project.
+type ProjectStacks struct {
+	StackStatus StackStatus // Status of the project's stack, empty if it doesn't exist.
+	Instances   []*ProjectStackInstance
+}
+
+// ProjectStackInstance is an instance of the project's StackSet in a region.
+type ProjectStackInstance struct {
+	Region       string
+	Status       string // Status of the instance in the StackSet: CURRENT, OUTDATED or INOPERABLE.
+	StatusReason string
+	StackStatus  StackStatus // Status of the instance's stack, empty if it doesn't exist.
+}
+
+// DescribeProjectStacks returns the status of the project's stack and of the stack instances of its StackSet.
+func (cf CloudFormation) DescribeProjectStacks(project *archer.Project) (*ProjectStacks, error) {
+	projectConfig := stack.NewProjectStackConfig(&deploy.CreateProjectInput{
+		Project:   project.Name,
+		AccountID: project.AccountID}, cf.box)
+	stacks := &ProjectStacks{}
+	projectStack, err := cf.describeStack(&cloudformation.DescribeStacksInput{
+		StackName: aws.String(projectConfig.StackName()),
+	})
+	var errNotFound *ErrStackNotFound
+	if err != nil && !errors.As(err, &errNotFound) {
+		return nil, fmt.Errorf("describe stack %s: %w", projectConfig.StackName(), err)
+	}
+	if projectStack != nil {
+		stacks.StackStatus = StackStatus(aws.StringValue(projectStack.StackStatus))
+	}
+
+	stackInstances, err := cf.client.ListStackInstances(&cloudformation.ListStackInstancesInput{
+		StackSetName:         aws.String(projectConfig.StackSetName()),
+		StackInstanceAccount: aws.String(project.AccountID),
+	})
+	if err != nil {
+		if stackSetDoesNotExist(err) {
+			return stacks, nil
+		}
+		return nil, fmt.Errorf("listing stack instances: %w", err)
+	}
+	for _, summary := range stackInstances.Summaries {
+		instance := &ProjectStackInstance{
+			Region:       aws.StringValue(summary.Region),
+			Status:       aws.StringValue(summary.Status),
+			StatusReason: aws.StringValue(summary.StatusReason),
+		}
+		if summary.StackId != nil {
+			instanceStack, err := cf.describeStackWithClient(&cloudformation.DescribeStacksInput{
+				StackName: summary.StackId,
+			}, cf.regionalClientProvider.Client(instance.Region))
+			if err != nil && !errors.As(err, &errNotFound) {
+				return nil, fmt.Errorf("describe stack %s in region %s: %w", aws.StringValue(summary.StackId), instance.Region, err)
+			}
+			if instanceStack != nil {
+				instance.StackStatus = StackStatus(aws.StringValue(instanceStack.StackStatus))
+			}
+		}
+		stacks.Instances = append(stacks.Instances, instance)
+	}
+	return stacks, nil
+}
+
 func (cf CloudFormation) getResourcesForStackInstances(project *archer.Project, region *string) ([]*archer.ProjectRegionalResources, error) {
 	projectConfig := stack.NewProjectStackConfig(&deploy.CreateProjectInput{
 		Project:   project.Name,
@@ -135,6 +198,10 @@ func (cf CloudFormation) getResourcesForStackInstances(project *archer.Project,
 	stackInstances, err := cf.client.ListStackInstances(listStackInstancesInput)
 
 	if err != nil {
+		if stackSetDoesNotExist(err) {
+			// The project's regional resources have already been deleted.
+			return nil, nil
+		}
 		return nil, fmt.Errorf("listing stack instances: %w", err)
 	}
 
@@ -207,6 +274,45 @@ func (cf CloudFormation) AddAppToProject(project *archer.Project, appName string
 	return nil
 }
 
+// RemoveAppFromProject removes the App specific resources, like the ECR repo, from the Project resource stack.
+// The ECR repo must be empty to be deleted.
+func (cf CloudFormation) RemoveAppFromProject(project *archer.Project, appName string) error {
+	projectConfig := stack.NewProjectStackConfig(&deploy.CreateProjectInput{
+		Project:   project.Name,
+		AccountID: project.AccountID,
+	}, cf.box)
+	previouslyDeployedConfig, err := cf.getLastDeployedProjectConfig(projectConfig)
+	if err != nil {
+		return fmt.Errorf("removing %s app resources from project %s: %w", appName, project.Name, err)
+	}
+
+	var appList []string
+	shouldRemoveApp := false
+	for _, app := range previouslyDeployedConfig.Apps {
+		if app == appName {
+			shouldRemoveApp = true
+			continue
+		}
+		appList = append(appList, app)
+	}
+
+	if !shouldRemoveApp {
+		return nil
+	}
+
+	newDeploymentConfig := stack.ProjectResourcesConfig{
+		Version:  previouslyDeployedConfig.Version + 1,
+		Apps:     appList,
+		Accounts: previouslyDeployedConfig.Accounts,
+		Project:  projectConfig.Project,
+	}
+	if err := cf.deployProjectConfig(projectConfig, &newDeploymentConfig); err != nil {
+		return fmt.Errorf("removing %s app resources from project: %w", appName, err)
+	}
+
+	return nil
+}
+
 // AddEnvToProject takes a new environment and updates the Project configuration
 // with new Account IDs in resource policies (KMS Keys and ECR Repos) - and
 // sets up a new stack instance if the environment is in a new region.
@@ -361,12 +467,74 @@ func (cf CloudFormation) addNewProjectStackInstances(projectConfig *stack.Projec
 	return cf.waitForStackSetOperation(projectConfig.StackSetName(), *createStacksOutput.OperationId)
 }
 
+// DeleteProjectResources deletes the project's regional stack instances, and the ECR repositories, KMS keys and
+// S3 buckets they contain, and then the StackSet itself. The ECR repositories must be empty to be deleted.
+// If the StackSet does not exist then returns nil.
+func (cf CloudFormation) DeleteProjectResources(projectName string) error {
+	projectConfig := stack.NewProjectStackConfig(&deploy.CreateProjectInput{
+		Project: projectName,
+	}, cf.box)
+	stackInstances, err := cf.client.ListStackInstances(&cloudformation.ListStackInstancesInput{
+		StackSetName: aws.String(projectConfig.StackSetName()),
+	})
+	if err != nil {
+		if stackSetDoesNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("list stack instances of stack set %s: %w", projectConfig.StackSetName(), err)
+	}
+
+	if len(stackInstances.Summaries) > 0 {
+		accounts, regions := make(map[string]bool), make(map[string]bool)
+		in := &cloudformation.DeleteStackInstancesInput{
+			StackSetName: aws.String(projectConfig.StackSetName()),
+			RetainStacks: aws.Bool(false),
+		}
+		for _, stackInstance := range stackInstances.Summaries {
+			if !accounts[aws.StringValue(stackInstance.Account)] {
+				accounts[aws.StringValue(stackInstance.Account)] = true
+				in.Accounts = append(in.Accounts, stackInstance.Account)
+			}
+			if !regions[aws.StringValue(stackInstance.Region)] {
+				regions[aws.StringValue(stackInstance.Region)] = true
+				in.Regions = append(in.Regions, stackInstance.Region)
+			}
+		}
+		out, err := cf.client.DeleteStackInstances(in)
+		if err != nil {
+			return fmt.Errorf("delete stack instances of stack set %s: %w", projectConfig.StackSetName(), err)
+		}
+		if err := cf.waitForStackSetOperation(projectConfig.StackSetName(), aws.StringValue(out.OperationId)); err != nil {
+			return err
+		}
+	}
+
+	if _, err := cf.client.DeleteStackSet(&cloudformation.DeleteStackSetInput{
+		StackSetName: aws.String(projectConfig.StackSetName()),
+	}); err != nil && !stackSetDoesNotExist(err) {
+		return fmt.Errorf("delete stack set %s: %w", projectConfig.StackSetName(), err)
+	}
+	return nil
+}
+
+// DeleteProjectStack deletes the project stack which holds the StackSet roles, the DNS delegation role
+// and the project's hosted zone.
+func (cf CloudFormation) DeleteProjectStack(projectName string) error {
+	projectConfig := stack.NewProjectStackConfig(&deploy.CreateProjectInput{
+		Project: projectName,
+	}, cf.box)
+	return cf.delete(projectConfig.StackName())
+}
+
 func (cf CloudFormation) getLastDeployedProjectConfig(projectConfig *stack.ProjectStackConfig) (*stack.ProjectResourcesConfig, error) {
 	// Check the existing deploy stack template. From that template, we'll parse out the list of apps and accounts that
 	// are deployed in the stack.
 	describeOutput, err := cf.client.DescribeStackSet(&cloudformation.DescribeStackSetInput{
 		StackSetName: aws.String(projectConfig.StackSetName()),
 	})
+	if err != nil {
+		return nil, fmt.Errorf("describe stack set %s: %w", projectConfig.StackSetName(), err)
+	}
 	previouslyDeployedConfig, err := stack.ProjectConfigFrom(describeOutput.StackSet.TemplateBody)
 	if err != nil {
 		return nil, fmt.Errorf("parsing previous deployed stackset %w", err)
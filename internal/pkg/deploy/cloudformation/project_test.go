@@ -672,6 +672,189 @@ func TestAddAppToProject(t *testing.T) {
 	}
 }
 
+func TestRemoveAppFromProject(t *testing.T) {
+	mockProject := &archer.Project{
+		Name:      "testproject",
+		AccountID: "1234",
+	}
+	testCases := map[string]struct {
+		app                  string
+		mockDescribeStackSet func(t *testing.T, in *cloudformation.DescribeStackSetInput) (*cloudformation.DescribeStackSetOutput, error)
+		mockUpdateStackSet   func(t *testing.T, in *cloudformation.UpdateStackSetInput) (*cloudformation.UpdateStackSetOutput, error)
+		want                 error
+	}{
+		"wraps error if the stack set cannot be described": {
+			app: "test",
+			mockDescribeStackSet: func(t *testing.T, in *cloudformation.DescribeStackSetInput) (*cloudformation.DescribeStackSetOutput, error) {
+				return nil, errors.New("some error")
+			},
+			want: errors.New("removing test app resources from project testproject: describe stack set testproject-infrastructure: some error"),
+		},
+		"removes the app from the project": {
+			app: "test",
+			mockDescribeStackSet: func(t *testing.T, in *cloudformation.DescribeStackSetInput) (*cloudformation.DescribeStackSetOutput, error) {
+				body, err := yaml.Marshal(stack.DeployedProjectMetadata{Metadata: stack.ProjectResourcesConfig{
+					Apps:     []string{"firsttest", "test"},
+					Accounts: []string{"5678"},
+					Version:  2,
+				}})
+				require.NoError(t, err)
+				return &cloudformation.DescribeStackSetOutput{
+					StackSet: &cloudformation.StackSet{
+						TemplateBody: aws.String(string(body)),
+					},
+				}, nil
+			},
+			mockUpdateStackSet: func(t *testing.T, in *cloudformation.UpdateStackSetInput) (*cloudformation.UpdateStackSetOutput, error) {
+				require.Equal(t, "3", *in.OperationId)
+				configToDeploy, err := stack.ProjectConfigFrom(in.TemplateBody)
+				require.NoError(t, err)
+				require.ElementsMatch(t, []string{"firsttest"}, configToDeploy.Apps)
+				require.ElementsMatch(t, []string{"5678"}, configToDeploy.Accounts)
+				require.Equal(t, 3, configToDeploy.Version)
+				return &cloudformation.UpdateStackSetOutput{
+					OperationId: aws.String("3"),
+				}, nil
+			},
+		},
+		"does not update the stack set if the app was already removed": {
+			app: "test",
+			mockDescribeStackSet: func(t *testing.T, in *cloudformation.DescribeStackSetInput) (*cloudformation.DescribeStackSetOutput, error) {
+				body, err := yaml.Marshal(stack.DeployedProjectMetadata{Metadata: stack.ProjectResourcesConfig{
+					Apps:    []string{"firsttest"},
+					Version: 1,
+				}})
+				require.NoError(t, err)
+				return &cloudformation.DescribeStackSetOutput{
+					StackSet: &cloudformation.StackSet{
+						TemplateBody: aws.String(string(body)),
+					},
+				}, nil
+			},
+			mockUpdateStackSet: func(t *testing.T, in *cloudformation.UpdateStackSetInput) (*cloudformation.UpdateStackSetOutput, error) {
+				t.FailNow()
+				return nil, nil
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cf := CloudFormation{
+				client: &mockCloudFormation{
+					t:                    t,
+					mockDescribeStackSet: tc.mockDescribeStackSet,
+					mockUpdateStackSet:   tc.mockUpdateStackSet,
+					mockDescribeStackSetOperation: func(t *testing.T, in *cloudformation.DescribeStackSetOperationInput) (*cloudformation.DescribeStackSetOperationOutput, error) {
+						return &cloudformation.DescribeStackSetOperationOutput{
+							StackSetOperation: &cloudformation.StackSetOperation{
+								Status: aws.String("SUCCEEDED"),
+							},
+						}, nil
+					},
+				},
+				box: templates.Box(),
+			}
+
+			got := cf.RemoveAppFromProject(mockProject, tc.app)
+
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
+func TestDeleteProjectResources(t *testing.T) {
+	testCases := map[string]struct {
+		mockListStackInstances   func(t *testing.T, in *cloudformation.ListStackInstancesInput) (*cloudformation.ListStackInstancesOutput, error)
+		mockDeleteStackInstances func(t *testing.T, in *cloudformation.DeleteStackInstancesInput) (*cloudformation.DeleteStackInstancesOutput, error)
+		mockDeleteStackSet       func(t *testing.T, in *cloudformation.DeleteStackSetInput) (*cloudformation.DeleteStackSetOutput, error)
+
+		wantedErr error
+	}{
+		"returns nil if the stack set does not exist": {
+			mockListStackInstances: func(t *testing.T, in *cloudformation.ListStackInstancesInput) (*cloudformation.ListStackInstancesOutput, error) {
+				return nil, awserr.New(cloudformation.ErrCodeStackSetNotFoundException, "not found", nil)
+			},
+		},
+		"wraps error if the stack instances cannot be deleted": {
+			mockListStackInstances: func(t *testing.T, in *cloudformation.ListStackInstancesInput) (*cloudformation.ListStackInstancesOutput, error) {
+				return &cloudformation.ListStackInstancesOutput{
+					Summaries: []*cloudformation.StackInstanceSummary{
+						{Account: aws.String("1234"), Region: aws.String("us-west-2")},
+					},
+				}, nil
+			},
+			mockDeleteStackInstances: func(t *testing.T, in *cloudformation.DeleteStackInstancesInput) (*cloudformation.DeleteStackInstancesOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedErr: errors.New("delete stack instances of stack set phonetool-infrastructure: some error"),
+		},
+		"deletes the stack instances in every region and then the stack set": {
+			mockListStackInstances: func(t *testing.T, in *cloudformation.ListStackInstancesInput) (*cloudformation.ListStackInstancesOutput, error) {
+				require.Equal(t, "phonetool-infrastructure", aws.StringValue(in.StackSetName))
+				return &cloudformation.ListStackInstancesOutput{
+					Summaries: []*cloudformation.StackInstanceSummary{
+						{Account: aws.String("1234"), Region: aws.String("us-west-2")},
+						{Account: aws.String("1234"), Region: aws.String("us-east-1")},
+					},
+				}, nil
+			},
+			mockDeleteStackInstances: func(t *testing.T, in *cloudformation.DeleteStackInstancesInput) (*cloudformation.DeleteStackInstancesOutput, error) {
+				require.Equal(t, []*string{aws.String("1234")}, in.Accounts)
+				require.Equal(t, []*string{aws.String("us-west-2"), aws.String("us-east-1")}, in.Regions)
+				require.False(t, aws.BoolValue(in.RetainStacks))
+				return &cloudformation.DeleteStackInstancesOutput{OperationId: aws.String("1")}, nil
+			},
+			mockDeleteStackSet: func(t *testing.T, in *cloudformation.DeleteStackSetInput) (*cloudformation.DeleteStackSetOutput, error) {
+				require.Equal(t, "phonetool-infrastructure", aws.StringValue(in.StackSetName))
+				return &cloudformation.DeleteStackSetOutput{}, nil
+			},
+		},
+		"wraps error if the stack set cannot be deleted": {
+			mockListStackInstances: func(t *testing.T, in *cloudformation.ListStackInstancesInput) (*cloudformation.ListStackInstancesOutput, error) {
+				return &cloudformation.ListStackInstancesOutput{}, nil
+			},
+			mockDeleteStackSet: func(t *testing.T, in *cloudformation.DeleteStackSetInput) (*cloudformation.DeleteStackSetOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedErr: errors.New("delete stack set phonetool-infrastructure: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cf := CloudFormation{
+				client: &mockCloudFormation{
+					t:                        t,
+					mockListStackInstances:   tc.mockListStackInstances,
+					mockDeleteStackInstances: tc.mockDeleteStackInstances,
+					mockDeleteStackSet:       tc.mockDeleteStackSet,
+					mockDescribeStackSetOperation: func(t *testing.T, in *cloudformation.DescribeStackSetOperationInput) (*cloudformation.DescribeStackSetOperationOutput, error) {
+						return &cloudformation.DescribeStackSetOperationOutput{
+							StackSetOperation: &cloudformation.StackSetOperation{
+								Status: aws.String("SUCCEEDED"),
+							},
+						}, nil
+					},
+				},
+				box: boxWithTemplateFile(),
+			}
+
+			err := cf.DeleteProjectResources("phonetool")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestWaitForStackSetOperation(t *testing.T) {
 	waitingForOperation := true
 	testCases := map[string]struct {
@@ -929,6 +1112,115 @@ func TestGetRegionalProjectResources(t *testing.T) {
 	}
 }
 
+func TestDescribeProjectStacks(t *testing.T) {
+	mockProject := archer.Project{Name: "project", AccountID: "12345"}
+
+	testCases := map[string]struct {
+		client         *mockCloudFormation
+		clientProvider func(string) cloudformationiface.CloudFormationAPI
+
+		wantedStacks *ProjectStacks
+		wantedErr    error
+	}{
+		"returns the status of the project stack and of the stack instances": {
+			client: &mockCloudFormation{
+				t: t,
+				mockDescribeStacks: func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+					require.Equal(t, "project-infrastructure-roles", *in.StackName)
+					return &cloudformation.DescribeStacksOutput{
+						Stacks: []*cloudformation.Stack{{StackStatus: aws.String("UPDATE_COMPLETE")}},
+					}, nil
+				},
+				mockListStackInstances: func(t *testing.T, in *cloudformation.ListStackInstancesInput) (*cloudformation.ListStackInstancesOutput, error) {
+					require.Equal(t, "project-infrastructure", *in.StackSetName)
+					require.Equal(t, "12345", *in.StackInstanceAccount)
+					return &cloudformation.ListStackInstancesOutput{
+						Summaries: []*cloudformation.StackInstanceSummary{
+							{
+								StackId:      aws.String("cross-region-stack"),
+								Region:       aws.String("us-east-9"),
+								Status:       aws.String("OUTDATED"),
+								StatusReason: aws.String("operation failed"),
+							},
+						},
+					}, nil
+				},
+			},
+			clientProvider: func(region string) cloudformationiface.CloudFormationAPI {
+				require.Equal(t, "us-east-9", region)
+				return &mockCloudFormation{
+					t: t,
+					mockDescribeStacks: func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+						require.Equal(t, "cross-region-stack", *in.StackName)
+						return &cloudformation.DescribeStacksOutput{
+							Stacks: []*cloudformation.Stack{{StackStatus: aws.String("UPDATE_ROLLBACK_COMPLETE")}},
+						}, nil
+					},
+				}
+			},
+			wantedStacks: &ProjectStacks{
+				StackStatus: "UPDATE_COMPLETE",
+				Instances: []*ProjectStackInstance{
+					{
+						Region:       "us-east-9",
+						Status:       "OUTDATED",
+						StatusReason: "operation failed",
+						StackStatus:  "UPDATE_ROLLBACK_COMPLETE",
+					},
+				},
+			},
+		},
+		"returns empty statuses if the stacks don't exist": {
+			client: &mockCloudFormation{
+				t: t,
+				mockDescribeStacks: func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+					return nil, awserr.New("ValidationError", "Stack with id project-infrastructure-roles does not exist", nil)
+				},
+				mockListStackInstances: func(t *testing.T, in *cloudformation.ListStackInstancesInput) (*cloudformation.ListStackInstancesOutput, error) {
+					return nil, awserr.New(cloudformation.ErrCodeStackSetNotFoundException, "not found", nil)
+				},
+			},
+			wantedStacks: &ProjectStacks{},
+		},
+		"wraps list stack instances errors": {
+			client: &mockCloudFormation{
+				t: t,
+				mockDescribeStacks: func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+					return &cloudformation.DescribeStacksOutput{
+						Stacks: []*cloudformation.Stack{{StackStatus: aws.String("CREATE_COMPLETE")}},
+					}, nil
+				},
+				mockListStackInstances: func(t *testing.T, in *cloudformation.ListStackInstancesInput) (*cloudformation.ListStackInstancesOutput, error) {
+					return nil, errors.New("some error")
+				},
+			},
+			wantedErr: errors.New("listing stack instances: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			cf := CloudFormation{
+				client:                 tc.client,
+				regionalClientProvider: mockClientBuilder{mockClient: tc.clientProvider},
+				box:                    boxWithTemplateFile(),
+			}
+
+			// WHEN
+			stacks, err := cf.DescribeProjectStacks(&mockProject)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStacks, stacks)
+		})
+	}
+}
+
 func TestGetProjectResourcesByRegion(t *testing.T) {
 	mockProject := archer.Project{Name: "project", AccountID: "12345"}
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"strconv"
+	"strings"
 	"text/template"
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
@@ -37,12 +38,23 @@ const (
 	envParamToolsAccountPrincipalKey    = "ToolsAccountPrincipalARN"
 	envParamProjectDNSKey               = "ProjectDNSName"
 	envParamProjectDNSDelegationRoleKey = "ProjectDNSDelegationRole"
+	envParamImportVPCIDKey              = "ImportVpcId"
+	envParamImportPublicSubnetsKey      = "ImportPublicSubnetIds"
+	envParamImportPrivateSubnetsKey     = "ImportPrivateSubnetIds"
+	envParamVPCCIDRKey                  = "VpcCIDR"
+	envParamAZCountKey                  = "AZCount"
+	envParamNATGatewayModeKey           = "NATGatewayMode"
+	envParamPrivateOnlyKey              = "PrivateOnly"
+	fmtEnvParamPublicSubnetCIDRKey      = "PublicSubnet%dCIDR"
+	fmtEnvParamPrivateSubnetCIDRKey     = "PrivateSubnet%dCIDR"
 )
 
 // Output keys.
 const (
 	envOutputCFNExecutionRoleARN = "CFNExecutionRoleARN"
 	envOutputManagerRoleKey      = "EnvironmentManagerRoleARN"
+	// EnvOutputTemplateVersionKey is the output holding the version of the template the stack was deployed with.
+	EnvOutputTemplateVersionKey = "TemplateVersion"
 )
 
 // NewEnvStackConfig sets up a struct which can provide values to CloudFormation for
@@ -80,9 +92,11 @@ func (e *EnvStackConfig) Template() (string, error) {
 	templateData := struct {
 		DNSDelegationLambda string
 		ACMValidationLambda string
+		TemplateVersion     string
 	}{
 		dnsDelegator,
 		acmValidator,
+		deploy.LatestEnvTemplateVersion,
 	}
 
 	var buf bytes.Buffer
@@ -94,8 +108,15 @@ func (e *EnvStackConfig) Template() (string, error) {
 }
 
 // Parameters returns the parameters to be passed into a environment CloudFormation template.
+// The VPC and subnets are only created if no existing VPC is imported.
 func (e *EnvStackConfig) Parameters() []*cloudformation.Parameter {
-	return []*cloudformation.Parameter{
+	var vpcID, publicSubnets, privateSubnets string
+	if e.ImportVPC != nil {
+		vpcID = e.ImportVPC.ID
+		publicSubnets = strings.Join(e.ImportVPC.PublicSubnetIDs, ",")
+		privateSubnets = strings.Join(e.ImportVPC.PrivateSubnetIDs, ",")
+	}
+	params := []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(envParamIncludeLBKey),
 			ParameterValue: aws.String(strconv.FormatBool(e.PublicLoadBalancer)),
@@ -120,7 +141,59 @@ func (e *EnvStackConfig) Parameters() []*cloudformation.Parameter {
 			ParameterKey:   aws.String(envParamProjectDNSDelegationRoleKey),
 			ParameterValue: aws.String(e.dnsDelegationRole()),
 		},
+		{
+			ParameterKey:   aws.String(envParamPrivateOnlyKey),
+			ParameterValue: aws.String(strconv.FormatBool(e.Private)),
+		},
+		{
+			ParameterKey:   aws.String(envParamImportVPCIDKey),
+			ParameterValue: aws.String(vpcID),
+		},
+		{
+			ParameterKey:   aws.String(envParamImportPublicSubnetsKey),
+			ParameterValue: aws.String(publicSubnets),
+		},
+		{
+			ParameterKey:   aws.String(envParamImportPrivateSubnetsKey),
+			ParameterValue: aws.String(privateSubnets),
+		},
 	}
+	return append(params, e.newVPCParameters()...)
+}
+
+// newVPCParameters returns the parameters describing the layout of the VPC to create.
+// The template's defaults are used if no layout is configured.
+func (e *EnvStackConfig) newVPCParameters() []*cloudformation.Parameter {
+	if e.NewVPC == nil {
+		return nil
+	}
+	params := []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String(envParamVPCCIDRKey),
+			ParameterValue: aws.String(e.NewVPC.CIDR),
+		},
+		{
+			ParameterKey:   aws.String(envParamAZCountKey),
+			ParameterValue: aws.String(strconv.Itoa(e.NewVPC.AZCount)),
+		},
+		{
+			ParameterKey:   aws.String(envParamNATGatewayModeKey),
+			ParameterValue: aws.String(e.NewVPC.NATGatewayMode),
+		},
+	}
+	for i, cidr := range e.NewVPC.PublicSubnetCIDRs {
+		params = append(params, &cloudformation.Parameter{
+			ParameterKey:   aws.String(fmt.Sprintf(fmtEnvParamPublicSubnetCIDRKey, i+1)),
+			ParameterValue: aws.String(cidr),
+		})
+	}
+	for i, cidr := range e.NewVPC.PrivateSubnetCIDRs {
+		params = append(params, &cloudformation.Parameter{
+			ParameterKey:   aws.String(fmt.Sprintf(fmtEnvParamPrivateSubnetCIDRKey, i+1)),
+			ParameterValue: aws.String(cidr),
+		})
+	}
+	return params
 }
 
 // Tags returns the tags that should be applied to the environment CloudFormation stack.
@@ -151,7 +224,12 @@ func (e *EnvStackConfig) dnsDelegationRole() string {
 
 // StackName returns the name of the CloudFormation stack (based on the project and env names).
 func (e *EnvStackConfig) StackName() string {
-	return fmt.Sprintf("%s-%s", e.Project, e.Name)
+	return NameForEnv(e.Project, e.Name)
+}
+
+// NameForEnv returns the name of the stack of an environment.
+func NameForEnv(project, env string) string {
+	return fmt.Sprintf("%s-%s", project, env)
 }
 
 // ToEnv inspects an environment cloudformation stack and constructs an environment
@@ -167,6 +245,11 @@ func (e *EnvStackConfig) ToEnv(stack *cloudformation.Stack) (*archer.Environment
 		stackOutputs[*output.OutputKey] = *output.OutputValue
 	}
 
+	templateVersion, ok := stackOutputs[EnvOutputTemplateVersionKey]
+	if !ok {
+		templateVersion = deploy.LegacyEnvTemplateVersion
+	}
+
 	return &archer.Environment{
 		Name:             e.Name,
 		Project:          e.Project,
@@ -175,5 +258,7 @@ func (e *EnvStackConfig) ToEnv(stack *cloudformation.Stack) (*archer.Environment
 		AccountID:        stackARN.AccountID,
 		ManagerRoleARN:   stackOutputs[envOutputManagerRoleKey],
 		ExecutionRoleARN: stackOutputs[envOutputCFNExecutionRoleARN],
+		TemplateVersion:  templateVersion,
+		Private:          e.Private,
 	}, nil
 }
@@ -51,6 +51,21 @@ func TestEnvParameters(t *testing.T) {
 	deploymentInput := mockDeployEnvironmentInput()
 	deploymentInputWithDNS := mockDeployEnvironmentInput()
 	deploymentInputWithDNS.ProjectDNSName = "ecs.aws"
+	deploymentInputWithImportedVPC := mockDeployEnvironmentInput()
+	deploymentInputWithImportedVPC.ImportVPC = &deploy.ImportVPCConfig{
+		ID:               "vpc-1",
+		PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+		PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+	}
+	deploymentInputWithNewVPC := mockDeployEnvironmentInput()
+	deploymentInputWithNewVPC.NewVPC = &deploy.NewVPCConfig{
+		CIDR:               "172.16.0.0/20",
+		AZCount:            3,
+		PublicSubnetCIDRs:  []string{"172.16.0.0/23", "172.16.2.0/23", "172.16.4.0/23"},
+		PrivateSubnetCIDRs: []string{"172.16.6.0/23", "172.16.8.0/23", "172.16.10.0/23"},
+		NATGatewayMode:     deploy.NATGatewayNone,
+	}
+	deploymentInputWithNewVPC.Private = true
 	testCases := map[string]struct {
 		input *deploy.CreateEnvironmentInput
 		want  []*cloudformation.Parameter
@@ -82,6 +97,22 @@ func TestEnvParameters(t *testing.T) {
 					ParameterKey:   aws.String(envParamProjectDNSDelegationRoleKey),
 					ParameterValue: aws.String(""),
 				},
+				{
+					ParameterKey:   aws.String(envParamPrivateOnlyKey),
+					ParameterValue: aws.String("false"),
+				},
+				{
+					ParameterKey:   aws.String(envParamImportVPCIDKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamImportPublicSubnetsKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamImportPrivateSubnetsKey),
+					ParameterValue: aws.String(""),
+				},
 			},
 		},
 		"with DNS": {
@@ -111,6 +142,148 @@ func TestEnvParameters(t *testing.T) {
 					ParameterKey:   aws.String(envParamProjectDNSDelegationRoleKey),
 					ParameterValue: aws.String("arn:aws:iam::000000000:role/project-DNSDelegationRole"),
 				},
+				{
+					ParameterKey:   aws.String(envParamPrivateOnlyKey),
+					ParameterValue: aws.String("false"),
+				},
+				{
+					ParameterKey:   aws.String(envParamImportVPCIDKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamImportPublicSubnetsKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamImportPrivateSubnetsKey),
+					ParameterValue: aws.String(""),
+				},
+			},
+		},
+		"with imported VPC": {
+			input: deploymentInputWithImportedVPC,
+			want: []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(envParamIncludeLBKey),
+					ParameterValue: aws.String("true"),
+				},
+				{
+					ParameterKey:   aws.String(envParamProjectNameKey),
+					ParameterValue: aws.String(deploymentInputWithImportedVPC.Project),
+				},
+				{
+					ParameterKey:   aws.String(envParamEnvNameKey),
+					ParameterValue: aws.String(deploymentInputWithImportedVPC.Name),
+				},
+				{
+					ParameterKey:   aws.String(envParamToolsAccountPrincipalKey),
+					ParameterValue: aws.String(deploymentInputWithImportedVPC.ToolsAccountPrincipalARN),
+				},
+				{
+					ParameterKey:   aws.String(envParamProjectDNSKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamProjectDNSDelegationRoleKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamPrivateOnlyKey),
+					ParameterValue: aws.String("false"),
+				},
+				{
+					ParameterKey:   aws.String(envParamImportVPCIDKey),
+					ParameterValue: aws.String("vpc-1"),
+				},
+				{
+					ParameterKey:   aws.String(envParamImportPublicSubnetsKey),
+					ParameterValue: aws.String("subnet-1,subnet-2"),
+				},
+				{
+					ParameterKey:   aws.String(envParamImportPrivateSubnetsKey),
+					ParameterValue: aws.String("subnet-3,subnet-4"),
+				},
+			},
+		},
+		"private with new VPC layout": {
+			input: deploymentInputWithNewVPC,
+			want: []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(envParamIncludeLBKey),
+					ParameterValue: aws.String("true"),
+				},
+				{
+					ParameterKey:   aws.String(envParamProjectNameKey),
+					ParameterValue: aws.String(deploymentInputWithNewVPC.Project),
+				},
+				{
+					ParameterKey:   aws.String(envParamEnvNameKey),
+					ParameterValue: aws.String(deploymentInputWithNewVPC.Name),
+				},
+				{
+					ParameterKey:   aws.String(envParamToolsAccountPrincipalKey),
+					ParameterValue: aws.String(deploymentInputWithNewVPC.ToolsAccountPrincipalARN),
+				},
+				{
+					ParameterKey:   aws.String(envParamProjectDNSKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamProjectDNSDelegationRoleKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamPrivateOnlyKey),
+					ParameterValue: aws.String("true"),
+				},
+				{
+					ParameterKey:   aws.String(envParamImportVPCIDKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamImportPublicSubnetsKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamImportPrivateSubnetsKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamVPCCIDRKey),
+					ParameterValue: aws.String("172.16.0.0/20"),
+				},
+				{
+					ParameterKey:   aws.String(envParamAZCountKey),
+					ParameterValue: aws.String("3"),
+				},
+				{
+					ParameterKey:   aws.String(envParamNATGatewayModeKey),
+					ParameterValue: aws.String("none"),
+				},
+				{
+					ParameterKey:   aws.String("PublicSubnet1CIDR"),
+					ParameterValue: aws.String("172.16.0.0/23"),
+				},
+				{
+					ParameterKey:   aws.String("PublicSubnet2CIDR"),
+					ParameterValue: aws.String("172.16.2.0/23"),
+				},
+				{
+					ParameterKey:   aws.String("PublicSubnet3CIDR"),
+					ParameterValue: aws.String("172.16.4.0/23"),
+				},
+				{
+					ParameterKey:   aws.String("PrivateSubnet1CIDR"),
+					ParameterValue: aws.String("172.16.6.0/23"),
+				},
+				{
+					ParameterKey:   aws.String("PrivateSubnet2CIDR"),
+					ParameterValue: aws.String("172.16.8.0/23"),
+				},
+				{
+					ParameterKey:   aws.String("PrivateSubnet3CIDR"),
+					ParameterValue: aws.String("172.16.10.0/23"),
+				},
 			},
 		},
 	}
@@ -219,6 +392,30 @@ func TestToEnv(t *testing.T) {
 				Region:           "eu-west-3",
 				ManagerRoleARN:   "arn:aws:iam::902697171733:role/phonetool-test-EnvManagerRole",
 				ExecutionRoleARN: "arn:aws:iam::902697171733:role/phonetool-test-CFNExecutionRole",
+				TemplateVersion:  deploy.LegacyEnvTemplateVersion,
+			},
+		},
+		"should return the template version of the stack": {
+			mockStack: func() *cloudformation.Stack {
+				s := mockEnvironmentStack(
+					"arn:aws:cloudformation:eu-west-3:902697171733:stack/project-env",
+					"arn:aws:iam::902697171733:role/phonetool-test-EnvManagerRole",
+					"arn:aws:iam::902697171733:role/phonetool-test-CFNExecutionRole")
+				s.Outputs = append(s.Outputs, &cloudformation.Output{
+					OutputKey:   aws.String(EnvOutputTemplateVersionKey),
+					OutputValue: aws.String("v1.0.0"),
+				})
+				return s
+			}(),
+			expectedEnv: archer.Environment{
+				Name:             mockDeployInput.Name,
+				Project:          mockDeployInput.Project,
+				Prod:             mockDeployInput.Prod,
+				AccountID:        "902697171733",
+				Region:           "eu-west-3",
+				ManagerRoleARN:   "arn:aws:iam::902697171733:role/phonetool-test-EnvManagerRole",
+				ExecutionRoleARN: "arn:aws:iam::902697171733:role/phonetool-test-CFNExecutionRole",
+				TemplateVersion:  "v1.0.0",
 			},
 		},
 	}
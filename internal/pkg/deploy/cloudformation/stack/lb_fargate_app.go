@@ -34,6 +34,7 @@ const (
 	lbFargateTaskCPUKey             = "TaskCPU"
 	lbFargateTaskMemoryKey          = "TaskMemory"
 	lbFargateTaskCountKey           = "TaskCount"
+	lbFargateAddonsTemplateURLKey   = "AddonsTemplateURL"
 )
 
 // LBFargateStackConfig represents the configuration needed to create a CloudFormation stack from a
@@ -66,8 +67,14 @@ func NewHTTPSLBFargateStack(in *deploy.CreateLBFargateAppInput) *LBFargateStackC
 
 // StackName returns the name of the stack.
 func (c *LBFargateStackConfig) StackName() string {
+	return NameForApp(c.Env.Project, c.Env.Name, c.App.Name)
+}
+
+// NameForApp returns the name of the stack of an application in an environment.
+// It's the name of the stack created by "app deploy" and by pipelines, every command reading or deleting the stack uses it.
+func NameForApp(project, env, app string) string {
 	const maxLen = 128 // stack name limit constrained by CFN https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/cfn-using-console-create-stack-parameters.html
-	stackName := fmt.Sprintf("%s-%s-%s-app", c.Env.Project, c.Env.Name, c.App.Name)
+	stackName := fmt.Sprintf("%s-%s-%s", project, env, app)
 
 	if len(stackName) > maxLen {
 		return stackName[len(stackName)-maxLen:]
@@ -81,7 +88,7 @@ func (c *LBFargateStackConfig) Template() (string, error) {
 	if err != nil {
 		return "", &ErrTemplateNotFound{templateLocation: lbFargateAppTemplatePath, parentErr: err}
 	}
-	tpl, err := template.New("template").Parse(content)
+	tpl, err := template.New("template").Funcs(templateFunctions).Parse(content)
 	if err != nil {
 		return "", fmt.Errorf("parse CloudFormation template for %s: %w", c.App.Type, err)
 	}
@@ -95,7 +102,7 @@ func (c *LBFargateStackConfig) Template() (string, error) {
 // Parameters returns the list of CloudFormation parameters used by the template.
 func (c *LBFargateStackConfig) Parameters() []*cloudformation.Parameter {
 	templateParams := c.toTemplateParams()
-	return []*cloudformation.Parameter{
+	params := []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(lbFargateParamProjectNameKey),
 			ParameterValue: aws.String(templateParams.Env.Project),
@@ -141,6 +148,13 @@ func (c *LBFargateStackConfig) Parameters() []*cloudformation.Parameter {
 			ParameterValue: aws.String(strconv.FormatBool(c.httpsEnabled)),
 		},
 	}
+	if c.Addons != nil {
+		params = append(params, &cloudformation.Parameter{
+			ParameterKey:   aws.String(lbFargateAddonsTemplateURLKey),
+			ParameterValue: aws.String(c.Addons.TemplateURL),
+		})
+	}
+	return params
 }
 
 // SerializedParameters returns the CloudFormation stack's parameters serialized
@@ -187,6 +201,7 @@ type lbFargateTemplateParams struct {
 	Priority int // Listener's rule priority https://docs.aws.amazon.com/elasticloadbalancing/latest/application/load-balancer-listeners.html#listener-rules
 
 	HTTPSEnabled string
+	ExecEnabled  bool // Whether ECS Exec is enabled on the service's tasks.
 	// Field types to override.
 	Image struct {
 		URL  string
@@ -196,15 +211,19 @@ type lbFargateTemplateParams struct {
 
 func (c *LBFargateStackConfig) toTemplateParams() *lbFargateTemplateParams {
 	url := fmt.Sprintf("%s:%s", c.ImageRepoURL, c.ImageTag)
+	conf := c.CreateLBFargateAppInput.App.EnvConf(c.Env.Name) // Get environment specific app configuration.
+	conf.Secrets = conf.SecretsValueFrom(c.Env.Project, c.Env.Name)
 	return &lbFargateTemplateParams{
 		CreateLBFargateAppInput: &deploy.CreateLBFargateAppInput{
 			App: &manifest.LBFargateManifest{
 				AppManifest:     c.App.AppManifest,
-				LBFargateConfig: c.CreateLBFargateAppInput.App.EnvConf(c.Env.Name), // Get environment specific app configuration.
+				LBFargateConfig: conf,
 			},
-			Env: c.Env,
+			Env:    c.Env,
+			Addons: c.Addons,
 		},
 		HTTPSEnabled: strconv.FormatBool(c.httpsEnabled),
+		ExecEnabled:  conf.ExecEnabled(c.Env.Prod),
 		Priority:     1, // TODO assign a unique path priority given a path.
 		Image: struct {
 			URL  string
@@ -30,14 +30,14 @@ func TestLBFargateStackConfig_StackName(t *testing.T) {
 			inEnvName:     "test",
 			inProjectName: "phonetool",
 
-			wantedStackName: "phonetool-test-frontend-app",
+			wantedStackName: "phonetool-test-frontend",
 		},
 		"longer than 128 characters": {
 			inAppName:     "whatisthishorriblylongapplicationnamethatcantfitintocloudformationwhatarewesupposedtodoaboutthisaaaaaaaaaaaaaaaaaaaa",
 			inEnvName:     "test",
 			inProjectName: "phonetool",
 
-			wantedStackName: "ol-test-whatisthishorriblylongapplicationnamethatcantfitintocloudformationwhatarewesupposedtodoaboutthisaaaaaaaaaaaaaaaaaaaa-app",
+			wantedStackName: "netool-test-whatisthishorriblylongapplicationnamethatcantfitintocloudformationwhatarewesupposedtodoaboutthisaaaaaaaaaaaaaaaaaaaa",
 		},
 	}
 
@@ -124,6 +124,26 @@ func TestLBFargateStackConfig_Template(t *testing.T) {
   TaskMemory: '512'
   TaskCount: 1`,
 		},
+		"render exec settings per environment type": {
+			in: &deploy.CreateLBFargateAppInput{
+				App: manifest.NewLoadBalancedFargateManifest("frontend", "frontend/Dockerfile"),
+				Env: &archer.Environment{
+					Project:   "phonetool",
+					Name:      "prod",
+					Region:    "us-west-2",
+					AccountID: "12345",
+					Prod:      true,
+				},
+				ImageRepoURL: "12345.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend",
+				ImageTag:     "manual-bf3678c",
+			},
+			mockBox: func(box *packd.MemoryBox) {
+				box.AddString(lbFargateAppTemplatePath, `LaunchType: FARGATE{{if .ExecEnabled}}
+EnableExecuteCommand: true{{end}}`)
+			},
+
+			wantedTemplate: `LaunchType: FARGATE`,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -150,7 +170,9 @@ func TestLBFargateStackConfig_Template(t *testing.T) {
 func TestLBFargateStackConfig_Parameters(t *testing.T) {
 	testCases := map[string]struct {
 		httpsEnabled bool
+		addons       *deploy.AddonsStack
 		expectedHTTP string
+		expectedURL  string
 	}{
 		"HTTPS Enabled": {
 			httpsEnabled: true,
@@ -160,6 +182,14 @@ func TestLBFargateStackConfig_Parameters(t *testing.T) {
 			httpsEnabled: false,
 			expectedHTTP: "false",
 		},
+		"With addons": {
+			httpsEnabled: false,
+			addons: &deploy.AddonsStack{
+				TemplateURL: "https://bucket.s3-us-west-2.amazonaws.com/addons/frontend/abc.yml",
+			},
+			expectedHTTP: "false",
+			expectedURL:  "https://bucket.s3-us-west-2.amazonaws.com/addons/frontend/abc.yml",
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -177,6 +207,7 @@ func TestLBFargateStackConfig_Parameters(t *testing.T) {
 					},
 					ImageRepoURL: "12345.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend",
 					ImageTag:     "manual-bf3678c",
+					Addons:       tc.addons,
 				},
 				httpsEnabled: tc.httpsEnabled,
 			}
@@ -185,7 +216,7 @@ func TestLBFargateStackConfig_Parameters(t *testing.T) {
 			params := conf.Parameters()
 
 			// THEN
-			require.Equal(t, []*cloudformation.Parameter{
+			wanted := []*cloudformation.Parameter{
 				{
 					ParameterKey:   aws.String(lbFargateParamProjectNameKey),
 					ParameterValue: aws.String("phonetool"),
@@ -230,7 +261,14 @@ func TestLBFargateStackConfig_Parameters(t *testing.T) {
 					ParameterKey:   aws.String(lbFargatePramHTTPSKey),
 					ParameterValue: aws.String(tc.expectedHTTP),
 				},
-			}, params)
+			}
+			if tc.expectedURL != "" {
+				wanted = append(wanted, &cloudformation.Parameter{
+					ParameterKey:   aws.String(lbFargateAddonsTemplateURLKey),
+					ParameterValue: aws.String(tc.expectedURL),
+				})
+			}
+			require.Equal(t, wanted, params)
 		})
 	}
 }
@@ -3,7 +3,10 @@
 
 package stack
 
-import "strings"
+import (
+	"strings"
+	"unicode"
+)
 
 const (
 	dashReplacement = "DASH"
@@ -11,6 +14,8 @@ const (
 
 var templateFunctions = map[string]interface{}{
 	"logicalIDSafe": logicalIDSafe,
+	"envVarName":    envVarName,
+	"appStackName":  NameForApp,
 }
 
 // logicalIDSafe takes a CloudFormation logical ID, and
@@ -26,3 +31,22 @@ func logicalIDSafe(logicalID string) string {
 func safeLogicalIDToOriginal(safeLogicalID string) string {
 	return strings.ReplaceAll(safeLogicalID, dashReplacement, "-")
 }
+
+// envVarName takes a CloudFormation output name in PascalCase, and
+// converts it to an environment variable name in SCREAMING_SNAKE_CASE.
+// For example, "MyTableName" becomes "MY_TABLE_NAME".
+func envVarName(outputName string) string {
+	runes := []rune(outputName)
+	var sb strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prevIsLower := !unicode.IsUpper(runes[i-1])
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prevIsLower || nextIsLower {
+				sb.WriteRune('_')
+			}
+		}
+		sb.WriteRune(unicode.ToUpper(r))
+	}
+	return sb.String()
+}
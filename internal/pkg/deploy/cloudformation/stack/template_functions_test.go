@@ -0,0 +1,36 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvVarName(t *testing.T) {
+	testCases := map[string]struct {
+		in     string
+		wanted string
+	}{
+		"single word": {
+			in:     "Bucket",
+			wanted: "BUCKET",
+		},
+		"pascal case": {
+			in:     "MyTableName",
+			wanted: "MY_TABLE_NAME",
+		},
+		"with acronym": {
+			in:     "MyDBClusterARN",
+			wanted: "MY_DB_CLUSTER_ARN",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, envVarName(tc.in))
+		})
+	}
+}
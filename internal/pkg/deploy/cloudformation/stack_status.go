@@ -23,3 +23,8 @@ func (s StackStatus) RequiresCleanup() bool {
 func (s StackStatus) InProgress() bool {
 	return strings.HasSuffix(string(s), "IN_PROGRESS")
 }
+
+// RolledBack indicates that the last operation on the stack failed and was rolled back.
+func (s StackStatus) RolledBack() bool {
+	return strings.Contains(string(s), "ROLLBACK")
+}
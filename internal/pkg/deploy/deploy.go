@@ -16,3 +16,19 @@ type ResourceEvent struct {
 	Status       string
 	StatusReason string
 }
+
+// ResourceChange represents a modification to an AWS resource that a deployment will make.
+type ResourceChange struct {
+	Resource
+	Action      string   // Add, Modify, or Remove.
+	Replacement string   // Whether the resource is replaced when modified: True, False, or Conditional.
+	Properties  []string // Paths of the modified properties, e.g. "Properties.TaskDefinition".
+}
+
+// ChangeSet holds the changes that a deployment will make to a stack, so that they can be reviewed before being applied.
+type ChangeSet struct {
+	StackName string
+	ID        string // ID of the change set to execute to apply the changes.
+	NewStack  bool   // Whether executing the change set creates the stack.
+	Changes   []*ResourceChange
+}
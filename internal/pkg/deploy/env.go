@@ -6,17 +6,87 @@
 package deploy
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
 )
 
+const (
+	// LatestEnvTemplateVersion is the version of the environment template shipped with the CLI.
+	// Bump it whenever templates/environment/cf.yml changes so that existing environments can be upgraded.
+	LatestEnvTemplateVersion = "v1.6.0"
+	// LegacyEnvTemplateVersion is the version of environment stacks created before templates were versioned.
+	LegacyEnvTemplateVersion = "v0.0.0"
+	// MinAppEnvTemplateVersion is the oldest version of the environment template exporting every value
+	// imported by the application templates.
+	MinAppEnvTemplateVersion = "v1.4.0"
+)
+
+// IsOlderEnvTemplateVersion returns true if the environment template version is older than the other version.
+// An empty version is the legacy version.
+func IsOlderEnvTemplateVersion(version, other string) bool {
+	v, o := parseEnvTemplateVersion(version), parseEnvTemplateVersion(other)
+	for i := range v {
+		if v[i] != o[i] {
+			return v[i] < o[i]
+		}
+	}
+	return false
+}
+
+// parseEnvTemplateVersion returns the major, minor and patch numbers of a "vX.Y.Z" version.
+// Missing or invalid numbers are zero.
+func parseEnvTemplateVersion(version string) [3]int {
+	var parts [3]int
+	for i, s := range strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3) {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			continue
+		}
+		parts[i] = n
+	}
+	return parts
+}
+
 // CreateEnvironmentInput holds the fields required to deploy an environment.
 type CreateEnvironmentInput struct {
-	Project                  string // Name of the project this environment belongs to.
-	Name                     string // Name of the environment, must be unique within a project.
-	Prod                     bool   // Whether or not this environment is a production environment.
-	PublicLoadBalancer       bool   // Whether or not this environment should contain a shared public load balancer between applications.
-	ToolsAccountPrincipalARN string // The Principal ARN of the tools account.
-	ProjectDNSName           string // The DNS name of this project, if it exists
+	Project                  string           // Name of the project this environment belongs to.
+	Name                     string           // Name of the environment, must be unique within a project.
+	Prod                     bool             // Whether or not this environment is a production environment.
+	PublicLoadBalancer       bool             // Whether or not this environment should contain a shared public load balancer between applications.
+	ToolsAccountPrincipalARN string           // The Principal ARN of the tools account.
+	ProjectDNSName           string           // The DNS name of this project, if it exists
+	ImportVPC                *ImportVPCConfig // Optional. Existing VPC and subnets used instead of creating a new VPC.
+	NewVPC                   *NewVPCConfig    // Optional. Layout of the VPC created for the environment, defaults to two AZs with a NAT gateway each.
+	Private                  bool             // Whether the environment has no access to the internet, its load balancer is then internal.
+}
+
+// ImportVPCConfig holds the IDs of an existing VPC and of its subnets to deploy an environment in.
+type ImportVPCConfig struct {
+	ID               string
+	PublicSubnetIDs  []string
+	PrivateSubnetIDs []string
+}
+
+// NAT gateway modes of a VPC created for an environment.
+const (
+	NATGatewayPerAZ  = "per-az" // A NAT gateway in each availability zone.
+	NATGatewaySingle = "single" // A single NAT gateway shared by all availability zones.
+	NATGatewayNone   = "none"   // No NAT gateway, private subnets reach AWS services through VPC endpoints.
+)
+
+// NATGatewayModes are the supported NAT gateway modes.
+var NATGatewayModes = []string{NATGatewayPerAZ, NATGatewaySingle, NATGatewayNone}
+
+// NewVPCConfig holds the network layout of a VPC created for an environment.
+// There is a public and a private subnet in each availability zone.
+type NewVPCConfig struct {
+	CIDR               string
+	AZCount            int
+	PublicSubnetCIDRs  []string // One per availability zone.
+	PrivateSubnetCIDRs []string // One per availability zone.
+	NATGatewayMode     string
 }
 
 // CreateEnvironmentResponse holds the created environment on successful deployment.
@@ -25,3 +95,12 @@ type CreateEnvironmentResponse struct {
 	Env *archer.Environment
 	Err error
 }
+
+// EnvironmentUpgrade holds a pending change set that moves an environment stack to a newer template version.
+type EnvironmentUpgrade struct {
+	StackName   string            // Name of the environment stack.
+	ChangeSetID string            // ID of the change set to execute to apply the upgrade.
+	FromVersion string            // Template version the stack is currently deployed with.
+	ToVersion   string            // Template version the stack is upgraded to.
+	Changes     []*ResourceChange // Resources modified by the upgrade.
+}
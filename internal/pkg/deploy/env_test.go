@@ -0,0 +1,51 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsOlderEnvTemplateVersion(t *testing.T) {
+	testCases := map[string]struct {
+		version string
+		other   string
+
+		wanted bool
+	}{
+		"legacy version is older": {
+			version: "",
+			other:   "v1.0.0",
+			wanted:  true,
+		},
+		"older minor version": {
+			version: "v1.3.0",
+			other:   "v1.4.0",
+			wanted:  true,
+		},
+		"compares numbers instead of strings": {
+			version: "v1.9.0",
+			other:   "v1.10.0",
+			wanted:  true,
+		},
+		"same version": {
+			version: "v1.4.0",
+			other:   "v1.4.0",
+			wanted:  false,
+		},
+		"newer version": {
+			version: "v2.0.0",
+			other:   "v1.4.0",
+			wanted:  false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, IsOlderEnvTemplateVersion(tc.version, tc.other))
+		})
+	}
+}
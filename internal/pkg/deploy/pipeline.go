@@ -192,3 +192,9 @@ type AssociatedEnvironment struct {
 	// Whether or not this environment is a production environment.
 	Prod bool
 }
+
+// CreatePipelineResponse holds the error of the creation or the update of a pipeline's stack.
+// The error is nil if the deployment succeeded.
+type CreatePipelineResponse struct {
+	Err error
+}
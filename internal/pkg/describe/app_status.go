@@ -0,0 +1,337 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/progress"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
+)
+
+const (
+	maxServiceEvents = 10
+	// Target tracking scaling policies create alarms named after the scalable target.
+	fmtScalingAlarmNamePrefix = "TargetTracking-service/%s/%s"
+)
+
+// AppStatus is the live status of an application's ECS service in an environment.
+type AppStatus struct {
+	Service     ServiceStatus  `json:"service"`
+	Deployments []Deployment   `json:"deployments"`
+	Targets     []TargetHealth `json:"targets"`
+	Alarms      []Alarm        `json:"alarms"`
+	Events      []ServiceEvent `json:"events"`
+}
+
+// ServiceStatus holds the counts of tasks of a service and the task definition it runs.
+type ServiceStatus struct {
+	Name                   string `json:"name"`
+	Status                 string `json:"status"`
+	DesiredCount           int64  `json:"desiredCount"`
+	RunningCount           int64  `json:"runningCount"`
+	PendingCount           int64  `json:"pendingCount"`
+	TaskDefinitionRevision int64  `json:"taskDefinitionRevision"`
+	ImageTag               string `json:"imageTag"`
+}
+
+// Deployment is a deployment of a task definition revision to a service.
+// The PRIMARY deployment is the most recent one, ACTIVE deployments are being replaced.
+type Deployment struct {
+	Status                 string    `json:"status"`
+	TaskDefinitionRevision int64     `json:"taskDefinitionRevision"`
+	DesiredCount           int64     `json:"desiredCount"`
+	RunningCount           int64     `json:"runningCount"`
+	PendingCount           int64     `json:"pendingCount"`
+	UpdatedAt              time.Time `json:"updatedAt"`
+}
+
+// TargetHealth is the health of a task registered in the service's target group.
+type TargetHealth struct {
+	TargetID string `json:"targetID"`
+	Port     int64  `json:"port"`
+	State    string `json:"state"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// Alarm is a CloudWatch alarm of the service in the ALARM state.
+type Alarm struct {
+	Name      string    `json:"name"`
+	Reason    string    `json:"reason"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ServiceEvent is an event of the ECS service.
+type ServiceEvent struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Message   string    `json:"message"`
+}
+
+// AppStatusDescriber retrieves the live status of an application's service.
+type AppStatusDescriber struct {
+	rg  resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI
+	ecs ecsiface.ECSAPI
+	elb elbv2iface.ELBV2API
+	cw  cloudwatchiface.CloudWatchAPI
+}
+
+// NewAppStatusDescriber returns an AppStatusDescriber configured with the input session.
+// The session must be in the region of the environment the application is deployed to.
+func NewAppStatusDescriber(s *session.Session) *AppStatusDescriber {
+	return &AppStatusDescriber{
+		rg:  resourcegroupstaggingapi.New(s),
+		ecs: ecs.New(s),
+		elb: elbv2.New(s),
+		cw:  cloudwatch.New(s),
+	}
+}
+
+// Describe returns the status of the application's service in the environment.
+// The service is found through the tags applied to the resources of the application stack.
+func (d *AppStatusDescriber) Describe(projectName, envName, appName string) (*AppStatus, error) {
+	tags := map[string]string{
+		stack.ProjectTagKey: projectName,
+		stack.EnvTagKey:     envName,
+		stack.AppTagKey:     appName,
+	}
+	serviceARNs, err := resourceARNs(d.rg, ecsServiceResourceType, tags)
+	if err != nil {
+		return nil, err
+	}
+	if len(serviceARNs) == 0 {
+		return nil, &ErrServiceNotFound{AppName: appName, EnvName: envName}
+	}
+	clusterName, serviceName, err := parseServiceARN(serviceARNs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := d.ecs.DescribeServices(&ecs.DescribeServicesInput{
+		Cluster:  aws.String(clusterName),
+		Services: []*string{aws.String(serviceName)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe service %s: %w", serviceName, err)
+	}
+	if len(resp.Services) == 0 {
+		return nil, &ErrServiceNotFound{AppName: appName, EnvName: envName}
+	}
+	service := resp.Services[0]
+
+	status := &AppStatus{
+		Service: ServiceStatus{
+			Name:                   serviceName,
+			Status:                 aws.StringValue(service.Status),
+			DesiredCount:           aws.Int64Value(service.DesiredCount),
+			RunningCount:           aws.Int64Value(service.RunningCount),
+			PendingCount:           aws.Int64Value(service.PendingCount),
+			TaskDefinitionRevision: taskDefinitionRevision(aws.StringValue(service.TaskDefinition)),
+		},
+	}
+	for _, deployment := range service.Deployments {
+		status.Deployments = append(status.Deployments, Deployment{
+			Status:                 aws.StringValue(deployment.Status),
+			TaskDefinitionRevision: taskDefinitionRevision(aws.StringValue(deployment.TaskDefinition)),
+			DesiredCount:           aws.Int64Value(deployment.DesiredCount),
+			RunningCount:           aws.Int64Value(deployment.RunningCount),
+			PendingCount:           aws.Int64Value(deployment.PendingCount),
+			UpdatedAt:              aws.TimeValue(deployment.UpdatedAt),
+		})
+	}
+	for i, event := range service.Events {
+		if i == maxServiceEvents {
+			break
+		}
+		status.Events = append(status.Events, ServiceEvent{
+			CreatedAt: aws.TimeValue(event.CreatedAt),
+			Message:   aws.StringValue(event.Message),
+		})
+	}
+
+	if status.Service.ImageTag, err = d.imageTag(aws.StringValue(service.TaskDefinition), appName); err != nil {
+		return nil, err
+	}
+	for _, lb := range service.LoadBalancers {
+		targets, err := d.targetHealth(aws.StringValue(lb.TargetGroupArn))
+		if err != nil {
+			return nil, err
+		}
+		status.Targets = append(status.Targets, targets...)
+	}
+	if status.Alarms, err = d.alarms(tags, clusterName, serviceName); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// imageTag returns the tag of the image of the application's container in the task definition.
+func (d *AppStatusDescriber) imageTag(taskDefinition, containerName string) (string, error) {
+	resp, err := d.ecs.DescribeTaskDefinition(&ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: aws.String(taskDefinition),
+	})
+	if err != nil {
+		return "", fmt.Errorf("describe task definition %s: %w", taskDefinition, err)
+	}
+	for _, container := range resp.TaskDefinition.ContainerDefinitions {
+		if aws.StringValue(container.Name) != containerName {
+			continue
+		}
+		return imageTag(aws.StringValue(container.Image)), nil
+	}
+	return "", nil
+}
+
+func (d *AppStatusDescriber) targetHealth(targetGroupARN string) ([]TargetHealth, error) {
+	resp, err := d.elb.DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: aws.String(targetGroupARN),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe health of targets in target group %s: %w", targetGroupARN, err)
+	}
+	var targets []TargetHealth
+	for _, desc := range resp.TargetHealthDescriptions {
+		targets = append(targets, TargetHealth{
+			TargetID: aws.StringValue(desc.Target.Id),
+			Port:     aws.Int64Value(desc.Target.Port),
+			State:    aws.StringValue(desc.TargetHealth.State),
+			Reason:   aws.StringValue(desc.TargetHealth.Reason),
+		})
+	}
+	return targets, nil
+}
+
+// alarms returns the alarms in the ALARM state that are either tagged with the application's tags
+// or created by the auto scaling policies of the service.
+func (d *AppStatusDescriber) alarms(tags map[string]string, clusterName, serviceName string) ([]Alarm, error) {
+	alarmARNs, err := resourceARNs(d.rg, alarmResourceType, tags)
+	if err != nil {
+		return nil, err
+	}
+	var inputs []*cloudwatch.DescribeAlarmsInput
+	if len(alarmARNs) > 0 {
+		var names []*string
+		for _, alarmARN := range alarmARNs {
+			parsed, err := arn.Parse(alarmARN)
+			if err != nil {
+				return nil, fmt.Errorf("parse alarm ARN %s: %w", alarmARN, err)
+			}
+			names = append(names, aws.String(strings.TrimPrefix(parsed.Resource, "alarm:")))
+		}
+		inputs = append(inputs, &cloudwatch.DescribeAlarmsInput{
+			AlarmNames: names,
+			StateValue: aws.String(cloudwatch.StateValueAlarm),
+		})
+	}
+	inputs = append(inputs, &cloudwatch.DescribeAlarmsInput{
+		AlarmNamePrefix: aws.String(fmt.Sprintf(fmtScalingAlarmNamePrefix, clusterName, serviceName)),
+		StateValue:      aws.String(cloudwatch.StateValueAlarm),
+	})
+
+	var alarms []Alarm
+	for _, in := range inputs {
+		for {
+			resp, err := d.cw.DescribeAlarms(in)
+			if err != nil {
+				return nil, fmt.Errorf("describe alarms: %w", err)
+			}
+			for _, alarm := range resp.MetricAlarms {
+				alarms = append(alarms, Alarm{
+					Name:      aws.StringValue(alarm.AlarmName),
+					Reason:    aws.StringValue(alarm.StateReason),
+					UpdatedAt: aws.TimeValue(alarm.StateUpdatedTimestamp),
+				})
+			}
+			if resp.NextToken == nil {
+				break
+			}
+			in.NextToken = resp.NextToken
+		}
+	}
+	return alarms, nil
+}
+
+// HumanString returns the status formatted in tables for a terminal.
+func (s *AppStatus) HumanString() string {
+	var b bytes.Buffer
+	writer := progress.NewTabWriter(&b)
+
+	fmt.Fprintf(writer, "%s\n", color.HighlightResource("Service"))
+	fmt.Fprintf(writer, "  Name\t%s\n", s.Service.Name)
+	fmt.Fprintf(writer, "  Status\t%s\n", s.Service.Status)
+	fmt.Fprintf(writer, "  Tasks (desired/running/pending)\t%d/%d/%d\n", s.Service.DesiredCount, s.Service.RunningCount, s.Service.PendingCount)
+	fmt.Fprintf(writer, "  Task definition revision\t%d\n", s.Service.TaskDefinitionRevision)
+	fmt.Fprintf(writer, "  Image tag\t%s\n", s.Service.ImageTag)
+
+	fmt.Fprintf(writer, "\n%s\n", color.HighlightResource("Deployments"))
+	fmt.Fprintf(writer, "  Status\tRevision\tDesired\tRunning\tPending\tUpdated at\n")
+	for _, d := range s.Deployments {
+		fmt.Fprintf(writer, "  %s\t%d\t%d\t%d\t%d\t%s\n", d.Status, d.TaskDefinitionRevision, d.DesiredCount, d.RunningCount, d.PendingCount, d.UpdatedAt.Format(time.RFC3339))
+	}
+
+	if len(s.Targets) > 0 {
+		fmt.Fprintf(writer, "\n%s\n", color.HighlightResource("Targets"))
+		fmt.Fprintf(writer, "  ID\tPort\tHealth\tReason\n")
+		for _, t := range s.Targets {
+			state := t.State
+			if state != elbv2.TargetHealthStateEnumHealthy {
+				state = color.Red.Sprint(state)
+			}
+			fmt.Fprintf(writer, "  %s\t%d\t%s\t%s\n", t.TargetID, t.Port, state, t.Reason)
+		}
+	}
+
+	fmt.Fprintf(writer, "\n%s\n", color.HighlightResource("Alarms"))
+	if len(s.Alarms) == 0 {
+		fmt.Fprintf(writer, "  No alarms in the %s state.\n", cloudwatch.StateValueAlarm)
+	}
+	for _, a := range s.Alarms {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", color.Red.Sprint(a.Name), a.UpdatedAt.Format(time.RFC3339), a.Reason)
+	}
+
+	fmt.Fprintf(writer, "\n%s\n", color.HighlightResource("Events"))
+	for _, e := range s.Events {
+		fmt.Fprintf(writer, "  %s\t%s\n", e.CreatedAt.Format(time.RFC3339), e.Message)
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// parseServiceARN returns the cluster and service names from a service ARN in the long format,
+// e.g. arn:aws:ecs:us-west-2:1234567890:service/my-cluster/my-service.
+func parseServiceARN(serviceARN string) (clusterName, serviceName string, err error) {
+	parsed, err := arn.Parse(serviceARN)
+	if err != nil {
+		return "", "", fmt.Errorf("parse service ARN %s: %w", serviceARN, err)
+	}
+	parts := strings.Split(parsed.Resource, "/")
+	if len(parts) != 3 {
+		return "", "", fmt.Errorf("service ARN %s is not in the long ARN format", serviceARN)
+	}
+	return parts[1], parts[2], nil
+}
+
+// taskDefinitionRevision returns the revision of a task definition ARN,
+// e.g. arn:aws:ecs:us-west-2:1234567890:task-definition/my-family:3.
+func taskDefinitionRevision(taskDefinitionARN string) int64 {
+	var revision int64
+	if i := strings.LastIndex(taskDefinitionARN, ":"); i != -1 {
+		fmt.Sscanf(taskDefinitionARN[i+1:], "%d", &revision)
+	}
+	return revision
+}
@@ -0,0 +1,262 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRG struct {
+	resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI
+
+	mockGetResources func(*resourcegroupstaggingapi.GetResourcesInput) (*resourcegroupstaggingapi.GetResourcesOutput, error)
+}
+
+func (m mockRG) GetResources(in *resourcegroupstaggingapi.GetResourcesInput) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+	return m.mockGetResources(in)
+}
+
+type mockECS struct {
+	ecsiface.ECSAPI
+
+	mockDescribeServices       func(*ecs.DescribeServicesInput) (*ecs.DescribeServicesOutput, error)
+	mockDescribeTaskDefinition func(*ecs.DescribeTaskDefinitionInput) (*ecs.DescribeTaskDefinitionOutput, error)
+}
+
+func (m mockECS) DescribeServices(in *ecs.DescribeServicesInput) (*ecs.DescribeServicesOutput, error) {
+	return m.mockDescribeServices(in)
+}
+
+func (m mockECS) DescribeTaskDefinition(in *ecs.DescribeTaskDefinitionInput) (*ecs.DescribeTaskDefinitionOutput, error) {
+	return m.mockDescribeTaskDefinition(in)
+}
+
+type mockELB struct {
+	elbv2iface.ELBV2API
+
+	mockDescribeTargetHealth func(*elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error)
+}
+
+func (m mockELB) DescribeTargetHealth(in *elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error) {
+	return m.mockDescribeTargetHealth(in)
+}
+
+type mockCW struct {
+	cloudwatchiface.CloudWatchAPI
+
+	mockDescribeAlarms func(*cloudwatch.DescribeAlarmsInput) (*cloudwatch.DescribeAlarmsOutput, error)
+}
+
+func (m mockCW) DescribeAlarms(in *cloudwatch.DescribeAlarmsInput) (*cloudwatch.DescribeAlarmsOutput, error) {
+	return m.mockDescribeAlarms(in)
+}
+
+func TestAppStatusDescriber_Describe(t *testing.T) {
+	const (
+		serviceARN = "arn:aws:ecs:us-west-2:1234567890:service/phonetool-test-Cluster/phonetool-test-frontend-Service"
+		taskDefARN = "arn:aws:ecs:us-west-2:1234567890:task-definition/phonetool-test-frontend:3"
+		alarmARN   = "arn:aws:cloudwatch:us-west-2:1234567890:alarm:frontend-5xx"
+	)
+	updatedAt := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	mockErr := errors.New("some error")
+	servicesOnly := func(in *resourcegroupstaggingapi.GetResourcesInput) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+		if aws.StringValue(in.ResourceTypeFilters[0]) == ecsServiceResourceType {
+			return &resourcegroupstaggingapi.GetResourcesOutput{
+				ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{
+					{ResourceARN: aws.String(serviceARN)},
+				},
+			}, nil
+		}
+		return &resourcegroupstaggingapi.GetResourcesOutput{
+			ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{
+				{ResourceARN: aws.String(alarmARN)},
+			},
+		}, nil
+	}
+
+	testCases := map[string]struct {
+		mockRG  mockRG
+		mockECS mockECS
+		mockELB mockELB
+		mockCW  mockCW
+
+		wantedStatus *AppStatus
+		wantedErr    error
+	}{
+		"returns ErrServiceNotFound if there is no tagged service": {
+			mockRG: mockRG{
+				mockGetResources: func(in *resourcegroupstaggingapi.GetResourcesInput) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+					require.Equal(t, []*resourcegroupstaggingapi.TagFilter{
+						{Key: aws.String("ecs-application"), Values: []*string{aws.String("frontend")}},
+						{Key: aws.String("ecs-environment"), Values: []*string{aws.String("test")}},
+						{Key: aws.String("ecs-project"), Values: []*string{aws.String("phonetool")}},
+					}, in.TagFilters)
+					return &resourcegroupstaggingapi.GetResourcesOutput{}, nil
+				},
+			},
+			wantedErr: &ErrServiceNotFound{AppName: "frontend", EnvName: "test"},
+		},
+		"wraps error from DescribeServices": {
+			mockRG: mockRG{mockGetResources: servicesOnly},
+			mockECS: mockECS{
+				mockDescribeServices: func(in *ecs.DescribeServicesInput) (*ecs.DescribeServicesOutput, error) {
+					return nil, mockErr
+				},
+			},
+			wantedErr: fmt.Errorf("describe service phonetool-test-frontend-Service: %w", mockErr),
+		},
+		"returns the status of the service": {
+			mockRG: mockRG{mockGetResources: servicesOnly},
+			mockECS: mockECS{
+				mockDescribeServices: func(in *ecs.DescribeServicesInput) (*ecs.DescribeServicesOutput, error) {
+					require.Equal(t, "phonetool-test-Cluster", aws.StringValue(in.Cluster))
+					return &ecs.DescribeServicesOutput{
+						Services: []*ecs.Service{
+							{
+								Status:         aws.String("ACTIVE"),
+								DesiredCount:   aws.Int64(2),
+								RunningCount:   aws.Int64(1),
+								PendingCount:   aws.Int64(1),
+								TaskDefinition: aws.String(taskDefARN),
+								Deployments: []*ecs.Deployment{
+									{
+										Status:         aws.String("PRIMARY"),
+										TaskDefinition: aws.String(taskDefARN),
+										DesiredCount:   aws.Int64(2),
+										RunningCount:   aws.Int64(1),
+										PendingCount:   aws.Int64(1),
+										UpdatedAt:      aws.Time(updatedAt),
+									},
+								},
+								LoadBalancers: []*ecs.LoadBalancer{
+									{TargetGroupArn: aws.String("targetGroupARN")},
+								},
+								Events: []*ecs.ServiceEvent{
+									{CreatedAt: aws.Time(updatedAt), Message: aws.String("has reached a steady state.")},
+								},
+							},
+						},
+					}, nil
+				},
+				mockDescribeTaskDefinition: func(in *ecs.DescribeTaskDefinitionInput) (*ecs.DescribeTaskDefinitionOutput, error) {
+					return &ecs.DescribeTaskDefinitionOutput{
+						TaskDefinition: &ecs.TaskDefinition{
+							ContainerDefinitions: []*ecs.ContainerDefinition{
+								{Name: aws.String("sidecar"), Image: aws.String("nginx:latest")},
+								{Name: aws.String("frontend"), Image: aws.String("1234567890.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:manual-bf3678c")},
+							},
+						},
+					}, nil
+				},
+			},
+			mockELB: mockELB{
+				mockDescribeTargetHealth: func(in *elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error) {
+					return &elbv2.DescribeTargetHealthOutput{
+						TargetHealthDescriptions: []*elbv2.TargetHealthDescription{
+							{
+								Target:       &elbv2.TargetDescription{Id: aws.String("10.0.0.1"), Port: aws.Int64(80)},
+								TargetHealth: &elbv2.TargetHealth{State: aws.String("healthy")},
+							},
+						},
+					}, nil
+				},
+			},
+			mockCW: mockCW{
+				mockDescribeAlarms: func(in *cloudwatch.DescribeAlarmsInput) (*cloudwatch.DescribeAlarmsOutput, error) {
+					if in.AlarmNamePrefix != nil {
+						require.Equal(t, "TargetTracking-service/phonetool-test-Cluster/phonetool-test-frontend-Service", aws.StringValue(in.AlarmNamePrefix))
+						return &cloudwatch.DescribeAlarmsOutput{}, nil
+					}
+					require.Equal(t, []*string{aws.String("frontend-5xx")}, in.AlarmNames)
+					return &cloudwatch.DescribeAlarmsOutput{
+						MetricAlarms: []*cloudwatch.MetricAlarm{
+							{
+								AlarmName:             aws.String("frontend-5xx"),
+								StateReason:           aws.String("Threshold crossed"),
+								StateUpdatedTimestamp: aws.Time(updatedAt),
+							},
+						},
+					}, nil
+				},
+			},
+			wantedStatus: &AppStatus{
+				Service: ServiceStatus{
+					Name:                   "phonetool-test-frontend-Service",
+					Status:                 "ACTIVE",
+					DesiredCount:           2,
+					RunningCount:           1,
+					PendingCount:           1,
+					TaskDefinitionRevision: 3,
+					ImageTag:               "manual-bf3678c",
+				},
+				Deployments: []Deployment{
+					{
+						Status:                 "PRIMARY",
+						TaskDefinitionRevision: 3,
+						DesiredCount:           2,
+						RunningCount:           1,
+						PendingCount:           1,
+						UpdatedAt:              updatedAt,
+					},
+				},
+				Targets: []TargetHealth{
+					{TargetID: "10.0.0.1", Port: 80, State: "healthy"},
+				},
+				Alarms: []Alarm{
+					{Name: "frontend-5xx", Reason: "Threshold crossed", UpdatedAt: updatedAt},
+				},
+				Events: []ServiceEvent{
+					{CreatedAt: updatedAt, Message: "has reached a steady state."},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			d := &AppStatusDescriber{
+				rg:  tc.mockRG,
+				ecs: tc.mockECS,
+				elb: tc.mockELB,
+				cw:  tc.mockCW,
+			}
+
+			// WHEN
+			status, err := d.Describe("phonetool", "test", "frontend")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStatus, status)
+		})
+	}
+}
+
+func TestParseServiceARN(t *testing.T) {
+	cluster, service, err := parseServiceARN("arn:aws:ecs:us-west-2:1234567890:service/my-cluster/my-service")
+	require.NoError(t, err)
+	require.Equal(t, "my-cluster", cluster)
+	require.Equal(t, "my-service", service)
+
+	_, _, err = parseServiceARN("arn:aws:ecs:us-west-2:1234567890:service/my-service")
+	require.EqualError(t, err, "service ARN arn:aws:ecs:us-west-2:1234567890:service/my-service is not in the long ARN format")
+}
@@ -0,0 +1,99 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
+)
+
+// Logical IDs of the resources of an application stack.
+const (
+	appTaskDefinitionLogicalID = "TaskDefinition"
+	appSecurityGroupLogicalID  = "ContainerSecurityGroup"
+	appServiceLogicalID        = "Service"
+)
+
+// AppTask holds the network and task definition of an application's service in an environment,
+// so that one-off tasks can run with the same settings.
+type AppTask struct {
+	Cluster        string
+	Service        string // Name of the ECS service running the application.
+	TaskDefinition string
+	Subnets        []string // Private subnets of the environment.
+	SecurityGroups []string
+}
+
+// AppTaskDescriber retrieves the settings of an application's tasks from the environment and application stacks.
+type AppTaskDescriber struct {
+	cfn cloudformationiface.CloudFormationAPI
+}
+
+// NewAppTaskDescriber returns an AppTaskDescriber configured with the input session.
+// The session must be in the region of the environment the application is deployed to.
+func NewAppTaskDescriber(s *session.Session) *AppTaskDescriber {
+	return &AppTaskDescriber{
+		cfn: cloudformation.New(s),
+	}
+}
+
+// Describe returns the cluster, service, private subnets, task definition and security groups of the application in the environment.
+func (d *AppTaskDescriber) Describe(projectName, envName, appName string) (*AppTask, error) {
+	envStackName := stack.NameForEnv(projectName, envName)
+	envStack, err := describeStack(d.cfn, envStackName)
+	if err != nil {
+		return nil, err
+	}
+	if envStack == nil {
+		return nil, fmt.Errorf("stack %s of environment %s does not exist", envStackName, envName)
+	}
+	task := &AppTask{}
+	for _, output := range envStack.Outputs {
+		switch aws.StringValue(output.OutputKey) {
+		case envOutputClusterID:
+			task.Cluster = aws.StringValue(output.OutputValue)
+		case envOutputPrivateSubnets:
+			task.Subnets = splitOutput(aws.StringValue(output.OutputValue))
+		case envOutputSecurityGroup:
+			task.SecurityGroups = append(task.SecurityGroups, aws.StringValue(output.OutputValue))
+		}
+	}
+
+	appStackName := stack.NameForApp(projectName, envName, appName)
+	appStack, err := describeStack(d.cfn, appStackName)
+	if err != nil {
+		return nil, err
+	}
+	if appStack == nil {
+		return nil, &ErrServiceNotFound{AppName: appName, EnvName: envName}
+	}
+	resp, err := d.cfn.DescribeStackResources(&cloudformation.DescribeStackResourcesInput{
+		StackName: aws.String(appStackName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe resources of stack %s: %w", appStackName, err)
+	}
+	for _, r := range resp.StackResources {
+		switch aws.StringValue(r.LogicalResourceId) {
+		case appTaskDefinitionLogicalID:
+			task.TaskDefinition = aws.StringValue(r.PhysicalResourceId)
+		case appSecurityGroupLogicalID:
+			task.SecurityGroups = append(task.SecurityGroups, aws.StringValue(r.PhysicalResourceId))
+		case appServiceLogicalID:
+			// The physical ID of a service is its ARN, which ends with the name of the service.
+			arn := aws.StringValue(r.PhysicalResourceId)
+			task.Service = arn[strings.LastIndex(arn, "/")+1:]
+		}
+	}
+	if task.TaskDefinition == "" {
+		return nil, fmt.Errorf("task definition of application %s not found in stack %s", appName, appStackName)
+	}
+	return task, nil
+}
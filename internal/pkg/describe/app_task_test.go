@@ -0,0 +1,101 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppTaskDescriber_Describe(t *testing.T) {
+	mockErr := errors.New("some error")
+	envStack := &cloudformation.Stack{
+		Outputs: []*cloudformation.Output{
+			{OutputKey: aws.String("ClusterId"), OutputValue: aws.String("phonetool-test-Cluster")},
+			{OutputKey: aws.String("PrivateSubnets"), OutputValue: aws.String("subnet-1,subnet-2")},
+			{OutputKey: aws.String("EnvironmentSecurityGroup"), OutputValue: aws.String("sg-env")},
+		},
+	}
+	stacks := func(appStack *cloudformation.Stack) func(in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+		return func(in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+			switch aws.StringValue(in.StackName) {
+			case "phonetool-test":
+				return &cloudformation.DescribeStacksOutput{Stacks: []*cloudformation.Stack{envStack}}, nil
+			case "phonetool-test-frontend":
+				if appStack == nil {
+					return &cloudformation.DescribeStacksOutput{}, nil
+				}
+				return &cloudformation.DescribeStacksOutput{Stacks: []*cloudformation.Stack{appStack}}, nil
+			}
+			return nil, fmt.Errorf("unexpected stack %s", aws.StringValue(in.StackName))
+		}
+	}
+
+	testCases := map[string]struct {
+		mockCFN mockCFN
+
+		wantedTask *AppTask
+		wantedErr  error
+	}{
+		"returns error if the application is not deployed": {
+			mockCFN: mockCFN{
+				mockDescribeStacks: stacks(nil),
+			},
+			wantedErr: &ErrServiceNotFound{AppName: "frontend", EnvName: "test"},
+		},
+		"returns error if the resources cannot be listed": {
+			mockCFN: mockCFN{
+				mockDescribeStacks: stacks(&cloudformation.Stack{}),
+				mockDescribeStackResources: func(in *cloudformation.DescribeStackResourcesInput) (*cloudformation.DescribeStackResourcesOutput, error) {
+					return nil, mockErr
+				},
+			},
+			wantedErr: errors.New("describe resources of stack phonetool-test-frontend: some error"),
+		},
+		"returns the settings of the service": {
+			mockCFN: mockCFN{
+				mockDescribeStacks: stacks(&cloudformation.Stack{}),
+				mockDescribeStackResources: func(in *cloudformation.DescribeStackResourcesInput) (*cloudformation.DescribeStackResourcesOutput, error) {
+					return &cloudformation.DescribeStackResourcesOutput{
+						StackResources: []*cloudformation.StackResource{
+							{LogicalResourceId: aws.String("TaskDefinition"), PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:1111:task-definition/phonetool-test-frontend:3")},
+							{LogicalResourceId: aws.String("ContainerSecurityGroup"), PhysicalResourceId: aws.String("sg-1")},
+							{LogicalResourceId: aws.String("Service"), PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:1111:service/phonetool-test-Cluster/phonetool-test-frontend-Service")},
+						},
+					}, nil
+				},
+			},
+			wantedTask: &AppTask{
+				Cluster:        "phonetool-test-Cluster",
+				Service:        "phonetool-test-frontend-Service",
+				TaskDefinition: "arn:aws:ecs:us-west-2:1111:task-definition/phonetool-test-frontend:3",
+				Subnets:        []string{"subnet-1", "subnet-2"},
+				SecurityGroups: []string{"sg-env", "sg-1"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			d := &AppTaskDescriber{cfn: tc.mockCFN}
+
+			// WHEN
+			task, err := d.Describe("phonetool", "test", "frontend")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTask, task)
+		})
+	}
+}
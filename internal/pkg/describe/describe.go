@@ -0,0 +1,62 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package describe retrieves information about the resources deployed for a project,
+// such as the live status of an application's service.
+package describe
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
+)
+
+// Resource types used to find resources through their tags.
+const (
+	ecsServiceResourceType = "ecs:service"
+	alarmResourceType      = "cloudwatch:alarm"
+)
+
+// resourceARNs returns the ARNs of the resources of the type that have all the tags.
+// An empty tag value matches any value.
+func resourceARNs(rg resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI, resourceType string, tags map[string]string) ([]string, error) {
+	var keys []string
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var filters []*resourcegroupstaggingapi.TagFilter
+	for _, k := range keys {
+		filter := &resourcegroupstaggingapi.TagFilter{
+			Key:    aws.String(k),
+			Values: []*string{}, // Matches any value.
+		}
+		if tags[k] != "" {
+			filter.Values = []*string{aws.String(tags[k])}
+		}
+		filters = append(filters, filter)
+	}
+
+	var arns []string
+	in := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: []*string{aws.String(resourceType)},
+		TagFilters:          filters,
+	}
+	for {
+		resp, err := rg.GetResources(in)
+		if err != nil {
+			return nil, fmt.Errorf("get %s resources: %w", resourceType, err)
+		}
+		for _, resource := range resp.ResourceTagMappingList {
+			arns = append(arns, aws.StringValue(resource.ResourceARN))
+		}
+		if aws.StringValue(resp.PaginationToken) == "" {
+			break
+		}
+		in.PaginationToken = resp.PaginationToken
+	}
+	return arns, nil
+}
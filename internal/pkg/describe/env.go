@@ -0,0 +1,233 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/progress"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
+)
+
+const stackResourceType = "cloudformation:stack"
+
+const (
+	envOutputVPCID            = "VpcId"
+	envOutputPublicSubnets    = "PublicSubnets"
+	envOutputPrivateSubnets   = "PrivateSubnets"
+	envOutputClusterID        = "ClusterId"
+	envOutputSecurityGroup    = "EnvironmentSecurityGroup"
+	envOutputHTTPSListenerARN = "HTTPSListenerArn"
+	envOutputHostedZone       = "EnvironmentHostedZone"
+)
+
+// Env holds the resources of an environment and the applications deployed to it.
+type Env struct {
+	Name                string         `json:"name"`
+	Project             string         `json:"project"`
+	Region              string         `json:"region"`
+	AccountID           string         `json:"accountID"`
+	Prod                bool           `json:"prod"`
+	StackStatus         string         `json:"stackStatus"`
+	VPCID               string         `json:"vpcID"`
+	PublicSubnets       []string       `json:"publicSubnets"`
+	PrivateSubnets      []string       `json:"privateSubnets"`
+	Cluster             string         `json:"cluster"`
+	LoadBalancerDNSName string         `json:"loadBalancerDNSName"`
+	HTTPSListenerARN    string         `json:"httpsListenerARN"`
+	HostedZoneID        string         `json:"hostedZoneID"`
+	Subdomain           string         `json:"subdomain"`
+	Apps                []*EnvApp      `json:"apps"`
+	Resources           []*CfnResource `json:"resources,omitempty"`
+}
+
+// EnvApp is an application deployed to an environment.
+type EnvApp struct {
+	Name        string `json:"name"`
+	StackStatus string `json:"stackStatus"`
+}
+
+// CfnResource is a resource created by a CloudFormation stack.
+type CfnResource struct {
+	Type       string `json:"type"`
+	LogicalID  string `json:"logicalID"`
+	PhysicalID string `json:"physicalID"`
+}
+
+// EnvDescriber retrieves the resources of an environment from its stack.
+type EnvDescriber struct {
+	cfn cloudformationiface.CloudFormationAPI
+	rg  resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI
+}
+
+// NewEnvDescriber returns an EnvDescriber configured with the input session.
+// The session must be in the region of the environment.
+func NewEnvDescriber(s *session.Session) *EnvDescriber {
+	return &EnvDescriber{
+		cfn: cloudformation.New(s),
+		rg:  resourcegroupstaggingapi.New(s),
+	}
+}
+
+// Describe returns the resources of the environment from the outputs of its stack, and the applications deployed to it.
+// The applications are found through the tags applied to their stacks.
+// If withResources is true, every resource of the environment stack is also returned.
+func (d *EnvDescriber) Describe(env *archer.Environment, withResources bool) (*Env, error) {
+	stackName := stack.NameForEnv(env.Project, env.Name)
+	envStack, err := describeStack(d.cfn, stackName)
+	if err != nil {
+		return nil, err
+	}
+	if envStack == nil {
+		return nil, fmt.Errorf("stack %s of environment %s does not exist", stackName, env.Name)
+	}
+	outputs := make(map[string]string)
+	for _, output := range envStack.Outputs {
+		outputs[aws.StringValue(output.OutputKey)] = aws.StringValue(output.OutputValue)
+	}
+	desc := &Env{
+		Name:                env.Name,
+		Project:             env.Project,
+		Region:              env.Region,
+		AccountID:           env.AccountID,
+		Prod:                env.Prod,
+		StackStatus:         aws.StringValue(envStack.StackStatus),
+		VPCID:               outputs[envOutputVPCID],
+		PublicSubnets:       splitOutput(outputs[envOutputPublicSubnets]),
+		PrivateSubnets:      splitOutput(outputs[envOutputPrivateSubnets]),
+		Cluster:             outputs[envOutputClusterID],
+		LoadBalancerDNSName: outputs[envOutputPublicLoadBalancerDNSName],
+		HTTPSListenerARN:    outputs[envOutputHTTPSListenerARN],
+		HostedZoneID:        outputs[envOutputHostedZone],
+		Subdomain:           outputs[envOutputSubdomain],
+	}
+
+	apps, err := d.apps(env)
+	if err != nil {
+		return nil, err
+	}
+	desc.Apps = apps
+
+	if withResources {
+		resources, err := d.resources(stackName)
+		if err != nil {
+			return nil, err
+		}
+		desc.Resources = resources
+	}
+	return desc, nil
+}
+
+// apps returns the applications whose stacks are tagged with the environment, sorted by name.
+func (d *EnvDescriber) apps(env *archer.Environment) ([]*EnvApp, error) {
+	arns, err := resourceARNs(d.rg, stackResourceType, map[string]string{
+		stack.ProjectTagKey: env.Project,
+		stack.EnvTagKey:     env.Name,
+		stack.AppTagKey:     "",
+	})
+	if err != nil {
+		return nil, err
+	}
+	var apps []*EnvApp
+	for _, arn := range arns {
+		appStack, err := describeStack(d.cfn, arn)
+		if err != nil {
+			return nil, err
+		}
+		if appStack == nil {
+			continue
+		}
+		app := &EnvApp{
+			StackStatus: aws.StringValue(appStack.StackStatus),
+		}
+		for _, t := range appStack.Tags {
+			if aws.StringValue(t.Key) == stack.AppTagKey {
+				app.Name = aws.StringValue(t.Value)
+			}
+		}
+		apps = append(apps, app)
+	}
+	sort.Slice(apps, func(i, j int) bool { return apps[i].Name < apps[j].Name })
+	return apps, nil
+}
+
+// resources returns every resource of the stack.
+func (d *EnvDescriber) resources(stackName string) ([]*CfnResource, error) {
+	resp, err := d.cfn.DescribeStackResources(&cloudformation.DescribeStackResourcesInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe resources of stack %s: %w", stackName, err)
+	}
+	var resources []*CfnResource
+	for _, r := range resp.StackResources {
+		resources = append(resources, &CfnResource{
+			Type:       aws.StringValue(r.ResourceType),
+			LogicalID:  aws.StringValue(r.LogicalResourceId),
+			PhysicalID: aws.StringValue(r.PhysicalResourceId),
+		})
+	}
+	return resources, nil
+}
+
+// HumanString returns the environment formatted in tables for a terminal.
+func (e *Env) HumanString() string {
+	var b bytes.Buffer
+	writer := progress.NewTabWriter(&b)
+
+	fmt.Fprintf(writer, "%s\n", color.HighlightResource("About"))
+	fmt.Fprintf(writer, "  Name\t%s\n", e.Name)
+	fmt.Fprintf(writer, "  Project\t%s\n", e.Project)
+	fmt.Fprintf(writer, "  Production\t%t\n", e.Prod)
+	fmt.Fprintf(writer, "  Region\t%s\n", e.Region)
+	fmt.Fprintf(writer, "  Account ID\t%s\n", e.AccountID)
+	fmt.Fprintf(writer, "  Stack status\t%s\n", e.StackStatus)
+
+	fmt.Fprintf(writer, "\n%s\n", color.HighlightResource("Network"))
+	fmt.Fprintf(writer, "  VPC\t%s\n", valueOrDash(e.VPCID))
+	fmt.Fprintf(writer, "  Public subnets\t%s\n", valueOrDash(strings.Join(e.PublicSubnets, ", ")))
+	fmt.Fprintf(writer, "  Private subnets\t%s\n", valueOrDash(strings.Join(e.PrivateSubnets, ", ")))
+	fmt.Fprintf(writer, "  Load balancer DNS\t%s\n", valueOrDash(e.LoadBalancerDNSName))
+	fmt.Fprintf(writer, "  HTTPS listener\t%s\n", valueOrDash(e.HTTPSListenerARN))
+	fmt.Fprintf(writer, "  Hosted zone\t%s\n", valueOrDash(e.HostedZoneID))
+	fmt.Fprintf(writer, "  Subdomain\t%s\n", valueOrDash(e.Subdomain))
+
+	fmt.Fprintf(writer, "\n%s\n", color.HighlightResource("Cluster"))
+	fmt.Fprintf(writer, "  Name\t%s\n", valueOrDash(e.Cluster))
+
+	fmt.Fprintf(writer, "\n%s\n", color.HighlightResource("Applications"))
+	fmt.Fprintf(writer, "  Name\tStack status\n")
+	for _, app := range e.Apps {
+		fmt.Fprintf(writer, "  %s\t%s\n", app.Name, app.StackStatus)
+	}
+
+	if len(e.Resources) > 0 {
+		fmt.Fprintf(writer, "\n%s\n", color.HighlightResource("Resources"))
+		fmt.Fprintf(writer, "  Type\tLogical ID\tPhysical ID\n")
+		for _, r := range e.Resources {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", r.Type, r.LogicalID, r.PhysicalID)
+		}
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// splitOutput splits a comma-delimited stack output, such as a list of subnets.
+func splitOutput(output string) []string {
+	if output == "" {
+		return nil
+	}
+	return strings.Split(output, ",")
+}
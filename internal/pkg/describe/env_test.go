@@ -0,0 +1,143 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvDescriber_Describe(t *testing.T) {
+	const appStackARN = "arn:aws:cloudformation:us-west-2:1234:stack/phonetool-test-frontend/abc"
+	testEnv := &archer.Environment{Project: "phonetool", Name: "test", Region: "us-west-2", AccountID: "1234"}
+	mockErr := errors.New("some error")
+	envStack := &cloudformation.Stack{
+		StackStatus: aws.String("CREATE_COMPLETE"),
+		Outputs: []*cloudformation.Output{
+			{OutputKey: aws.String("VpcId"), OutputValue: aws.String("vpc-1234")},
+			{OutputKey: aws.String("PublicSubnets"), OutputValue: aws.String("subnet-1,subnet-2")},
+			{OutputKey: aws.String("PrivateSubnets"), OutputValue: aws.String("subnet-3,subnet-4")},
+			{OutputKey: aws.String("ClusterId"), OutputValue: aws.String("phonetool-test-Cluster")},
+			{OutputKey: aws.String("PublicLoadBalancerDNSName"), OutputValue: aws.String("abc.us-west-2.elb.amazonaws.com")},
+		},
+	}
+	stacks := func(in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+		switch aws.StringValue(in.StackName) {
+		case "phonetool-test":
+			return &cloudformation.DescribeStacksOutput{Stacks: []*cloudformation.Stack{envStack}}, nil
+		case appStackARN:
+			return &cloudformation.DescribeStacksOutput{Stacks: []*cloudformation.Stack{{
+				StackStatus: aws.String("UPDATE_COMPLETE"),
+				Tags: []*cloudformation.Tag{
+					{Key: aws.String("ecs-application"), Value: aws.String("frontend")},
+				},
+			}}}, nil
+		}
+		return nil, fmt.Errorf("unexpected stack %s", aws.StringValue(in.StackName))
+	}
+	appStacks := mockRG{
+		mockGetResources: func(in *resourcegroupstaggingapi.GetResourcesInput) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+			require.Equal(t, "cloudformation:stack", aws.StringValue(in.ResourceTypeFilters[0]))
+			return &resourcegroupstaggingapi.GetResourcesOutput{
+				ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{
+					{ResourceARN: aws.String(appStackARN)},
+				},
+			}, nil
+		},
+	}
+	wantedEnv := Env{
+		Name:                "test",
+		Project:             "phonetool",
+		Region:              "us-west-2",
+		AccountID:           "1234",
+		StackStatus:         "CREATE_COMPLETE",
+		VPCID:               "vpc-1234",
+		PublicSubnets:       []string{"subnet-1", "subnet-2"},
+		PrivateSubnets:      []string{"subnet-3", "subnet-4"},
+		Cluster:             "phonetool-test-Cluster",
+		LoadBalancerDNSName: "abc.us-west-2.elb.amazonaws.com",
+		Apps:                []*EnvApp{{Name: "frontend", StackStatus: "UPDATE_COMPLETE"}},
+	}
+	withResources := wantedEnv
+	withResources.Resources = []*CfnResource{
+		{Type: "AWS::EC2::VPC", LogicalID: "VPC", PhysicalID: "vpc-1234"},
+	}
+
+	testCases := map[string]struct {
+		inWithResources bool
+		mockCFN         mockCFN
+		mockRG          mockRG
+
+		wantedEnv *Env
+		wantedErr error
+	}{
+		"returns error if the environment stack does not exist": {
+			mockCFN: mockCFN{
+				mockDescribeStacks: func(in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+					return nil, awserr.New("ValidationError", "Stack with id phonetool-test does not exist", nil)
+				},
+			},
+			wantedErr: errors.New("stack phonetool-test of environment test does not exist"),
+		},
+		"wraps error if the application stacks cannot be found": {
+			mockCFN: mockCFN{mockDescribeStacks: stacks},
+			mockRG: mockRG{
+				mockGetResources: func(in *resourcegroupstaggingapi.GetResourcesInput) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+					return nil, mockErr
+				},
+			},
+			wantedErr: fmt.Errorf("get cloudformation:stack resources: %w", mockErr),
+		},
+		"returns the resources of the environment and its applications": {
+			mockCFN:   mockCFN{mockDescribeStacks: stacks},
+			mockRG:    appStacks,
+			wantedEnv: &wantedEnv,
+		},
+		"returns every resource of the environment stack": {
+			inWithResources: true,
+			mockCFN: mockCFN{
+				mockDescribeStacks: stacks,
+				mockDescribeStackResources: func(in *cloudformation.DescribeStackResourcesInput) (*cloudformation.DescribeStackResourcesOutput, error) {
+					require.Equal(t, "phonetool-test", aws.StringValue(in.StackName))
+					return &cloudformation.DescribeStackResourcesOutput{
+						StackResources: []*cloudformation.StackResource{
+							{ResourceType: aws.String("AWS::EC2::VPC"), LogicalResourceId: aws.String("VPC"), PhysicalResourceId: aws.String("vpc-1234")},
+						},
+					}, nil
+				},
+			},
+			mockRG:    appStacks,
+			wantedEnv: &withResources,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			d := &EnvDescriber{
+				cfn: tc.mockCFN,
+				rg:  tc.mockRG,
+			}
+
+			// WHEN
+			env, err := d.Describe(testEnv, tc.inWithResources)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEnv, env)
+		})
+	}
+}
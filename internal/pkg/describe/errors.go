@@ -0,0 +1,16 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import "fmt"
+
+// ErrServiceNotFound occurs when the ECS service of an application can't be found in an environment.
+type ErrServiceNotFound struct {
+	AppName string
+	EnvName string
+}
+
+func (e *ErrServiceNotFound) Error() string {
+	return fmt.Sprintf("couldn't find a service for application %s in environment %s, has it been deployed?", e.AppName, e.EnvName)
+}
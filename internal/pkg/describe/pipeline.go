@@ -0,0 +1,301 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/progress"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/aws-sdk-go/service/codepipeline/codepipelineiface"
+)
+
+// Names of the stages and actions of the pipeline template.
+const (
+	pipelineSourceStageName    = "Source"
+	pipelineDeployStagePrefix  = "DeployTo-"       // Followed by the environment name.
+	pipelineDeployActionPrefix = "CreateOrUpdate-" // Followed by the application and environment names.
+	pipelineSecretParamKey     = "GitHubAccessTokenSecretId"
+
+	sourceOwnerConfigKey   = "Owner"
+	sourceRepoConfigKey    = "Repo"
+	sourceBranchConfigKey  = "Branch"
+	deployRoleARNConfigKey = "RoleArn"
+)
+
+// Pipeline is the source repository and the stages of a pipeline.
+type Pipeline struct {
+	Name   string          `json:"name"`
+	Source PipelineSource  `json:"source"`
+	Stages []PipelineStage `json:"stages"`
+	Secret string          `json:"secret,omitempty"`
+}
+
+// PipelineSource is the repository that triggers the pipeline.
+type PipelineSource struct {
+	Provider   string `json:"provider"`
+	Repository string `json:"repository"`
+	Branch     string `json:"branch"`
+}
+
+// PipelineStage is a stage of the pipeline. Deploy stages deploy applications to an environment.
+type PipelineStage struct {
+	Name             string   `json:"name"`
+	Environment      string   `json:"environment,omitempty"`
+	AccountID        string   `json:"accountID,omitempty"`
+	Region           string   `json:"region,omitempty"`
+	Applications     []string `json:"applications,omitempty"`
+	RequiresApproval bool     `json:"requiresApproval"`
+}
+
+// PipelineStatus is the latest execution state of the stages of a pipeline.
+type PipelineStatus struct {
+	Name     string        `json:"name"`
+	Revision string        `json:"revision,omitempty"` // Latest revision of the source repository.
+	Stages   []StageStatus `json:"stages"`
+}
+
+// StageStatus is the state of the latest execution of a stage.
+type StageStatus struct {
+	Name    string         `json:"name"`
+	Status  string         `json:"status"`
+	Actions []ActionStatus `json:"actions"`
+}
+
+// ActionStatus is the state of the latest execution of an action.
+// Manual approvals that are in progress are waiting for someone to approve them.
+type ActionStatus struct {
+	Name               string    `json:"name"`
+	Status             string    `json:"status"`
+	Summary            string    `json:"summary,omitempty"`
+	UpdatedAt          time.Time `json:"updatedAt"`
+	WaitingForApproval bool      `json:"waitingForApproval"`
+}
+
+// PipelineDescriber retrieves the configuration and the state of a pipeline.
+type PipelineDescriber struct {
+	cp  codepipelineiface.CodePipelineAPI
+	cfn cloudformationiface.CloudFormationAPI
+}
+
+// NewPipelineDescriber returns a PipelineDescriber configured with the input session.
+// The session must be in the region the pipeline is deployed to.
+func NewPipelineDescriber(s *session.Session) *PipelineDescriber {
+	return &PipelineDescriber{
+		cp:  codepipeline.New(s),
+		cfn: cloudformation.New(s),
+	}
+}
+
+// Describe returns the source and the stages of the pipeline.
+// The pipeline is named after its CloudFormation stack.
+func (d *PipelineDescriber) Describe(name string) (*Pipeline, error) {
+	resp, err := d.cp.GetPipeline(&codepipeline.GetPipelineInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get pipeline %s: %w", name, err)
+	}
+	secret, err := d.secretName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline := &Pipeline{
+		Name:   name,
+		Secret: secret,
+	}
+	for _, s := range resp.Pipeline.Stages {
+		stageName := aws.StringValue(s.Name)
+		if stageName == pipelineSourceStageName {
+			pipeline.Source = pipelineSource(s)
+		}
+		stage, err := pipelineStage(s)
+		if err != nil {
+			return nil, err
+		}
+		pipeline.Stages = append(pipeline.Stages, stage)
+	}
+	return pipeline, nil
+}
+
+// Status returns the state of the latest execution of each stage and action of the pipeline.
+func (d *PipelineDescriber) Status(name string) (*PipelineStatus, error) {
+	resp, err := d.cp.GetPipelineState(&codepipeline.GetPipelineStateInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get state of pipeline %s: %w", name, err)
+	}
+
+	status := &PipelineStatus{
+		Name: name,
+	}
+	for _, s := range resp.StageStates {
+		stage := StageStatus{
+			Name: aws.StringValue(s.StageName),
+		}
+		if s.LatestExecution != nil {
+			stage.Status = aws.StringValue(s.LatestExecution.Status)
+		}
+		for _, a := range s.ActionStates {
+			action := ActionStatus{
+				Name: aws.StringValue(a.ActionName),
+			}
+			if a.LatestExecution != nil {
+				action.Status = aws.StringValue(a.LatestExecution.Status)
+				action.Summary = aws.StringValue(a.LatestExecution.Summary)
+				action.UpdatedAt = aws.TimeValue(a.LatestExecution.LastStatusChange)
+				// Only manual approvals that haven't been approved or rejected yet have a token.
+				action.WaitingForApproval = action.Status == codepipeline.ActionExecutionStatusInProgress && a.LatestExecution.Token != nil
+			}
+			if stage.Name == pipelineSourceStageName && status.Revision == "" && a.CurrentRevision != nil {
+				status.Revision = aws.StringValue(a.CurrentRevision.RevisionId)
+			}
+			stage.Actions = append(stage.Actions, action)
+		}
+		status.Stages = append(status.Stages, stage)
+	}
+	return status, nil
+}
+
+// secretName returns the name of the secret holding the GitHub access token of the pipeline.
+func (d *PipelineDescriber) secretName(stackName string) (string, error) {
+	resp, err := d.cfn.DescribeStacks(&cloudformation.DescribeStacksInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return "", fmt.Errorf("describe stack %s: %w", stackName, err)
+	}
+	if len(resp.Stacks) == 0 {
+		return "", fmt.Errorf("stack %s not found", stackName)
+	}
+	for _, p := range resp.Stacks[0].Parameters {
+		if aws.StringValue(p.ParameterKey) == pipelineSecretParamKey {
+			return aws.StringValue(p.ParameterValue), nil
+		}
+	}
+	return "", nil
+}
+
+func pipelineSource(s *codepipeline.StageDeclaration) PipelineSource {
+	var source PipelineSource
+	for _, a := range s.Actions {
+		if a.ActionTypeId == nil || aws.StringValue(a.ActionTypeId.Category) != codepipeline.ActionCategorySource {
+			continue
+		}
+		source.Provider = aws.StringValue(a.ActionTypeId.Provider)
+		source.Repository = fmt.Sprintf("%s/%s", aws.StringValue(a.Configuration[sourceOwnerConfigKey]), aws.StringValue(a.Configuration[sourceRepoConfigKey]))
+		source.Branch = aws.StringValue(a.Configuration[sourceBranchConfigKey])
+		break
+	}
+	return source
+}
+
+// pipelineStage returns the stage with the environment, account, region and applications it deploys to.
+func pipelineStage(s *codepipeline.StageDeclaration) (PipelineStage, error) {
+	stage := PipelineStage{
+		Name: aws.StringValue(s.Name),
+	}
+	if !strings.HasPrefix(stage.Name, pipelineDeployStagePrefix) {
+		return stage, nil
+	}
+	stage.Environment = strings.TrimPrefix(stage.Name, pipelineDeployStagePrefix)
+	deployActionSuffix := "-" + stage.Environment
+	for _, a := range s.Actions {
+		if a.ActionTypeId == nil {
+			continue
+		}
+		switch aws.StringValue(a.ActionTypeId.Category) {
+		case codepipeline.ActionCategoryApproval:
+			stage.RequiresApproval = true
+		case codepipeline.ActionCategoryDeploy:
+			actionName := aws.StringValue(a.Name)
+			stage.Applications = append(stage.Applications, strings.TrimSuffix(strings.TrimPrefix(actionName, pipelineDeployActionPrefix), deployActionSuffix))
+			stage.Region = aws.StringValue(a.Region)
+			if roleARN := aws.StringValue(a.Configuration[deployRoleARNConfigKey]); roleARN != "" {
+				parsed, err := arn.Parse(roleARN)
+				if err != nil {
+					return PipelineStage{}, fmt.Errorf("parse role ARN %s of action %s: %w", roleARN, actionName, err)
+				}
+				stage.AccountID = parsed.AccountID
+			}
+		}
+	}
+	return stage, nil
+}
+
+// HumanString returns the stringified Pipeline struct with human readable format.
+func (p *Pipeline) HumanString() string {
+	var b bytes.Buffer
+	writer := progress.NewTabWriter(&b)
+
+	fmt.Fprintf(writer, "%s\n", color.HighlightResource("About"))
+	fmt.Fprintf(writer, "  Name\t%s\n", p.Name)
+	fmt.Fprintf(writer, "  Provider\t%s\n", p.Source.Provider)
+	fmt.Fprintf(writer, "  Repository\t%s\n", p.Source.Repository)
+	fmt.Fprintf(writer, "  Branch\t%s\n", p.Source.Branch)
+	if p.Secret != "" {
+		fmt.Fprintf(writer, "  Access token secret\t%s\n", p.Secret)
+	}
+
+	fmt.Fprintf(writer, "\n%s\n", color.HighlightResource("Stages"))
+	fmt.Fprintf(writer, "  Name\tEnvironment\tAccount\tRegion\tApplications\tApproval\n")
+	for _, s := range p.Stages {
+		approval := "-"
+		if s.RequiresApproval {
+			approval = "Manual"
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\t%s\n", s.Name, valueOrDash(s.Environment), valueOrDash(s.AccountID),
+			valueOrDash(s.Region), valueOrDash(strings.Join(s.Applications, ", ")), approval)
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// HumanString returns the stringified PipelineStatus struct with human readable format.
+func (s *PipelineStatus) HumanString() string {
+	var b bytes.Buffer
+	writer := progress.NewTabWriter(&b)
+
+	fmt.Fprintf(writer, "%s\n", color.HighlightResource("Pipeline"))
+	fmt.Fprintf(writer, "  Name\t%s\n", s.Name)
+	fmt.Fprintf(writer, "  Latest revision\t%s\n", valueOrDash(s.Revision))
+
+	var approvals []string
+	fmt.Fprintf(writer, "\n%s\n", color.HighlightResource("Stages"))
+	fmt.Fprintf(writer, "  Stage\tAction\tStatus\tUpdated at\n")
+	for _, stage := range s.Stages {
+		fmt.Fprintf(writer, "  %s\t\t%s\t\n", stage.Name, valueOrDash(stage.Status))
+		for _, a := range stage.Actions {
+			updatedAt := "-"
+			if !a.UpdatedAt.IsZero() {
+				updatedAt = a.UpdatedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(writer, "  \t%s\t%s\t%s\n", a.Name, valueOrDash(a.Status), updatedAt)
+			if a.WaitingForApproval {
+				approvals = append(approvals, fmt.Sprintf("%s/%s", stage.Name, a.Name))
+			}
+		}
+	}
+
+	fmt.Fprintf(writer, "\n%s\n", color.HighlightResource("Approvals"))
+	if len(approvals) == 0 {
+		fmt.Fprintf(writer, "  No approvals waiting.\n")
+	}
+	for _, approval := range approvals {
+		fmt.Fprintf(writer, "  %s\tWaiting for approval\n", color.Magenta.Sprint(approval))
+	}
+	writer.Flush()
+	return b.String()
+}
@@ -0,0 +1,301 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/aws-sdk-go/service/codepipeline/codepipelineiface"
+	"github.com/stretchr/testify/require"
+)
+
+type mockCodePipeline struct {
+	codepipelineiface.CodePipelineAPI
+
+	mockGetPipeline      func(*codepipeline.GetPipelineInput) (*codepipeline.GetPipelineOutput, error)
+	mockGetPipelineState func(*codepipeline.GetPipelineStateInput) (*codepipeline.GetPipelineStateOutput, error)
+}
+
+func (m mockCodePipeline) GetPipeline(in *codepipeline.GetPipelineInput) (*codepipeline.GetPipelineOutput, error) {
+	return m.mockGetPipeline(in)
+}
+
+func (m mockCodePipeline) GetPipelineState(in *codepipeline.GetPipelineStateInput) (*codepipeline.GetPipelineStateOutput, error) {
+	return m.mockGetPipelineState(in)
+}
+
+func TestPipelineDescriber_Describe(t *testing.T) {
+	mockErr := errors.New("some error")
+	testPipeline := &codepipeline.PipelineDeclaration{
+		Name: aws.String("phonetool-pipeline"),
+		Stages: []*codepipeline.StageDeclaration{
+			{
+				Name: aws.String("Source"),
+				Actions: []*codepipeline.ActionDeclaration{
+					{
+						Name: aws.String("SourceCodeFor-phonetool"),
+						ActionTypeId: &codepipeline.ActionTypeId{
+							Category: aws.String(codepipeline.ActionCategorySource),
+							Provider: aws.String("GitHub"),
+						},
+						Configuration: map[string]*string{
+							"Owner":  aws.String("badgoose"),
+							"Repo":   aws.String("phonetool"),
+							"Branch": aws.String("master"),
+						},
+					},
+				},
+			},
+			{
+				Name: aws.String("Build"),
+				Actions: []*codepipeline.ActionDeclaration{
+					{
+						Name: aws.String("Build"),
+						ActionTypeId: &codepipeline.ActionTypeId{
+							Category: aws.String(codepipeline.ActionCategoryBuild),
+							Provider: aws.String("CodeBuild"),
+						},
+					},
+				},
+			},
+			{
+				Name: aws.String("DeployTo-prod-iad"),
+				Actions: []*codepipeline.ActionDeclaration{
+					{
+						Name: aws.String("ApprovePromotionTo-prod-iad"),
+						ActionTypeId: &codepipeline.ActionTypeId{
+							Category: aws.String(codepipeline.ActionCategoryApproval),
+							Provider: aws.String("Manual"),
+						},
+					},
+					{
+						Name:   aws.String("CreateOrUpdate-front-end-prod-iad"),
+						Region: aws.String("us-east-1"),
+						ActionTypeId: &codepipeline.ActionTypeId{
+							Category: aws.String(codepipeline.ActionCategoryDeploy),
+							Provider: aws.String("CloudFormation"),
+						},
+						Configuration: map[string]*string{
+							"RoleArn": aws.String("arn:aws:iam::123456789012:role/phonetool-prod-iad-CFNExecutionRole"),
+						},
+					},
+					{
+						Name:   aws.String("CreateOrUpdate-api-prod-iad"),
+						Region: aws.String("us-east-1"),
+						ActionTypeId: &codepipeline.ActionTypeId{
+							Category: aws.String(codepipeline.ActionCategoryDeploy),
+							Provider: aws.String("CloudFormation"),
+						},
+						Configuration: map[string]*string{
+							"RoleArn": aws.String("arn:aws:iam::123456789012:role/phonetool-prod-iad-CFNExecutionRole"),
+						},
+					},
+				},
+			},
+		},
+	}
+	testStack := &cloudformation.Stack{
+		Parameters: []*cloudformation.Parameter{
+			{
+				ParameterKey:   aws.String("GitHubAccessTokenSecretId"),
+				ParameterValue: aws.String("github-token-phonetool-phonetool"),
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		mockCP  mockCodePipeline
+		mockCFN mockCFN
+
+		wantedPipeline *Pipeline
+		wantedErr      error
+	}{
+		"returns error if the pipeline can't be retrieved": {
+			mockCP: mockCodePipeline{
+				mockGetPipeline: func(in *codepipeline.GetPipelineInput) (*codepipeline.GetPipelineOutput, error) {
+					return nil, mockErr
+				},
+			},
+			wantedErr: errors.New("get pipeline phonetool-pipeline: some error"),
+		},
+		"returns error if the stack can't be described": {
+			mockCP: mockCodePipeline{
+				mockGetPipeline: func(in *codepipeline.GetPipelineInput) (*codepipeline.GetPipelineOutput, error) {
+					return &codepipeline.GetPipelineOutput{Pipeline: testPipeline}, nil
+				},
+			},
+			mockCFN: mockCFN{
+				mockDescribeStacks: func(in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+					return nil, mockErr
+				},
+			},
+			wantedErr: errors.New("describe stack phonetool-pipeline: some error"),
+		},
+		"returns the source and the environments of the deploy stages": {
+			mockCP: mockCodePipeline{
+				mockGetPipeline: func(in *codepipeline.GetPipelineInput) (*codepipeline.GetPipelineOutput, error) {
+					require.Equal(t, "phonetool-pipeline", aws.StringValue(in.Name))
+					return &codepipeline.GetPipelineOutput{Pipeline: testPipeline}, nil
+				},
+			},
+			mockCFN: mockCFN{
+				mockDescribeStacks: func(in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+					require.Equal(t, "phonetool-pipeline", aws.StringValue(in.StackName))
+					return &cloudformation.DescribeStacksOutput{Stacks: []*cloudformation.Stack{testStack}}, nil
+				},
+			},
+			wantedPipeline: &Pipeline{
+				Name: "phonetool-pipeline",
+				Source: PipelineSource{
+					Provider:   "GitHub",
+					Repository: "badgoose/phonetool",
+					Branch:     "master",
+				},
+				Stages: []PipelineStage{
+					{Name: "Source"},
+					{Name: "Build"},
+					{
+						Name:             "DeployTo-prod-iad",
+						Environment:      "prod-iad",
+						AccountID:        "123456789012",
+						Region:           "us-east-1",
+						Applications:     []string{"front-end", "api"},
+						RequiresApproval: true,
+					},
+				},
+				Secret: "github-token-phonetool-phonetool",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			d := &PipelineDescriber{
+				cp:  tc.mockCP,
+				cfn: tc.mockCFN,
+			}
+
+			// WHEN
+			pipeline, err := d.Describe("phonetool-pipeline")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPipeline, pipeline)
+		})
+	}
+}
+
+func TestPipelineDescriber_Status(t *testing.T) {
+	mockErr := errors.New("some error")
+	updatedAt := time.Date(2020, 3, 13, 19, 50, 30, 0, time.UTC)
+
+	testCases := map[string]struct {
+		mockCP mockCodePipeline
+
+		wantedStatus *PipelineStatus
+		wantedErr    error
+	}{
+		"returns error if the state can't be retrieved": {
+			mockCP: mockCodePipeline{
+				mockGetPipelineState: func(in *codepipeline.GetPipelineStateInput) (*codepipeline.GetPipelineStateOutput, error) {
+					return nil, mockErr
+				},
+			},
+			wantedErr: errors.New("get state of pipeline phonetool-pipeline: some error"),
+		},
+		"returns the latest revision and the approvals waiting": {
+			mockCP: mockCodePipeline{
+				mockGetPipelineState: func(in *codepipeline.GetPipelineStateInput) (*codepipeline.GetPipelineStateOutput, error) {
+					require.Equal(t, "phonetool-pipeline", aws.StringValue(in.Name))
+					return &codepipeline.GetPipelineStateOutput{
+						StageStates: []*codepipeline.StageState{
+							{
+								StageName:       aws.String("Source"),
+								LatestExecution: &codepipeline.StageExecution{Status: aws.String(codepipeline.StageExecutionStatusSucceeded)},
+								ActionStates: []*codepipeline.ActionState{
+									{
+										ActionName:      aws.String("SourceCodeFor-phonetool"),
+										CurrentRevision: &codepipeline.ActionRevision{RevisionId: aws.String("f2b9c1d")},
+										LatestExecution: &codepipeline.ActionExecution{
+											Status:           aws.String(codepipeline.ActionExecutionStatusSucceeded),
+											LastStatusChange: aws.Time(updatedAt),
+										},
+									},
+								},
+							},
+							{
+								StageName:       aws.String("DeployTo-prod"),
+								LatestExecution: &codepipeline.StageExecution{Status: aws.String(codepipeline.StageExecutionStatusInProgress)},
+								ActionStates: []*codepipeline.ActionState{
+									{
+										ActionName: aws.String("ApprovePromotionTo-prod"),
+										LatestExecution: &codepipeline.ActionExecution{
+											Status:           aws.String(codepipeline.ActionExecutionStatusInProgress),
+											LastStatusChange: aws.Time(updatedAt),
+											Token:            aws.String("token"),
+										},
+									},
+									{
+										ActionName: aws.String("CreateOrUpdate-api-prod"),
+									},
+								},
+							},
+						},
+					}, nil
+				},
+			},
+			wantedStatus: &PipelineStatus{
+				Name:     "phonetool-pipeline",
+				Revision: "f2b9c1d",
+				Stages: []StageStatus{
+					{
+						Name:   "Source",
+						Status: codepipeline.StageExecutionStatusSucceeded,
+						Actions: []ActionStatus{
+							{Name: "SourceCodeFor-phonetool", Status: codepipeline.ActionExecutionStatusSucceeded, UpdatedAt: updatedAt},
+						},
+					},
+					{
+						Name:   "DeployTo-prod",
+						Status: codepipeline.StageExecutionStatusInProgress,
+						Actions: []ActionStatus{
+							{Name: "ApprovePromotionTo-prod", Status: codepipeline.ActionExecutionStatusInProgress, UpdatedAt: updatedAt, WaitingForApproval: true},
+							{Name: "CreateOrUpdate-api-prod"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			d := &PipelineDescriber{
+				cp: tc.mockCP,
+			}
+
+			// WHEN
+			status, err := d.Status("phonetool-pipeline")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStatus, status)
+		})
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/session"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/progress"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
+)
+
+// StackStatusNotDeployed is the stack status of an application that was never deployed to an environment.
+const StackStatusNotDeployed = "NOT_DEPLOYED"
+
+const (
+	envOutputPublicLoadBalancerDNSName = "PublicLoadBalancerDNSName"
+	envOutputSubdomain                 = "EnvironmentSubdomain"
+	appParamContainerImage             = "ContainerImage"
+
+	// The routing path of an application that receives all the requests of the load balancer.
+	wildcardRulePath = "*"
+)
+
+// WebApp holds the configuration and endpoints of a web application in every environment of the project.
+type WebApp struct {
+	Name           string               `json:"name"`
+	Project        string               `json:"project"`
+	RepositoryURLs map[string]string    `json:"repositoryURLs"` // ECR repository URL keyed by region.
+	Environments   []*WebAppEnvironment `json:"environments"`
+}
+
+// WebAppEnvironment holds the deployment of a web application in an environment.
+type WebAppEnvironment struct {
+	Name        string        `json:"name"`
+	URL         string        `json:"url"`
+	StackStatus string        `json:"stackStatus"`
+	ImageTag    string        `json:"imageTag"`
+	Config      *WebAppConfig `json:"config"`
+}
+
+// WebAppConfig is the configuration of a web application in an environment.
+// Secrets hold the name of the SSM parameter of each secret, not its value.
+type WebAppConfig struct {
+	Path      string            `json:"path"`
+	CPU       int               `json:"cpu"`
+	Memory    int               `json:"memory"`
+	Count     int               `json:"count"`
+	Variables map[string]string `json:"variables"`
+	Secrets   map[string]string `json:"secrets"`
+}
+
+// WebAppDescriber retrieves the endpoints and the stacks of a web application.
+type WebAppDescriber struct {
+	cfn map[string]cloudformationiface.CloudFormationAPI // CloudFormation clients keyed by environment name.
+}
+
+// NewWebAppDescriber returns a WebAppDescriber.
+// The CloudFormation client of an environment is created from its manager role the first time it's needed.
+func NewWebAppDescriber() *WebAppDescriber {
+	return &WebAppDescriber{
+		cfn: make(map[string]cloudformationiface.CloudFormationAPI),
+	}
+}
+
+// Describe returns the URL, stack status and last deployed image tag of the application in the environment.
+// If the application is not deployed in the environment, the stack status is StackStatusNotDeployed.
+func (d *WebAppDescriber) Describe(env *archer.Environment, appName, rulePath string) (*WebAppEnvironment, error) {
+	cfn, err := d.client(env)
+	if err != nil {
+		return nil, err
+	}
+	desc := &WebAppEnvironment{
+		Name:        env.Name,
+		StackStatus: StackStatusNotDeployed,
+	}
+	appStack, err := describeStack(cfn, stack.NameForApp(env.Project, env.Name, appName))
+	if err != nil {
+		return nil, err
+	}
+	if appStack == nil {
+		return desc, nil
+	}
+	desc.StackStatus = aws.StringValue(appStack.StackStatus)
+	for _, param := range appStack.Parameters {
+		if aws.StringValue(param.ParameterKey) == appParamContainerImage {
+			desc.ImageTag = imageTag(aws.StringValue(param.ParameterValue))
+		}
+	}
+
+	envStack, err := describeStack(cfn, stack.NameForEnv(env.Project, env.Name))
+	if err != nil {
+		return nil, err
+	}
+	if envStack == nil {
+		return desc, nil
+	}
+	outputs := make(map[string]string)
+	for _, output := range envStack.Outputs {
+		outputs[aws.StringValue(output.OutputKey)] = aws.StringValue(output.OutputValue)
+	}
+	desc.URL = url(outputs, appName, rulePath)
+	return desc, nil
+}
+
+func (d *WebAppDescriber) client(env *archer.Environment) (cloudformationiface.CloudFormationAPI, error) {
+	if cfn, ok := d.cfn[env.Name]; ok {
+		return cfn, nil
+	}
+	sess, err := session.FromEnvRole(env.Name, env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create session from role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	d.cfn[env.Name] = cloudformation.New(sess)
+	return d.cfn[env.Name], nil
+}
+
+// HumanString returns the application formatted in tables for a terminal.
+func (w *WebApp) HumanString() string {
+	var b bytes.Buffer
+	writer := progress.NewTabWriter(&b)
+
+	fmt.Fprintf(writer, "%s\n", color.HighlightResource("About"))
+	fmt.Fprintf(writer, "  Project\t%s\n", w.Project)
+	fmt.Fprintf(writer, "  Name\t%s\n", w.Name)
+
+	fmt.Fprintf(writer, "\n%s\n", color.HighlightResource("Repositories"))
+	fmt.Fprintf(writer, "  Region\tURL\n")
+	for _, region := range sortedKeys(w.RepositoryURLs) {
+		fmt.Fprintf(writer, "  %s\t%s\n", region, w.RepositoryURLs[region])
+	}
+
+	fmt.Fprintf(writer, "\n%s\n", color.HighlightResource("Routes"))
+	fmt.Fprintf(writer, "  Environment\tURL\tStack status\tImage tag\n")
+	for _, env := range w.Environments {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", env.Name, valueOrDash(env.URL), env.StackStatus, valueOrDash(env.ImageTag))
+	}
+
+	fmt.Fprintf(writer, "\n%s\n", color.HighlightResource("Configurations"))
+	fmt.Fprintf(writer, "  Environment\tPath\tTasks\tCPU (vCPU)\tMemory (MiB)\n")
+	for _, env := range w.Environments {
+		if env.Config == nil {
+			continue
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%d\t%s\t%d\n", env.Name, env.Config.Path, env.Config.Count, cpuToVCPU(env.Config.CPU), env.Config.Memory)
+	}
+
+	fmt.Fprintf(writer, "\n%s\n", color.HighlightResource("Variables"))
+	fmt.Fprintf(writer, "  Name\tEnvironment\tValue\n")
+	for _, env := range w.Environments {
+		if env.Config == nil {
+			continue
+		}
+		for _, k := range sortedKeys(env.Config.Variables) {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", k, env.Name, env.Config.Variables[k])
+		}
+	}
+
+	fmt.Fprintf(writer, "\n%s\n", color.HighlightResource("Secrets"))
+	fmt.Fprintf(writer, "  Name\tEnvironment\tParameter\n")
+	for _, env := range w.Environments {
+		if env.Config == nil {
+			continue
+		}
+		for _, k := range sortedKeys(env.Config.Secrets) {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", k, env.Name, env.Config.Secrets[k])
+		}
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// describeStack returns the stack or nil if it doesn't exist.
+func describeStack(cfn cloudformationiface.CloudFormationAPI, stackName string) (*cloudformation.Stack, error) {
+	resp, err := cfn.DescribeStacks(&cloudformation.DescribeStacksInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "ValidationError" && strings.Contains(aerr.Message(), "does not exist") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("describe stack %s: %w", stackName, err)
+	}
+	if len(resp.Stacks) == 0 {
+		return nil, nil
+	}
+	return resp.Stacks[0], nil
+}
+
+// url returns the URL of the application from the outputs of the environment stack.
+// If the environment has a subdomain, the application is reachable over HTTPS through its own subdomain.
+// Otherwise, it's reachable over HTTP through the path of its listener rule on the public load balancer.
+func url(envOutputs map[string]string, appName, rulePath string) string {
+	if subdomain, ok := envOutputs[envOutputSubdomain]; ok {
+		return fmt.Sprintf("https://%s.%s", appName, subdomain)
+	}
+	dnsName, ok := envOutputs[envOutputPublicLoadBalancerDNSName]
+	if !ok {
+		return ""
+	}
+	if rulePath == "" || rulePath == wildcardRulePath {
+		return fmt.Sprintf("http://%s", dnsName)
+	}
+	return fmt.Sprintf("http://%s/%s", dnsName, strings.TrimPrefix(rulePath, "/"))
+}
+
+// imageTag returns the tag of an image URI, e.g. "manual-bf3678c" for "1234.dkr.ecr.us-west-2.amazonaws.com/frontend:manual-bf3678c".
+func imageTag(image string) string {
+	if i := strings.LastIndex(image, ":"); i != -1 && !strings.Contains(image[i:], "/") {
+		return image[i+1:]
+	}
+	return ""
+}
+
+// cpuToVCPU converts CPU units to vCPUs, e.g. 256 to "0.25".
+func cpuToVCPU(cpu int) string {
+	return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.2f", float64(cpu)/1024), "0"), ".")
+}
+
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
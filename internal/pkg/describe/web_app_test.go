@@ -0,0 +1,161 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
+	"github.com/stretchr/testify/require"
+)
+
+type mockCFN struct {
+	cloudformationiface.CloudFormationAPI
+
+	mockDescribeStacks         func(*cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error)
+	mockDescribeStackResources func(*cloudformation.DescribeStackResourcesInput) (*cloudformation.DescribeStackResourcesOutput, error)
+}
+
+func (m mockCFN) DescribeStacks(in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+	return m.mockDescribeStacks(in)
+}
+
+func (m mockCFN) DescribeStackResources(in *cloudformation.DescribeStackResourcesInput) (*cloudformation.DescribeStackResourcesOutput, error) {
+	return m.mockDescribeStackResources(in)
+}
+
+func TestWebAppDescriber_Describe(t *testing.T) {
+	mockErr := errors.New("some error")
+	appStack := &cloudformation.Stack{
+		StackStatus: aws.String("UPDATE_COMPLETE"),
+		Parameters: []*cloudformation.Parameter{
+			{ParameterKey: aws.String("ContainerPort"), ParameterValue: aws.String("80")},
+			{ParameterKey: aws.String("ContainerImage"), ParameterValue: aws.String("1234567890.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:manual-bf3678c")},
+		},
+	}
+	stacks := func(envOutputs ...*cloudformation.Output) func(in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+		return func(in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+			switch aws.StringValue(in.StackName) {
+			case "phonetool-test-frontend":
+				return &cloudformation.DescribeStacksOutput{Stacks: []*cloudformation.Stack{appStack}}, nil
+			case "phonetool-test":
+				return &cloudformation.DescribeStacksOutput{Stacks: []*cloudformation.Stack{{Outputs: envOutputs}}}, nil
+			}
+			return nil, fmt.Errorf("unexpected stack %s", aws.StringValue(in.StackName))
+		}
+	}
+
+	testCases := map[string]struct {
+		inRulePath string
+		mockCFN    mockCFN
+
+		wantedEnv *WebAppEnvironment
+		wantedErr error
+	}{
+		"returns NOT_DEPLOYED if the application stack does not exist": {
+			mockCFN: mockCFN{
+				mockDescribeStacks: func(in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+					return nil, awserr.New("ValidationError", "Stack with id phonetool-test-frontend does not exist", nil)
+				},
+			},
+			wantedEnv: &WebAppEnvironment{
+				Name:        "test",
+				StackStatus: StackStatusNotDeployed,
+			},
+		},
+		"wraps error from DescribeStacks": {
+			mockCFN: mockCFN{
+				mockDescribeStacks: func(in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+					return nil, mockErr
+				},
+			},
+			wantedErr: fmt.Errorf("describe stack phonetool-test-frontend: %w", mockErr),
+		},
+		"returns the load balancer URL with the path of the application": {
+			inRulePath: "frontend",
+			mockCFN: mockCFN{
+				mockDescribeStacks: stacks(&cloudformation.Output{
+					OutputKey:   aws.String("PublicLoadBalancerDNSName"),
+					OutputValue: aws.String("abc.us-west-2.elb.amazonaws.com"),
+				}),
+			},
+			wantedEnv: &WebAppEnvironment{
+				Name:        "test",
+				URL:         "http://abc.us-west-2.elb.amazonaws.com/frontend",
+				StackStatus: "UPDATE_COMPLETE",
+				ImageTag:    "manual-bf3678c",
+			},
+		},
+		"returns the load balancer URL if the application receives all requests": {
+			inRulePath: "*",
+			mockCFN: mockCFN{
+				mockDescribeStacks: stacks(&cloudformation.Output{
+					OutputKey:   aws.String("PublicLoadBalancerDNSName"),
+					OutputValue: aws.String("abc.us-west-2.elb.amazonaws.com"),
+				}),
+			},
+			wantedEnv: &WebAppEnvironment{
+				Name:        "test",
+				URL:         "http://abc.us-west-2.elb.amazonaws.com",
+				StackStatus: "UPDATE_COMPLETE",
+				ImageTag:    "manual-bf3678c",
+			},
+		},
+		"returns the subdomain URL if the environment has a domain": {
+			inRulePath: "*",
+			mockCFN: mockCFN{
+				mockDescribeStacks: stacks(
+					&cloudformation.Output{
+						OutputKey:   aws.String("PublicLoadBalancerDNSName"),
+						OutputValue: aws.String("abc.us-west-2.elb.amazonaws.com"),
+					},
+					&cloudformation.Output{
+						OutputKey:   aws.String("EnvironmentSubdomain"),
+						OutputValue: aws.String("test.phonetool.example.com"),
+					},
+				),
+			},
+			wantedEnv: &WebAppEnvironment{
+				Name:        "test",
+				URL:         "https://frontend.test.phonetool.example.com",
+				StackStatus: "UPDATE_COMPLETE",
+				ImageTag:    "manual-bf3678c",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			d := &WebAppDescriber{
+				cfn: map[string]cloudformationiface.CloudFormationAPI{
+					"test": tc.mockCFN,
+				},
+			}
+
+			// WHEN
+			env, err := d.Describe(&archer.Environment{Project: "phonetool", Name: "test"}, "frontend", tc.inRulePath)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEnv, env)
+		})
+	}
+}
+
+func TestCPUToVCPU(t *testing.T) {
+	require.Equal(t, "0.25", cpuToVCPU(256))
+	require.Equal(t, "0.5", cpuToVCPU(512))
+	require.Equal(t, "1", cpuToVCPU(1024))
+}
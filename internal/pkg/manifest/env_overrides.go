@@ -0,0 +1,96 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	environmentsKey = "environments"
+	variablesKey    = "variables"
+	secretsKey      = "secrets"
+
+	manifestIndent = 2
+)
+
+// AddEnvOverrides returns the application manifest with the variables and secrets added to the overrides
+// of the environment. Comments and the order of the existing fields in the manifest are preserved.
+func AddEnvOverrides(in []byte, envName string, variables, secrets map[string]string) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(in, &doc); err != nil {
+		return nil, &ErrUnmarshalAppManifest{parent: err}
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, &ErrUnmarshalAppManifest{parent: fmt.Errorf("manifest must be a map")}
+	}
+
+	envs := mappingChild(doc.Content[0], environmentsKey)
+	env := mappingChild(envs, envName)
+	if len(variables) > 0 {
+		setMappingValues(mappingChild(env, variablesKey), variables)
+	}
+	if len(secrets) > 0 {
+		setMappingValues(mappingChild(env, secretsKey), secrets)
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(manifestIndent)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, fmt.Errorf("marshal manifest: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("marshal manifest: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// mappingChild returns the mapping node under the key, creating it if it doesn't exist or is empty.
+func mappingChild(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i < len(node.Content); i += 2 {
+		if node.Content[i].Value != key {
+			continue
+		}
+		child := node.Content[i+1]
+		if child.Kind != yaml.MappingNode {
+			// The key exists without any value, e.g. "environments:".
+			child.Kind = yaml.MappingNode
+			child.Tag = ""
+			child.Value = ""
+		}
+		return child
+	}
+	child := &yaml.Node{Kind: yaml.MappingNode}
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, child)
+	return child
+}
+
+// setMappingValues sets the values of the keys in the mapping node sorted by key.
+func setMappingValues(node *yaml.Node, values map[string]string) {
+	var keys []string
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		updated := false
+		for i := 0; i < len(node.Content); i += 2 {
+			if node.Content[i].Value == k {
+				node.Content[i+1] = &yaml.Node{Kind: yaml.ScalarNode, Value: values[k]}
+				updated = true
+				break
+			}
+		}
+		if !updated {
+			node.Content = append(node.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Value: k},
+				&yaml.Node{Kind: yaml.ScalarNode, Value: values[k]})
+		}
+	}
+}
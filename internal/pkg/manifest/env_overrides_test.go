@@ -0,0 +1,102 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddEnvOverrides(t *testing.T) {
+	testCases := map[string]struct {
+		in        string
+		envName   string
+		variables map[string]string
+		secrets   map[string]string
+
+		wantedManifest string
+		wantedErr      error
+	}{
+		"invalid manifest": {
+			in:        "- frontend",
+			envName:   "test",
+			wantedErr: &ErrUnmarshalAppManifest{},
+		},
+		"adds the environments section": {
+			in: `# The manifest for the "frontend" application.
+name: frontend
+count: 1 # Number of tasks.
+`,
+			envName:   "test",
+			variables: map[string]string{"USERS_NAME": "phonetool-test-frontend-Users"},
+			secrets:   map[string]string{"DB_SECRET": "arn:aws:secretsmanager:us-west-2:1234:secret:phonetool-test-frontend-DB"},
+			wantedManifest: `# The manifest for the "frontend" application.
+name: frontend
+count: 1 # Number of tasks.
+environments:
+  test:
+    variables:
+      USERS_NAME: phonetool-test-frontend-Users
+    secrets:
+      DB_SECRET: arn:aws:secretsmanager:us-west-2:1234:secret:phonetool-test-frontend-DB
+`,
+		},
+		"updates existing overrides": {
+			in: `name: frontend
+environments:
+  prod:
+    count: 2
+  test:
+    variables:
+      LOG_LEVEL: debug
+      USERS_NAME: old
+`,
+			envName: "test",
+			variables: map[string]string{
+				"USERS_NAME":  "phonetool-test-frontend-Users",
+				"ASSETS_NAME": "phonetool-test-frontend-assets",
+			},
+			wantedManifest: `name: frontend
+environments:
+  prod:
+    count: 2
+  test:
+    variables:
+      LOG_LEVEL: debug
+      USERS_NAME: phonetool-test-frontend-Users
+      ASSETS_NAME: phonetool-test-frontend-assets
+`,
+		},
+		"fills empty environment": {
+			in: `name: frontend
+environments:
+  test:
+`,
+			envName:   "test",
+			variables: map[string]string{"USERS_NAME": "phonetool-test-frontend-Users"},
+			wantedManifest: `name: frontend
+environments:
+  test:
+    variables:
+      USERS_NAME: phonetool-test-frontend-Users
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			out, err := AddEnvOverrides([]byte(tc.in), tc.envName, tc.variables, tc.secrets)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.IsType(t, tc.wantedErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedManifest, string(out))
+		})
+	}
+}
@@ -5,11 +5,18 @@ package manifest
 
 import (
 	"bytes"
+	"fmt"
+	"strings"
 	"text/template"
 
 	"github.com/aws/amazon-ecs-cli-v2/templates"
 )
 
+const (
+	fmtSecretsPath         = "/archer/%s/%s/secrets/"
+	fmtSecretParameterName = fmtSecretsPath + "%s"
+)
+
 // LBFargateManifest holds the configuration to build a container image with an exposed port that receives
 // requests through a load balancer with AWS Fargate as the compute engine.
 type LBFargateManifest struct {
@@ -30,6 +37,7 @@ type LBFargateConfig struct {
 	RoutingRule      `yaml:"http,flow"`
 	ContainersConfig `yaml:",inline"`
 	Scaling          *AutoScalingConfig `yaml:",flow"`
+	Exec             *bool              `yaml:"exec"` // Whether ECS Exec is enabled on the tasks. Defaults to off in production environments.
 }
 
 // ContainersConfig represents the resource boundaries and environment variables for the containers in the service.
@@ -43,7 +51,8 @@ type ContainersConfig struct {
 
 // RoutingRule holds the path to route requests to the service.
 type RoutingRule struct {
-	Path string `yaml:"path"`
+	Path   string `yaml:"path"`
+	Public *bool  `yaml:"public"` // Whether the service must be reachable from the internet. Defaults to true.
 }
 
 // AutoScalingConfig is the configuration to scale the service with target tracking scaling policies.
@@ -132,7 +141,8 @@ func (m *LBFargateManifest) EnvConf(envName string) LBFargateConfig {
 	}
 	conf := LBFargateConfig{
 		RoutingRule: RoutingRule{
-			Path: m.Path,
+			Path:   m.Path,
+			Public: m.Public,
 		},
 		ContainersConfig: ContainersConfig{
 			CPU:       m.CPU,
@@ -142,6 +152,7 @@ func (m *LBFargateManifest) EnvConf(envName string) LBFargateConfig {
 			Secrets:   secrets,
 		},
 		Scaling: scaling,
+		Exec:    m.Exec,
 	}
 
 	// Override with fields set in the environment.
@@ -149,6 +160,9 @@ func (m *LBFargateManifest) EnvConf(envName string) LBFargateConfig {
 	if target.RoutingRule.Path != "" {
 		conf.RoutingRule.Path = target.RoutingRule.Path
 	}
+	if target.RoutingRule.Public != nil {
+		conf.RoutingRule.Public = target.RoutingRule.Public
+	}
 	if target.CPU != 0 {
 		conf.CPU = target.CPU
 	}
@@ -164,6 +178,9 @@ func (m *LBFargateManifest) EnvConf(envName string) LBFargateConfig {
 	for k, v := range target.Secrets {
 		conf.Secrets[k] = v
 	}
+	if target.Exec != nil {
+		conf.Exec = target.Exec
+	}
 	if target.Scaling != nil {
 		if conf.Scaling == nil {
 			conf.Scaling = &AutoScalingConfig{}
@@ -184,6 +201,52 @@ func (m *LBFargateManifest) EnvConf(envName string) LBFargateConfig {
 	return conf
 }
 
+// IsPublic returns whether the service requires a load balancer reachable from the internet.
+// Services that aren't public can also be deployed to private environments behind their internal load balancer.
+func (r RoutingRule) IsPublic() bool {
+	if r.Public != nil {
+		return *r.Public
+	}
+	return true
+}
+
+// ExecEnabled returns whether ECS Exec should be enabled on the tasks given the application configuration of an environment.
+// Unless set explicitly, it is enabled in all environments but production ones.
+func (c LBFargateConfig) ExecEnabled(prod bool) bool {
+	if c.Exec != nil {
+		return *c.Exec
+	}
+	return !prod
+}
+
+// SecretParameterName returns the name of the SSM parameter holding a secret created by "secret init" for an environment.
+func SecretParameterName(project, env, name string) string {
+	return fmt.Sprintf(fmtSecretParameterName, project, env, name)
+}
+
+// SecretsPath returns the path of the SSM parameters holding the secrets of an environment.
+func SecretsPath(project, env string) string {
+	return fmt.Sprintf(fmtSecretsPath, project, env)
+}
+
+// SecretsValueFrom returns where each secret of the containers is read from in an environment.
+// Short names refer to the secrets created by "secret init" for the environment, while full SSM parameter names
+// starting with "/" and ARNs are kept as is.
+func (c ContainersConfig) SecretsValueFrom(project, env string) map[string]string {
+	if c.Secrets == nil {
+		return nil
+	}
+	valueFrom := make(map[string]string, len(c.Secrets))
+	for k, v := range c.Secrets {
+		if strings.HasPrefix(v, "/") || strings.HasPrefix(v, "arn:") {
+			valueFrom[k] = v
+			continue
+		}
+		valueFrom[k] = SecretParameterName(project, env, v)
+	}
+	return valueFrom
+}
+
 // CFNTemplate serializes the manifest object into a CloudFormation template.
 func (m *LBFargateManifest) CFNTemplate() (string, error) {
 	return "", nil
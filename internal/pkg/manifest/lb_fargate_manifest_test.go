@@ -7,6 +7,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
 )
 
@@ -30,6 +31,8 @@ image:
 http:
   # Requests to this path will be forwarded to your service.
   path: '*'
+  # Set to false to also deploy your service to private environments behind their internal load balancer.
+  #public: false
 
 # Number of CPU units for the task.
 cpu: 256
@@ -44,7 +47,7 @@ count: 1
 #  LOG_LEVEL: info
 #
 #secrets:                      # Pass secrets from AWS Systems Manager (SSM) Parameter Store.
-#  GITHUB_TOKEN: GITHUB_TOKEN  # The key is the name of the environment variable, the value is the name of the SSM parameter.
+#  GITHUB_TOKEN: GITHUB_TOKEN  # The key is the name of the environment variable, the value is the name of a secret from "archer secret init" or the full name of an SSM parameter.
 #
 #scaling:                      # Optional configuration for scaling your service.
 #  minCount: 1                   # Minimum number of tasks that should be running in your service.
@@ -52,6 +55,8 @@ count: 1
 #
 #  # If the target value is crossed, ECS starts adding or removing tasks.
 #  targetCPU: 75.0               # Target average CPU utilization percentage.
+#
+#exec: true                    # Enable "archer app exec" shell sessions into your tasks. Defaults to false in production environments.
 
 # You can override any of the values defined above by environment.
 #environments:
@@ -174,7 +179,7 @@ func TestLBFargateManifest_EnvConf(t *testing.T) {
 			inEnvNameToQuery: "prod-iad",
 			inEnvOverride: map[string]LBFargateConfig{
 				"prod-iad": {
-					RoutingRule: RoutingRule{Path: "/frontend*"},
+					RoutingRule: RoutingRule{Path: "/frontend*", Public: aws.Bool(false)},
 					ContainersConfig: ContainersConfig{
 						CPU:    2046,
 						Memory: 2046,
@@ -194,11 +199,12 @@ func TestLBFargateManifest_EnvConf(t *testing.T) {
 						TargetCPU:    75.0,
 						TargetMemory: 75.0,
 					},
+					Exec: aws.Bool(true),
 				},
 			},
 
 			wantedConfig: LBFargateConfig{
-				RoutingRule: RoutingRule{Path: "/frontend*"},
+				RoutingRule: RoutingRule{Path: "/frontend*", Public: aws.Bool(false)},
 				ContainersConfig: ContainersConfig{
 					CPU:    2046,
 					Memory: 2046,
@@ -218,6 +224,7 @@ func TestLBFargateManifest_EnvConf(t *testing.T) {
 					TargetCPU:    75.0,
 					TargetMemory: 75.0,
 				},
+				Exec: aws.Bool(true),
 			},
 		},
 	}
@@ -239,3 +246,93 @@ func TestLBFargateManifest_EnvConf(t *testing.T) {
 		})
 	}
 }
+
+func TestRoutingRule_IsPublic(t *testing.T) {
+	testCases := map[string]struct {
+		inPublic *bool
+
+		wanted bool
+	}{
+		"public by default": {
+			wanted: true,
+		},
+		"explicitly not public": {
+			inPublic: aws.Bool(false),
+			wanted:   false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := RoutingRule{Public: tc.inPublic}
+
+			require.Equal(t, tc.wanted, rule.IsPublic())
+		})
+	}
+}
+
+func TestLBFargateConfig_ExecEnabled(t *testing.T) {
+	testCases := map[string]struct {
+		inExec *bool
+		inProd bool
+
+		wanted bool
+	}{
+		"enabled by default in non-production environments": {
+			inProd: false,
+			wanted: true,
+		},
+		"disabled by default in production environments": {
+			inProd: true,
+			wanted: false,
+		},
+		"explicitly enabled in a production environment": {
+			inExec: aws.Bool(true),
+			inProd: true,
+			wanted: true,
+		},
+		"explicitly disabled in a non-production environment": {
+			inExec: aws.Bool(false),
+			inProd: false,
+			wanted: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			conf := LBFargateConfig{Exec: tc.inExec}
+
+			require.Equal(t, tc.wanted, conf.ExecEnabled(tc.inProd))
+		})
+	}
+}
+
+func TestContainersConfig_SecretsValueFrom(t *testing.T) {
+	testCases := map[string]struct {
+		inSecrets map[string]string
+
+		wanted map[string]string
+	}{
+		"no secrets": {},
+		"resolves short names to the secrets of the environment": {
+			inSecrets: map[string]string{
+				"DB_PASSWORD":  "DB_PASSWORD",
+				"GITHUB_TOKEN": "/shared/github-token",
+				"API_KEY":      "arn:aws:secretsmanager:us-west-2:1111:secret:api-key",
+			},
+			wanted: map[string]string{
+				"DB_PASSWORD":  "/archer/phonetool/test/secrets/DB_PASSWORD",
+				"GITHUB_TOKEN": "/shared/github-token",
+				"API_KEY":      "arn:aws:secretsmanager:us-west-2:1111:secret:api-key",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			conf := ContainersConfig{Secrets: tc.inSecrets}
+
+			require.Equal(t, tc.wanted, conf.SecretsValueFrom("phonetool", "test"))
+		})
+	}
+}
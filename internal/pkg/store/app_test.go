@@ -4,6 +4,7 @@
 package store
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -309,3 +310,52 @@ func TestStore_CreateApplication(t *testing.T) {
 		})
 	}
 }
+
+func TestStore_DeleteApplication(t *testing.T) {
+	testCases := map[string]struct {
+		mockDeleteParam func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
+
+		wantedError error
+	}{
+		"parameter is already deleted": {
+			mockDeleteParam: func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				return nil, awserr.New(ssm.ErrCodeParameterNotFound, "Not found", nil)
+			},
+		},
+		"unexpected error": {
+			mockDeleteParam: func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedError: errors.New("delete application frontend from project phonetool: some error"),
+		},
+		"successfully deleted param": {
+			mockDeleteParam: func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				wantedPath := fmt.Sprintf(fmtAppParamPath, "phonetool", "frontend")
+				require.Equal(t, wantedPath, *in.Name)
+				return nil, nil
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := &Store{
+				ssmClient: &mockSSM{
+					t:                   t,
+					mockDeleteParameter: tc.mockDeleteParam,
+				},
+			}
+
+			// WHEN
+			err := store.DeleteApplication("phonetool", "frontend")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
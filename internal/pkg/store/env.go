@@ -51,6 +51,30 @@ func (s *Store) CreateEnvironment(environment *archer.Environment) error {
 
 }
 
+// UpdateEnvironment overwrites the stored configuration of an existing environment.
+func (s *Store) UpdateEnvironment(environment *archer.Environment) error {
+	if _, err := s.GetEnvironment(environment.Project, environment.Name); err != nil {
+		return err
+	}
+
+	environmentPath := fmt.Sprintf(fmtEnvParamPath, environment.Project, environment.Name)
+	data, err := marshal(environment)
+	if err != nil {
+		return fmt.Errorf("serializing environment %s: %w", environment.Name, err)
+	}
+
+	if _, err := s.ssmClient.PutParameter(&ssm.PutParameterInput{
+		Name:        aws.String(environmentPath),
+		Description: aws.String(fmt.Sprintf("The %s deployment stage", environment.Name)),
+		Type:        aws.String(ssm.ParameterTypeString),
+		Value:       aws.String(data),
+		Overwrite:   aws.Bool(true),
+	}); err != nil {
+		return fmt.Errorf("update environment %s in project %s: %w", environment.Name, environment.Project, err)
+	}
+	return nil
+}
+
 // GetEnvironment gets an environment belonging to a particular project by name. If no environment is found
 // it returns ErrNoSuchEnvironment.
 func (s *Store) GetEnvironment(projectName string, environmentName string) (*archer.Environment, error) {
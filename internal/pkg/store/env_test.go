@@ -312,6 +312,90 @@ func TestStore_CreateEnvironment(t *testing.T) {
 	}
 }
 
+func TestStore_UpdateEnvironment(t *testing.T) {
+	testEnvironment := archer.Environment{Name: "test", Project: "chicken", AccountID: "1234", Region: "us-west-2", TemplateVersion: "v1.0.0"}
+	testEnvironmentString, err := marshal(testEnvironment)
+	testEnvironmentPath := fmt.Sprintf(fmtEnvParamPath, testEnvironment.Project, testEnvironment.Name)
+	require.NoError(t, err, "Marshal environment should not fail")
+
+	testCases := map[string]struct {
+		mockGetParameter func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+		mockPutParameter func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+		wantedErr        error
+	}{
+		"overwrites the existing environment": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				require.Equal(t, testEnvironmentPath, *param.Name)
+				return &ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Name:  aws.String(testEnvironmentPath),
+						Value: aws.String(testEnvironmentString),
+					},
+				}, nil
+			},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, testEnvironmentPath, *param.Name)
+				require.Equal(t, testEnvironmentString, *param.Value)
+				require.True(t, *param.Overwrite)
+				return &ssm.PutParameterOutput{
+					Version: aws.Int64(2),
+				}, nil
+			},
+		},
+		"with no existing environment": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return nil, awserr.New(ssm.ErrCodeParameterNotFound, "Not found", nil)
+			},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.FailNow(t, "should not be called")
+				return nil, nil
+			},
+			wantedErr: &ErrNoSuchEnvironment{
+				ProjectName:     testEnvironment.Project,
+				EnvironmentName: testEnvironment.Name,
+			},
+		},
+		"with SSM error": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return &ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Name:  aws.String(testEnvironmentPath),
+						Value: aws.String(testEnvironmentString),
+					},
+				}, nil
+			},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, fmt.Errorf("broken")
+			},
+			wantedErr: fmt.Errorf("update environment test in project chicken: broken"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := &Store{
+				ssmClient: &mockSSM{
+					t:                t,
+					mockPutParameter: tc.mockPutParameter,
+					mockGetParameter: tc.mockGetParameter,
+				},
+			}
+
+			// WHEN
+			env := testEnvironment
+			err := store.UpdateEnvironment(&env)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestStore_DeleteEnvironment(t *testing.T) {
 	testCases := map[string]struct {
 		inProjectName   string
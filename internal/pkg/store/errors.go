@@ -130,3 +130,28 @@ func (e *ErrNoSuchApplication) Error() string {
 	return fmt.Sprintf("couldn't find application %s in the project %s",
 		e.ApplicationName, e.ProjectName)
 }
+
+// ErrNoSuchAppRevision means a specific revision of an application couldn't be found in an environment.
+type ErrNoSuchAppRevision struct {
+	ProjectName     string
+	EnvironmentName string
+	ApplicationName string
+	Number          int
+}
+
+// Is returns whether the provided error equals this error.
+func (e *ErrNoSuchAppRevision) Is(target error) bool {
+	t, ok := target.(*ErrNoSuchAppRevision)
+	if !ok {
+		return false
+	}
+	return e.ProjectName == t.ProjectName &&
+		e.EnvironmentName == t.EnvironmentName &&
+		e.ApplicationName == t.ApplicationName &&
+		e.Number == t.Number
+}
+
+func (e *ErrNoSuchAppRevision) Error() string {
+	return fmt.Sprintf("couldn't find revision %d of application %s in environment %s of project %s",
+		e.Number, e.ApplicationName, e.EnvironmentName, e.ProjectName)
+}
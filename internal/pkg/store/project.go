@@ -98,3 +98,44 @@ func (s *Store) ListProjects() ([]*archer.Project, error) {
 	}
 	return projects, nil
 }
+
+// DeleteProject removes the project and every parameter stored under it, such as its environments and applications, from SSM.
+// If the project does not exist in the store or is successfully deleted then returns nil. Otherwise, returns an error.
+func (s *Store) DeleteProject(projectName string) error {
+	projectPath := fmt.Sprintf(fmtProjectPath, projectName)
+	var names []*string
+	var nextToken *string
+	for {
+		params, err := s.ssmClient.GetParametersByPath(&ssm.GetParametersByPathInput{
+			Path:      aws.String(projectPath + "/"),
+			Recursive: aws.Bool(true),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return fmt.Errorf("list parameters of project %s: %w", projectName, err)
+		}
+		for _, param := range params.Parameters {
+			names = append(names, param.Name)
+		}
+		nextToken = params.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	// The project itself is deleted last so that the command can be re-run if a batch fails.
+	names = append(names, aws.String(projectPath))
+
+	// Parameters that don't exist anymore are reported as invalid instead of failing the request.
+	for start := 0; start < len(names); start += maxDeleteParametersBatchSize {
+		end := start + maxDeleteParametersBatchSize
+		if end > len(names) {
+			end = len(names)
+		}
+		if _, err := s.ssmClient.DeleteParameters(&ssm.DeleteParametersInput{
+			Names: names[start:end],
+		}); err != nil {
+			return fmt.Errorf("delete parameters of project %s: %w", projectName, err)
+		}
+	}
+	return nil
+}
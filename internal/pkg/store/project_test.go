@@ -319,3 +319,70 @@ func TestStore_CreateProject(t *testing.T) {
 		})
 	}
 }
+
+func TestStore_DeleteProject(t *testing.T) {
+	testCases := map[string]struct {
+		mockGetParametersByPath func(t *testing.T, in *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error)
+		mockDeleteParameters    func(t *testing.T, in *ssm.DeleteParametersInput) (*ssm.DeleteParametersOutput, error)
+
+		wantedErr error
+	}{
+		"wraps error if the parameters cannot be listed": {
+			mockGetParametersByPath: func(t *testing.T, in *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedErr: errors.New("list parameters of project phonetool: some error"),
+		},
+		"deletes every parameter of the project in batches": {
+			mockGetParametersByPath: func(t *testing.T, in *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+				require.Equal(t, "/archer/phonetool/", aws.StringValue(in.Path))
+				require.True(t, aws.BoolValue(in.Recursive))
+				var params []*ssm.Parameter
+				for i := 0; i < 10; i++ {
+					params = append(params, &ssm.Parameter{Name: aws.String(fmt.Sprintf("/archer/phonetool/environments/env%d", i))})
+				}
+				return &ssm.GetParametersByPathOutput{Parameters: params}, nil
+			},
+			mockDeleteParameters: func(t *testing.T, in *ssm.DeleteParametersInput) (*ssm.DeleteParametersOutput, error) {
+				if len(in.Names) == 1 {
+					require.Equal(t, "/archer/phonetool", aws.StringValue(in.Names[0]))
+				} else {
+					require.Len(t, in.Names, 10)
+				}
+				return &ssm.DeleteParametersOutput{}, nil
+			},
+		},
+		"wraps error if the parameters cannot be deleted": {
+			mockGetParametersByPath: func(t *testing.T, in *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+				return &ssm.GetParametersByPathOutput{}, nil
+			},
+			mockDeleteParameters: func(t *testing.T, in *ssm.DeleteParametersInput) (*ssm.DeleteParametersOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedErr: errors.New("delete parameters of project phonetool: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := &Store{
+				ssmClient: &mockSSM{
+					t:                       t,
+					mockGetParametersByPath: tc.mockGetParametersByPath,
+					mockDeleteParameters:    tc.mockDeleteParameters,
+				},
+			}
+
+			// WHEN
+			err := store.DeleteProject("phonetool")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+// CreateAppRevision records a deployment of an application as its next revision in the environment, and sets
+// the number of the revision. Only the latest maxAppRevisions revisions are kept.
+func (s *Store) CreateAppRevision(rev *archer.AppRevision) error {
+	revisions, err := s.ListAppRevisions(rev.Project, rev.Env, rev.App)
+	if err != nil {
+		return err
+	}
+	rev.Number = 1
+	if len(revisions) > 0 {
+		rev.Number = revisions[0].Number + 1
+	}
+
+	data, err := marshal(rev)
+	if err != nil {
+		return fmt.Errorf("serializing revision %d of application %s: %w", rev.Number, rev.App, err)
+	}
+	_, err = s.ssmClient.PutParameter(&ssm.PutParameterInput{
+		Name:        aws.String(fmt.Sprintf(fmtAppRevisionParamPath, rev.Project, rev.Env, rev.App, rev.Number)),
+		Description: aws.String(fmt.Sprintf("ECS-CLI v2 revision %d of application %s in environment %s", rev.Number, rev.App, rev.Env)),
+		Type:        aws.String(ssm.ParameterTypeString),
+		Value:       aws.String(data),
+	})
+	if err != nil {
+		return fmt.Errorf("create revision %d of application %s in environment %s: %w", rev.Number, rev.App, rev.Env, err)
+	}
+
+	// The new revision isn't part of the listed revisions, so one less old revision is kept.
+	if len(revisions) < maxAppRevisions {
+		return nil
+	}
+	var names []*string
+	for _, old := range revisions[maxAppRevisions-1:] {
+		names = append(names, aws.String(fmt.Sprintf(fmtAppRevisionParamPath, old.Project, old.Env, old.App, old.Number)))
+	}
+	for start := 0; start < len(names); start += maxDeleteParametersBatchSize {
+		end := start + maxDeleteParametersBatchSize
+		if end > len(names) {
+			end = len(names)
+		}
+		if _, err := s.ssmClient.DeleteParameters(&ssm.DeleteParametersInput{
+			Names: names[start:end],
+		}); err != nil {
+			return fmt.Errorf("delete old revisions of application %s in environment %s: %w", rev.App, rev.Env, err)
+		}
+	}
+	return nil
+}
+
+// ListAppRevisions returns the revisions of an application in an environment, from the most recent.
+func (s *Store) ListAppRevisions(projectName, envName, appName string) ([]*archer.AppRevision, error) {
+	serializedRevisions, err := s.listParams(fmt.Sprintf(rootAppRevisionParamPath, projectName, envName, appName))
+	if err != nil {
+		return nil, fmt.Errorf("list revisions of application %s in environment %s: %w", appName, envName, err)
+	}
+	var revisions []*archer.AppRevision
+	for _, serializedRevision := range serializedRevisions {
+		var rev archer.AppRevision
+		if err := json.Unmarshal([]byte(*serializedRevision), &rev); err != nil {
+			return nil, fmt.Errorf("read revision details of application %s in environment %s: %w", appName, envName, err)
+		}
+		revisions = append(revisions, &rev)
+	}
+	sort.SliceStable(revisions, func(i, j int) bool {
+		return revisions[i].Number > revisions[j].Number
+	})
+	return revisions, nil
+}
+
+// GetAppRevision gets a revision of an application in an environment by number. If the revision
+// doesn't exist, it returns ErrNoSuchAppRevision.
+func (s *Store) GetAppRevision(projectName, envName, appName string, number int) (*archer.AppRevision, error) {
+	param, err := s.ssmClient.GetParameter(&ssm.GetParameterInput{
+		Name: aws.String(fmt.Sprintf(fmtAppRevisionParamPath, projectName, envName, appName, number)),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			case ssm.ErrCodeParameterNotFound:
+				return nil, &ErrNoSuchAppRevision{
+					ProjectName:     projectName,
+					EnvironmentName: envName,
+					ApplicationName: appName,
+					Number:          number,
+				}
+			}
+		}
+		return nil, fmt.Errorf("get revision %d of application %s in environment %s: %w", number, appName, envName, err)
+	}
+
+	var rev archer.AppRevision
+	if err := json.Unmarshal([]byte(*param.Parameter.Value), &rev); err != nil {
+		return nil, fmt.Errorf("read details of revision %d of application %s in environment %s: %w", number, appName, envName, err)
+	}
+	return &rev, nil
+}
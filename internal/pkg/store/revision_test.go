@@ -0,0 +1,162 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package store
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/stretchr/testify/require"
+)
+
+func revisionParams(t *testing.T, numbers ...int) []*ssm.Parameter {
+	var params []*ssm.Parameter
+	for _, number := range numbers {
+		data, err := marshal(&archer.AppRevision{Project: "chicken", Env: "test", App: "fe", Number: number})
+		require.NoError(t, err)
+		params = append(params, &ssm.Parameter{
+			Name:  aws.String(fmt.Sprintf(fmtAppRevisionParamPath, "chicken", "test", "fe", number)),
+			Value: aws.String(data),
+		})
+	}
+	return params
+}
+
+func TestStore_CreateAppRevision(t *testing.T) {
+	testCases := map[string]struct {
+		existingRevisions []int
+
+		wantedNumber  int
+		wantedDeleted []string
+	}{
+		"creates the first revision": {
+			wantedNumber: 1,
+		},
+		"increments the latest revision": {
+			existingRevisions: []int{2, 3, 1},
+			wantedNumber:      4,
+		},
+		"deletes the oldest revisions above the limit": {
+			existingRevisions: []int{2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+			wantedNumber:      13,
+			wantedDeleted: []string{
+				fmt.Sprintf(fmtAppRevisionParamPath, "chicken", "test", "fe", 3),
+				fmt.Sprintf(fmtAppRevisionParamPath, "chicken", "test", "fe", 2),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var deleted []string
+			store := &Store{
+				ssmClient: &mockSSM{
+					t: t,
+					mockGetParametersByPath: func(t *testing.T, in *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+						require.Equal(t, "/archer/chicken/revisions/test/fe/", aws.StringValue(in.Path))
+						return &ssm.GetParametersByPathOutput{Parameters: revisionParams(t, tc.existingRevisions...)}, nil
+					},
+					mockPutParameter: func(t *testing.T, in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+						require.Equal(t, fmt.Sprintf(fmtAppRevisionParamPath, "chicken", "test", "fe", tc.wantedNumber), aws.StringValue(in.Name))
+						return &ssm.PutParameterOutput{Version: aws.Int64(1)}, nil
+					},
+					mockDeleteParameters: func(t *testing.T, in *ssm.DeleteParametersInput) (*ssm.DeleteParametersOutput, error) {
+						deleted = append(deleted, aws.StringValueSlice(in.Names)...)
+						return &ssm.DeleteParametersOutput{}, nil
+					},
+				},
+			}
+			rev := &archer.AppRevision{Project: "chicken", Env: "test", App: "fe", ImageTag: "v1"}
+
+			// WHEN
+			err := store.CreateAppRevision(rev)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedNumber, rev.Number)
+			require.Equal(t, tc.wantedDeleted, deleted)
+		})
+	}
+}
+
+func TestStore_ListAppRevisions(t *testing.T) {
+	// GIVEN
+	store := &Store{
+		ssmClient: &mockSSM{
+			t: t,
+			mockGetParametersByPath: func(t *testing.T, in *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+				return &ssm.GetParametersByPathOutput{Parameters: revisionParams(t, 9, 10, 8)}, nil
+			},
+		},
+	}
+
+	// WHEN
+	revisions, err := store.ListAppRevisions("chicken", "test", "fe")
+
+	// THEN
+	require.NoError(t, err)
+	var numbers []int
+	for _, rev := range revisions {
+		numbers = append(numbers, rev.Number)
+	}
+	require.Equal(t, []int{10, 9, 8}, numbers)
+}
+
+func TestStore_GetAppRevision(t *testing.T) {
+	testCases := map[string]struct {
+		mockGetParameter func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+
+		wantedRevision *archer.AppRevision
+		wantedErr      error
+	}{
+		"returns the revision": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				require.Equal(t, "/archer/chicken/revisions/test/fe/2", aws.StringValue(param.Name))
+				return &ssm.GetParameterOutput{Parameter: revisionParams(t, 2)[0]}, nil
+			},
+			wantedRevision: &archer.AppRevision{Project: "chicken", Env: "test", App: "fe", Number: 2},
+		},
+		"returns ErrNoSuchAppRevision if the revision doesn't exist": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return nil, awserr.New(ssm.ErrCodeParameterNotFound, "bloop", nil)
+			},
+			wantedErr: &ErrNoSuchAppRevision{ProjectName: "chicken", EnvironmentName: "test", ApplicationName: "fe", Number: 2},
+		},
+		"wraps other errors": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedErr: errors.New("get revision 2 of application fe in environment test: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := &Store{
+				ssmClient: &mockSSM{
+					t:                t,
+					mockGetParameter: tc.mockGetParameter,
+				},
+			}
+
+			// WHEN
+			rev, err := store.GetAppRevision("chicken", "test", "fe", 2)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedRevision, rev)
+		})
+	}
+}
@@ -35,14 +35,21 @@ func NewStore() (*SecretsManager, error) {
 	}, nil
 }
 
-// CreateSecret creates a secret and returns secret ARN
+// CreateSecret creates a secret with the input tags and returns secret ARN
 // NOTE: Currently the default KMS key ("aws/secretsmanager") is used for
 // encrypting the secret.
-func (s *SecretsManager) CreateSecret(secretName, secretString string) (string, error) {
+func (s *SecretsManager) CreateSecret(secretName, secretString string, tags map[string]string) (string, error) {
+	var secretTags []*secretsmanager.Tag
+	for k, v := range tags {
+		secretTags = append(secretTags, &secretsmanager.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
 	resp, err := s.secretsManager.CreateSecret(&secretsmanager.CreateSecretInput{
 		Name:         aws.String(secretName),
 		SecretString: aws.String(secretString),
-		// TODO add Tags/Description?
+		Tags:         secretTags,
 	})
 
 	if err != nil {
@@ -60,6 +67,61 @@ func (s *SecretsManager) CreateSecret(secretName, secretString string) (string,
 	return aws.StringValue(resp.ARN), nil
 }
 
+// ListSecretNames returns the names of the secrets that have every input tag.
+func (s *SecretsManager) ListSecretNames(tags map[string]string) ([]string, error) {
+	var names []string
+	var nextToken *string
+	for {
+		resp, err := s.secretsManager.ListSecrets(&secretsmanager.ListSecretsInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list secrets: %w", err)
+		}
+		for _, secret := range resp.SecretList {
+			if hasTags(secret.Tags, tags) {
+				names = append(names, aws.StringValue(secret.Name))
+			}
+		}
+		nextToken = resp.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	return names, nil
+}
+
+func hasTags(secretTags []*secretsmanager.Tag, tags map[string]string) bool {
+	values := make(map[string]string)
+	for _, t := range secretTags {
+		values[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	for k, v := range tags {
+		if value, ok := values[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
+// DeleteSecret deletes a secret immediately, without a recovery window.
+// If the secret does not exist then returns nil.
+func (s *SecretsManager) DeleteSecret(secretName string) error {
+	_, err := s.secretsManager.DeleteSecret(&secretsmanager.DeleteSecretInput{
+		SecretId:                   aws.String(secretName),
+		ForceDeleteWithoutRecovery: aws.Bool(true),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			if aerr.Code() == secretsmanager.ErrCodeResourceNotFoundException {
+				return nil
+			}
+		}
+		return fmt.Errorf("delete secret %s: %w", secretName, err)
+	}
+	return nil
+}
+
 type ErrSecretAlreadyExists struct {
 	secretName string
 	parentErr  error
@@ -0,0 +1,98 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package secretsmanager
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSecretsManager struct {
+	secretsmanageriface.SecretsManagerAPI
+	t               *testing.T
+	mockListSecrets func(t *testing.T, in *secretsmanager.ListSecretsInput) (*secretsmanager.ListSecretsOutput, error)
+}
+
+func (m *mockSecretsManager) ListSecrets(in *secretsmanager.ListSecretsInput) (*secretsmanager.ListSecretsOutput, error) {
+	return m.mockListSecrets(m.t, in)
+}
+
+func projectSecret(name, project string) *secretsmanager.SecretListEntry {
+	return &secretsmanager.SecretListEntry{
+		Name: aws.String(name),
+		Tags: []*secretsmanager.Tag{
+			{Key: aws.String("ecs-project"), Value: aws.String(project)},
+		},
+	}
+}
+
+func TestSecretsManager_ListSecretNames(t *testing.T) {
+	testCases := map[string]struct {
+		mockListSecrets func(t *testing.T, in *secretsmanager.ListSecretsInput) (*secretsmanager.ListSecretsOutput, error)
+
+		wantedNames []string
+		wantedErr   error
+	}{
+		"wraps error from ListSecrets": {
+			mockListSecrets: func(t *testing.T, in *secretsmanager.ListSecretsInput) (*secretsmanager.ListSecretsOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedErr: errors.New("list secrets: some error"),
+		},
+		"returns only the secrets tagged with the project": {
+			mockListSecrets: func(t *testing.T, in *secretsmanager.ListSecretsInput) (*secretsmanager.ListSecretsOutput, error) {
+				return &secretsmanager.ListSecretsOutput{
+					SecretList: []*secretsmanager.SecretListEntry{
+						projectSecret("github-token-api-frontend", "api"),
+						projectSecret("github-token-api-v2-frontend", "api-v2"),
+						{Name: aws.String("github-token-api-backend")},
+					},
+				}, nil
+			},
+			wantedNames: []string{"github-token-api-frontend"},
+		},
+		"returns the secrets of every page": {
+			mockListSecrets: func(t *testing.T, in *secretsmanager.ListSecretsInput) (*secretsmanager.ListSecretsOutput, error) {
+				if in.NextToken == nil {
+					return &secretsmanager.ListSecretsOutput{
+						SecretList: []*secretsmanager.SecretListEntry{projectSecret("github-token-api-frontend", "api")},
+						NextToken:  aws.String("next"),
+					}, nil
+				}
+				return &secretsmanager.ListSecretsOutput{
+					SecretList: []*secretsmanager.SecretListEntry{projectSecret("github-token-api-backend", "api")},
+				}, nil
+			},
+			wantedNames: []string{"github-token-api-frontend", "github-token-api-backend"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			s := &SecretsManager{
+				secretsManager: &mockSecretsManager{
+					t:               t,
+					mockListSecrets: tc.mockListSecrets,
+				},
+			}
+
+			// WHEN
+			names, err := s.ListSecretNames(map[string]string{"ecs-project": "api"})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedNames, names)
+		})
+	}
+}
@@ -39,8 +39,17 @@ const (
 	fmtEnvParamPath  = "/archer/%s/environments/%s" // path for an environment in a project
 	rootAppParamPath = "/archer/%s/applications/"
 	fmtAppParamPath  = "/archer/%s/applications/%s" // path for an application in a project
+
+	rootAppRevisionParamPath = "/archer/%s/revisions/%s/%s/"
+	fmtAppRevisionParamPath  = "/archer/%s/revisions/%s/%s/%d" // path for a revision of an application in an environment
 )
 
+// maxAppRevisions is the number of revisions kept for an application in an environment. Older revisions are deleted.
+const maxAppRevisions = 10
+
+// maxDeleteParametersBatchSize is the maximum number of parameters that can be deleted in a single DeleteParameters call.
+const maxDeleteParametersBatchSize = 10
+
 type identityService interface {
 	Get() (identity.Caller, error)
 }
@@ -20,6 +20,7 @@ type mockSSM struct {
 	mockGetParametersByPath func(t *testing.T, param *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error)
 	mockGetParameter        func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
 	mockDeleteParameter     func(t *testing.T, param *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
+	mockDeleteParameters    func(t *testing.T, param *ssm.DeleteParametersInput) (*ssm.DeleteParametersOutput, error)
 }
 
 func (m *mockSSM) PutParameter(in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
@@ -38,6 +39,10 @@ func (m *mockSSM) DeleteParameter(in *ssm.DeleteParameterInput) (*ssm.DeletePara
 	return m.mockDeleteParameter(m.t, in)
 }
 
+func (m *mockSSM) DeleteParameters(in *ssm.DeleteParametersInput) (*ssm.DeleteParametersOutput, error) {
+	return m.mockDeleteParameters(m.t, in)
+}
+
 type mockIdentityService struct {
 	mockIdentityServiceGet func() (identity.Caller, error)
 }
@@ -22,8 +22,13 @@ var (
 	BoldUnderline = color.New(color.Bold, color.Underline)
 	Magenta       = color.New(color.FgMagenta)
 	Blue          = color.New(color.FgBlue)
+	Green         = color.New(color.FgGreen)
+	Yellow        = color.New(color.FgYellow)
 )
 
+// rotation holds distinct colors to tell apart the items of a list, such as the tasks of an application.
+var rotation = []*color.Color{Cyan, Magenta, Green, Yellow, Blue, Red}
+
 const colorEnvVar = "COLOR"
 
 var lookupEnv = os.LookupEnv
@@ -64,3 +69,8 @@ func HighlightResource(s string) string {
 func HighlightCode(s string) string {
 	return Magenta.Sprintf("`%s`", s)
 }
+
+// Rotate colors the string with the color at the index in a rotation of distinct colors, and returns it.
+func Rotate(i int, s string) string {
+	return rotation[i%len(rotation)].Sprint(s)
+}
@@ -0,0 +1,126 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package output writes the results of read commands to stdout in the format selected with the --output flag.
+//
+// The JSON and YAML formats share the same schema: the fields and their order are the ones of the json tags
+// of the written data. Scripts should rely on these formats, the table format is meant to be read by humans.
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/progress"
+	"gopkg.in/yaml.v3"
+)
+
+// Formats supported by the --output flag.
+const (
+	TableFormat = "table"
+	JSONFormat  = "json"
+	YAMLFormat  = "yaml"
+)
+
+// Formats are the supported output formats, the first one is the default.
+var Formats = []string{TableFormat, JSONFormat, YAMLFormat}
+
+// Table is implemented by data that can be written as rows of columns.
+type Table interface {
+	Header() []string
+	Rows() [][]string
+}
+
+// HumanStringer is implemented by data that is written as text in the table format, such as descriptions
+// made of several tables.
+type HumanStringer interface {
+	HumanString() string
+}
+
+// ErrUnsupportedFormat occurs when the output format isn't one of the Formats.
+type ErrUnsupportedFormat struct {
+	Format string
+}
+
+func (e *ErrUnsupportedFormat) Error() string {
+	return fmt.Sprintf("output format %s is not supported, must be one of %s", e.Format, strings.Join(Formats, ", "))
+}
+
+// Validate returns an error if the format isn't supported.
+func Validate(format string) error {
+	for _, f := range Formats {
+		if format == f {
+			return nil
+		}
+	}
+	return &ErrUnsupportedFormat{Format: format}
+}
+
+// Write writes the data to w in the format.
+// The table format requires the data to implement either the Table or the HumanStringer interface.
+func Write(w io.Writer, format string, data interface{}) error {
+	switch format {
+	case JSONFormat:
+		return writeJSON(w, data)
+	case YAMLFormat:
+		return writeYAML(w, data)
+	case TableFormat:
+		return writeTable(w, data)
+	}
+	return &ErrUnsupportedFormat{Format: format}
+}
+
+func writeJSON(w io.Writer, data interface{}) error {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("marshal to JSON: %w", err)
+	}
+	fmt.Fprintf(w, "%s\n", b)
+	return nil
+}
+
+// writeYAML converts the JSON representation of the data to YAML, so that both formats have the same schema.
+func writeYAML(w io.Writer, data interface{}) error {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("marshal to JSON: %w", err)
+	}
+	// JSON is a subset of YAML: decoding it into a node keeps the order of the fields.
+	var node yaml.Node
+	if err := yaml.Unmarshal(b, &node); err != nil {
+		return fmt.Errorf("unmarshal JSON to YAML: %w", err)
+	}
+	blockStyle(&node)
+	b, err = yaml.Marshal(&node)
+	if err != nil {
+		return fmt.Errorf("marshal to YAML: %w", err)
+	}
+	fmt.Fprintf(w, "%s", b)
+	return nil
+}
+
+// blockStyle resets the JSON flow style of the node and its children, so that they're written in the YAML block style.
+func blockStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
+
+func writeTable(w io.Writer, data interface{}) error {
+	switch v := data.(type) {
+	case Table:
+		writer := progress.NewTabWriter(w)
+		fmt.Fprintf(writer, "%s\n", strings.Join(v.Header(), "\t"))
+		for _, row := range v.Rows() {
+			fmt.Fprintf(writer, "%s\n", strings.Join(row, "\t"))
+		}
+		return writer.Flush()
+	case HumanStringer:
+		fmt.Fprint(w, v.HumanString())
+		return nil
+	}
+	return fmt.Errorf("%T can't be written as a table", data)
+}
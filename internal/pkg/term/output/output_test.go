@@ -0,0 +1,115 @@
+// Copyright 2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package output
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mockTable struct {
+	Names []string `json:"names"`
+}
+
+func (t mockTable) Header() []string {
+	return []string{"Name", "Length"}
+}
+
+func (t mockTable) Rows() [][]string {
+	var rows [][]string
+	for _, name := range t.Names {
+		rows = append(rows, []string{name, "short"})
+	}
+	return rows
+}
+
+type mockDescription struct {
+	Name    string            `json:"name"`
+	Enabled bool              `json:"enabled"`
+	Count   int               `json:"count"`
+	Tags    map[string]string `json:"tags"`
+	Ports   []int             `json:"ports,omitempty"`
+}
+
+func (d mockDescription) HumanString() string {
+	return "About " + d.Name + "\n"
+}
+
+func TestWrite(t *testing.T) {
+	testCases := map[string]struct {
+		inFormat string
+		inData   interface{}
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"writes compact JSON": {
+			inFormat:      JSONFormat,
+			inData:        mockDescription{Name: "frontend", Count: 2, Tags: map[string]string{"team": "web"}},
+			wantedContent: `{"name":"frontend","enabled":false,"count":2,"tags":{"team":"web"}}` + "\n",
+		},
+		"writes YAML with the JSON fields in order": {
+			inFormat: YAMLFormat,
+			inData:   mockDescription{Name: "true", Enabled: true, Count: 2, Tags: map[string]string{}, Ports: []int{80, 443}},
+			wantedContent: `name: "true"
+enabled: true
+count: 2
+tags: {}
+ports:
+  - 80
+  - 443
+`,
+		},
+		"writes an aligned table": {
+			inFormat: TableFormat,
+			inData:   mockTable{Names: []string{"frontend", "api"}},
+			wantedContent: "Name                Length\n" +
+				"frontend            short\n" +
+				"api                 short\n",
+		},
+		"writes the human representation in the table format": {
+			inFormat:      TableFormat,
+			inData:        mockDescription{Name: "frontend"},
+			wantedContent: "About frontend\n",
+		},
+		"errors if the data can't be written as a table": {
+			inFormat:  TableFormat,
+			inData:    []string{"frontend"},
+			wantedErr: errors.New("[]string can't be written as a table"),
+		},
+		"errors on unsupported formats": {
+			inFormat:  "xml",
+			inData:    mockTable{},
+			wantedErr: errors.New("output format xml is not supported, must be one of table, json, yaml"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			b := &bytes.Buffer{}
+
+			// WHEN
+			err := Write(b, tc.inFormat, tc.inData)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, format := range Formats {
+		require.NoError(t, Validate(format))
+	}
+	require.Equal(t, &ErrUnsupportedFormat{Format: "xml"}, Validate("xml"))
+}
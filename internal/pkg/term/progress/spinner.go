@@ -14,7 +14,7 @@ import (
 	"github.com/briandowns/spinner"
 )
 
-// Events display settings.
+// Table display settings, shared by the events of a spinner and the tables written by commands.
 const (
 	minCellWidth           = 20  // minimum number of characters in a table's cell.
 	tabWidth               = 4   // number of characters in between columns.
@@ -23,6 +23,12 @@ const (
 	noAdditionalFormatting = 0
 )
 
+// NewTabWriter returns a writer that aligns the "\t" separated columns of a table with the display settings of events.
+// The writer must be flushed once the table is written.
+func NewTabWriter(w io.Writer) *tabwriter.Writer {
+	return tabwriter.NewWriter(w, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+}
+
 // TabRow represents a row in a table where columns are separated with a "\t" character.
 type TabRow string
 
@@ -63,7 +69,7 @@ func NewSpinner() *Spinner {
 	return &Spinner{
 		spin:         s,
 		cur:          cursor.New(),
-		eventsWriter: tabwriter.NewWriter(s.Writer, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting),
+		eventsWriter: NewTabWriter(s.Writer),
 	}
 }
 
@@ -124,6 +124,64 @@ func TestSelectOne(t *testing.T) {
 	}
 }
 
+func TestMultiSelect(t *testing.T) {
+	mockError := fmt.Errorf("error")
+	mockMessage := "Which droids are best droids?"
+	mockHelpMessage := "All the droids."
+
+	testCases := map[string]struct {
+		mockPrompter Prompt
+		mockOptions  []string
+
+		wantValue []string
+		wantError error
+	}{
+		"should return users input": {
+			mockPrompter: func(p survey.Prompt, out interface{}, opts ...survey.AskOpt) error {
+				internalPrompt, ok := p.(*survey.MultiSelect)
+
+				require.True(t, ok, "input prompt should be type *survey.MultiSelect")
+				require.Equal(t, mockMessage, internalPrompt.Message)
+				require.Equal(t, mockHelpMessage, internalPrompt.Help)
+				require.NotEmpty(t, internalPrompt.Options)
+
+				result, ok := out.(*[]string)
+
+				require.True(t, ok, "type to write user input to should be a slice of strings")
+
+				*result = internalPrompt.Options[:2]
+
+				require.Equal(t, 2, len(opts))
+
+				return nil
+			},
+			mockOptions: []string{"r2d2", "c3po", "bb8"},
+			wantValue:   []string{"r2d2", "c3po"},
+			wantError:   nil,
+		},
+		"should echo error": {
+			mockPrompter: func(p survey.Prompt, out interface{}, opts ...survey.AskOpt) error {
+				return mockError
+			},
+			mockOptions: []string{"apple", "orange", "banana"},
+			wantError:   mockError,
+		},
+		"should return error if input options list is empty": {
+			mockOptions: []string{},
+			wantError:   ErrEmptyOptions,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotValue, gotError := tc.mockPrompter.MultiSelect(mockMessage, mockHelpMessage, tc.mockOptions)
+
+			require.Equal(t, tc.wantValue, gotValue)
+			require.Equal(t, tc.wantError, gotError)
+		})
+	}
+}
+
 func TestConfirm(t *testing.T) {
 	mockError := fmt.Errorf("error")
 	mockMessage := "Is devx awesome?"
@@ -8,7 +8,11 @@
 //  .
 //  ├── ecs-project                    (manifest directory)
 //  │   ├── .ecs-workspace             (workspace summary)
+//  │   ├── .ecs-profiles              (AWS profiles of environments)
 //  │   ├── my-app.yml                 (application manifest)
+//  │   ├── my-app
+//  │   │   └── addons                 (additional CloudFormation resources for my-app)
+//  │   │       └── table.yml
 //  │   ├── buildspec.yml              (buildspec for the pipeline's build stage)
 //  │   └── pipeline.yml               (pipeline manifest)
 //  └── my-app                         (customer application)
@@ -33,11 +37,15 @@ const (
 	PipelineFileName = "pipeline.yml"
 	// BuildspecFileName is the name of the CodeBuild build specification for the "build" stage of the pipeline.
 	BuildspecFileName = "buildspec.yml"
+	// AddonsDirectoryName is the name of the directory under an application's directory where addon templates are stored.
+	AddonsDirectoryName = "addons"
 
 	workspaceSummaryFileName  = ".ecs-workspace"
+	envProfilesFileName       = ".ecs-profiles"
 	maximumParentDirsToSearch = 5
 	appManifestFileSuffix     = "-app.yml"
 	fmtAppManifestFileName    = "%s" + appManifestFileSuffix
+	addonFileSuffix           = ".yml"
 )
 
 // Workspace manages a local workspace, including creating and managing manifest files.
@@ -131,6 +139,72 @@ func (ws *Workspace) writeSummary(projectName string) error {
 	return ws.fsUtils.WriteFile(summaryPath, serializedWorkspaceSummary, 0644)
 }
 
+// envProfiles is the content of the file mapping environments to the AWS profile of their account.
+type envProfiles struct {
+	Environments map[string]string `yaml:"environments"`
+}
+
+// EnvProfiles returns the names of the AWS profiles used to manage environments, keyed by environment name.
+// It returns an empty map if no profile was recorded in the workspace.
+func (ws *Workspace) EnvProfiles() (map[string]string, error) {
+	profilesPath, err := ws.envProfilesPath()
+	if err != nil {
+		return nil, err
+	}
+	exists, err := ws.fsUtils.Exists(profilesPath)
+	if err != nil {
+		return nil, err
+	}
+	profiles := envProfiles{
+		Environments: make(map[string]string),
+	}
+	if !exists {
+		return profiles.Environments, nil
+	}
+	value, err := ws.fsUtils.ReadFile(profilesPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(value, &profiles); err != nil {
+		return nil, fmt.Errorf("unmarshal %s: %w", envProfilesFileName, err)
+	}
+	if profiles.Environments == nil {
+		profiles.Environments = make(map[string]string)
+	}
+	return profiles.Environments, nil
+}
+
+// SetEnvProfile records the AWS profile used to manage an environment.
+// An empty profile removes the environment's profile, so that it's managed with the default credentials.
+func (ws *Workspace) SetEnvProfile(envName, profile string) error {
+	profiles, err := ws.EnvProfiles()
+	if err != nil {
+		return err
+	}
+	if profile == "" {
+		delete(profiles, envName)
+	} else {
+		profiles[envName] = profile
+	}
+	profilesPath, err := ws.envProfilesPath()
+	if err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(envProfiles{Environments: profiles})
+	if err != nil {
+		return err
+	}
+	return ws.fsUtils.WriteFile(profilesPath, data, 0644)
+}
+
+func (ws *Workspace) envProfilesPath() (string, error) {
+	manifestPath, err := ws.manifestDirectoryPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(manifestPath, envProfilesFileName), nil
+}
+
 // AppNames returns the name of all the local applications. For now it
 // extracts the application name from the file name of the corresponding
 // application manifest.
@@ -168,6 +242,12 @@ func (ws *Workspace) createManifestDirectory() error {
 	return ws.fsUtils.Mkdir(ProjectDirectoryName, 0755)
 }
 
+// ManifestDirectoryPath returns the path of the manifest directory, searched for in the working directory
+// and its parent directories. It returns an ErrWorkspaceNotFound if none of them have one.
+func (ws *Workspace) ManifestDirectoryPath() (string, error) {
+	return ws.manifestDirectoryPath()
+}
+
 func (ws *Workspace) manifestDirectoryPath() (string, error) {
 	if ws.manifestDir != "" {
 		return ws.manifestDir, nil
@@ -221,6 +301,36 @@ func (ws *Workspace) ListManifestFiles() ([]string, error) {
 	return manifestFiles, nil
 }
 
+// ListAddonFiles returns the paths, relative to the project directory, of all the addon templates
+// of an application (e.g. frontend/addons/table.yml). If the application has no addons directory,
+// it returns an empty list.
+func (ws *Workspace) ListAddonFiles(appName string) ([]string, error) {
+	manifestDir, err := ws.manifestDirectoryPath()
+	if err != nil {
+		return nil, err
+	}
+	addonsDir := filepath.Join(appName, AddonsDirectoryName)
+	exists, err := ws.fsUtils.DirExists(filepath.Join(manifestDir, addonsDir))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+	files, err := ws.fsUtils.ReadDir(filepath.Join(manifestDir, addonsDir))
+	if err != nil {
+		return nil, err
+	}
+
+	var addonFiles []string
+	for _, file := range files {
+		if !file.IsDir() && strings.HasSuffix(file.Name(), addonFileSuffix) {
+			addonFiles = append(addonFiles, filepath.Join(addonsDir, file.Name()))
+		}
+	}
+	return addonFiles, nil
+}
+
 // ReadFile takes in a file name under the project directory (e.g. frontend-app.yml) and returns the read bytes.
 func (ws *Workspace) ReadFile(filename string) ([]byte, error) {
 	manifestDirPath, err := ws.manifestDirectoryPath()
@@ -255,12 +365,29 @@ func (ws *Workspace) WriteFile(blob []byte, filename string) (string, error) {
 	}
 
 	path := filepath.Join(manifestPath, filename)
+	if err := ws.fsUtils.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", fmt.Errorf("failed to create directory %s: %w", filepath.Dir(path), err)
+	}
 	if err := ws.fsUtils.WriteFile(path, blob, 0644); err != nil {
 		return "", fmt.Errorf("failed to write manifest file: %w", err)
 	}
 	return path, nil
 }
 
+// DeleteFile removes a file under the project directory (e.g. frontend-app.yml).
+// If the file doesn't exist, it's a no-op.
+func (ws *Workspace) DeleteFile(filename string) error {
+	manifestDirPath, err := ws.manifestDirectoryPath()
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(manifestDirPath, filename)
+	if err := ws.fsUtils.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete file %s: %w", path, err)
+	}
+	return nil
+}
+
 // AppManifestFileName returns the manifest's name from an application name.
 // TODO extend this to pipeline manifest filenames too
 func (ws *Workspace) AppManifestFileName(appName string) string {
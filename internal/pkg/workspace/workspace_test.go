@@ -128,6 +128,70 @@ func TestAppNames(t *testing.T) {
 	}
 }
 
+func TestListAddonFiles(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName      string
+		workingDir     string
+		mockFileSystem func(appFS afero.Fs)
+
+		wantedFiles []string
+		wantedError error
+	}{
+		"addons with extra files": {
+			inAppName:  "frontend",
+			workingDir: "test/",
+			mockFileSystem: func(appFS afero.Fs) {
+				appFS.MkdirAll("test/ecs-project/frontend/addons/nested", 0755)
+				afero.WriteFile(appFS, "test/ecs-project/frontend/addons/table.yml", []byte("table"), 0644)
+				afero.WriteFile(appFS, "test/ecs-project/frontend/addons/bucket.yml", []byte("bucket"), 0644)
+				afero.WriteFile(appFS, "test/ecs-project/frontend/addons/README.md", []byte("readme"), 0644)
+				afero.WriteFile(appFS, "test/ecs-project/frontend-app.yml", []byte("frontend"), 0644)
+			},
+			wantedFiles: []string{
+				filepath.Join("frontend", "addons", "table.yml"),
+				filepath.Join("frontend", "addons", "bucket.yml"),
+			},
+		},
+		"no addons directory": {
+			inAppName:  "frontend",
+			workingDir: "test/",
+			mockFileSystem: func(appFS afero.Fs) {
+				appFS.MkdirAll("test/ecs-project", 0755)
+				afero.WriteFile(appFS, "test/ecs-project/frontend-app.yml", []byte("frontend"), 0644)
+			},
+			wantedFiles: []string{},
+		},
+		"not in a valid workspace": {
+			inAppName:      "frontend",
+			workingDir:     "test/",
+			mockFileSystem: func(appFS afero.Fs) {},
+			wantedError:    fmt.Errorf("couldn't find a directory called ecs-project up to 5 levels up from test/"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			appFS := afero.NewMemMapFs()
+			tc.mockFileSystem(appFS)
+			ws := Workspace{
+				workingDir: tc.workingDir,
+				fsUtils:    &afero.Afero{Fs: appFS},
+			}
+
+			// WHEN
+			files, err := ws.ListAddonFiles(tc.inAppName)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.wantedFiles, files)
+		})
+	}
+}
+
 func TestReadManifest(t *testing.T) {
 	testCases := map[string]struct {
 		expectedContent string
@@ -215,6 +279,15 @@ func TestWriteManifest(t *testing.T) {
 				appFS.MkdirAll("test/ecs-project", 0755)
 			},
 		},
+		"new content in a nested directory": {
+			manifestFile:    "frontend/addons/table.yml",
+			expectedContent: "Resources:",
+			workingDir:      "test/",
+			expectedPath:    "test/ecs-project/frontend/addons/table.yml",
+			mockFileSystem: func(appFS afero.Fs) {
+				appFS.MkdirAll("test/ecs-project", 0755)
+			},
+		},
 		"no manifest dir": {
 			manifestFile:  "frontend-app.yml",
 			expectedPath:  "",
@@ -249,6 +322,59 @@ func TestWriteManifest(t *testing.T) {
 	}
 }
 
+func TestDeleteFile(t *testing.T) {
+	testCases := map[string]struct {
+		manifestFile   string
+		workingDir     string
+		expectedError  error
+		mockFileSystem func(appFS afero.Fs)
+	}{
+		"existing manifest": {
+			manifestFile: "frontend-app.yml",
+			workingDir:   "test/",
+			mockFileSystem: func(appFS afero.Fs) {
+				appFS.MkdirAll("test/ecs-project", 0755)
+				afero.WriteFile(appFS, "test/ecs-project/frontend-app.yml", []byte("frontend"), 0644)
+			},
+		},
+		"non-existent manifest": {
+			manifestFile: "frontend-app.yml",
+			workingDir:   "test/",
+			mockFileSystem: func(appFS afero.Fs) {
+				appFS.MkdirAll("test/ecs-project", 0755)
+			},
+		},
+		"no manifest dir": {
+			manifestFile:   "frontend-app.yml",
+			expectedError:  fmt.Errorf("couldn't find a directory called ecs-project up to 5 levels up from /"),
+			workingDir:     "/",
+			mockFileSystem: func(appFS afero.Fs) {},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// Create an empty FileSystem
+			appFS := afero.NewMemMapFs()
+			// Set it up
+			tc.mockFileSystem(appFS)
+
+			ws := Workspace{
+				workingDir: tc.workingDir,
+				fsUtils:    &afero.Afero{Fs: appFS},
+			}
+			err := ws.DeleteFile(tc.manifestFile)
+			if tc.expectedError == nil {
+				require.NoError(t, err)
+				exists, err := ws.fsUtils.Exists("test/ecs-project/" + tc.manifestFile)
+				require.NoError(t, err)
+				require.False(t, exists)
+			} else {
+				require.Equal(t, tc.expectedError.Error(), err.Error())
+			}
+		})
+	}
+}
+
 func TestManifestDirectoryPath(t *testing.T) {
 	// turn "test/ecs-project" into a platform-dependent path
 	var manifestDir = filepath.FromSlash("test/ecs-project")
@@ -382,6 +508,86 @@ func TestReadSummary(t *testing.T) {
 	}
 }
 
+func TestEnvProfiles(t *testing.T) {
+	testCases := map[string]struct {
+		workingDir     string
+		mockFileSystem func(appFS afero.Fs)
+
+		expectedProfiles map[string]string
+		expectedError    error
+	}{
+		"existing profiles": {
+			workingDir: "test/",
+			mockFileSystem: func(appFS afero.Fs) {
+				appFS.MkdirAll("test/ecs-project", 0755)
+				afero.WriteFile(appFS, "test/ecs-project/.ecs-profiles", []byte("environments:\n  prod: prod-admin\n"), 0644)
+			},
+			expectedProfiles: map[string]string{"prod": "prod-admin"},
+		},
+		"no profiles": {
+			workingDir: "test/",
+			mockFileSystem: func(appFS afero.Fs) {
+				appFS.MkdirAll("test/ecs-project", 0755)
+			},
+			expectedProfiles: map[string]string{},
+		},
+		"malformed profiles": {
+			workingDir: "test/",
+			mockFileSystem: func(appFS afero.Fs) {
+				appFS.MkdirAll("test/ecs-project", 0755)
+				afero.WriteFile(appFS, "test/ecs-project/.ecs-profiles", []byte("environments: [prod"), 0644)
+			},
+			expectedError: fmt.Errorf("unmarshal .ecs-profiles: yaml: line 1: did not find expected ',' or ']'"),
+		},
+		"no existing manifest dir": {
+			workingDir:     "test/",
+			mockFileSystem: func(appFS afero.Fs) {},
+			expectedError:  fmt.Errorf("couldn't find a directory called ecs-project up to 5 levels up from test/"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// Create an empty FileSystem
+			appFS := afero.NewMemMapFs()
+			// Set it up
+			tc.mockFileSystem(appFS)
+
+			ws := Workspace{
+				workingDir: tc.workingDir,
+				fsUtils:    &afero.Afero{Fs: appFS},
+			}
+			profiles, err := ws.EnvProfiles()
+			if tc.expectedError == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedProfiles, profiles)
+			} else {
+				require.EqualError(t, err, tc.expectedError.Error())
+			}
+		})
+	}
+}
+
+func TestSetEnvProfile(t *testing.T) {
+	// Create an empty FileSystem
+	appFS := afero.NewMemMapFs()
+	appFS.MkdirAll("test/ecs-project", 0755)
+	ws := Workspace{
+		workingDir: "test/",
+		fsUtils:    &afero.Afero{Fs: appFS},
+	}
+
+	require.NoError(t, ws.SetEnvProfile("prod", "prod-admin"))
+	require.NoError(t, ws.SetEnvProfile("test", "default"))
+	require.NoError(t, ws.SetEnvProfile("test", ""))
+
+	profiles, err := ws.EnvProfiles()
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"prod": "prod-admin"}, profiles)
+	content, err := afero.ReadFile(appFS, "test/ecs-project/.ecs-profiles")
+	require.NoError(t, err)
+	require.Equal(t, "environments:\n    prod: prod-admin\n", string(content))
+}
+
 func TestCreate(t *testing.T) {
 	testCases := map[string]struct {
 		projectName    string
@@ -77,6 +77,20 @@ func (mr *MockEnvironmentStoreMockRecorder) CreateEnvironment(env interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEnvironment", reflect.TypeOf((*MockEnvironmentStore)(nil).CreateEnvironment), env)
 }
 
+// UpdateEnvironment mocks base method
+func (m *MockEnvironmentStore) UpdateEnvironment(env *archer.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment
+func (mr *MockEnvironmentStoreMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockEnvironmentStore)(nil).UpdateEnvironment), env)
+}
+
 // DeleteEnvironment mocks base method
 func (m *MockEnvironmentStore) DeleteEnvironment(projectName, environmentName string) error {
 	m.ctrl.T.Helper()
@@ -204,6 +218,43 @@ func (mr *MockEnvironmentCreatorMockRecorder) CreateEnvironment(env interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEnvironment", reflect.TypeOf((*MockEnvironmentCreator)(nil).CreateEnvironment), env)
 }
 
+// MockEnvironmentUpdater is a mock of EnvironmentUpdater interface
+type MockEnvironmentUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnvironmentUpdaterMockRecorder
+}
+
+// MockEnvironmentUpdaterMockRecorder is the mock recorder for MockEnvironmentUpdater
+type MockEnvironmentUpdaterMockRecorder struct {
+	mock *MockEnvironmentUpdater
+}
+
+// NewMockEnvironmentUpdater creates a new mock instance
+func NewMockEnvironmentUpdater(ctrl *gomock.Controller) *MockEnvironmentUpdater {
+	mock := &MockEnvironmentUpdater{ctrl: ctrl}
+	mock.recorder = &MockEnvironmentUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockEnvironmentUpdater) EXPECT() *MockEnvironmentUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateEnvironment mocks base method
+func (m *MockEnvironmentUpdater) UpdateEnvironment(env *archer.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment
+func (mr *MockEnvironmentUpdaterMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockEnvironmentUpdater)(nil).UpdateEnvironment), env)
+}
+
 // MockEnvironmentDeleter is a mock of EnvironmentDeleter interface
 type MockEnvironmentDeleter struct {
 	ctrl     *gomock.Controller
@@ -77,6 +77,20 @@ func (mr *MockProjectStoreMockRecorder) CreateProject(project interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockProjectStore)(nil).CreateProject), project)
 }
 
+// DeleteProject mocks base method
+func (m *MockProjectStore) DeleteProject(projectName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", projectName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProject indicates an expected call of DeleteProject
+func (mr *MockProjectStoreMockRecorder) DeleteProject(projectName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockProjectStore)(nil).DeleteProject), projectName)
+}
+
 // MockProjectLister is a mock of ProjectLister interface
 type MockProjectLister struct {
 	ctrl     *gomock.Controller
@@ -152,6 +166,43 @@ func (mr *MockProjectCreatorMockRecorder) CreateProject(project interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockProjectCreator)(nil).CreateProject), project)
 }
 
+// MockProjectDeleter is a mock of ProjectDeleter interface
+type MockProjectDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectDeleterMockRecorder
+}
+
+// MockProjectDeleterMockRecorder is the mock recorder for MockProjectDeleter
+type MockProjectDeleterMockRecorder struct {
+	mock *MockProjectDeleter
+}
+
+// NewMockProjectDeleter creates a new mock instance
+func NewMockProjectDeleter(ctrl *gomock.Controller) *MockProjectDeleter {
+	mock := &MockProjectDeleter{ctrl: ctrl}
+	mock.recorder = &MockProjectDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockProjectDeleter) EXPECT() *MockProjectDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteProject mocks base method
+func (m *MockProjectDeleter) DeleteProject(projectName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", projectName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProject indicates an expected call of DeleteProject
+func (mr *MockProjectDeleterMockRecorder) DeleteProject(projectName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockProjectDeleter)(nil).DeleteProject), projectName)
+}
+
 // MockProjectGetter is a mock of ProjectGetter interface
 type MockProjectGetter struct {
 	ctrl     *gomock.Controller
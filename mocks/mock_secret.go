@@ -33,18 +33,47 @@ func (m *MockSecretsManager) EXPECT() *MockSecretsManagerMockRecorder {
 }
 
 // CreateSecret mocks base method
-func (m *MockSecretsManager) CreateSecret(secretName, secretString string) (string, error) {
+func (m *MockSecretsManager) CreateSecret(secretName, secretString string, tags map[string]string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSecret", secretName, secretString)
+	ret := m.ctrl.Call(m, "CreateSecret", secretName, secretString, tags)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateSecret indicates an expected call of CreateSecret
-func (mr *MockSecretsManagerMockRecorder) CreateSecret(secretName, secretString interface{}) *gomock.Call {
+func (mr *MockSecretsManagerMockRecorder) CreateSecret(secretName, secretString, tags interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockSecretsManager)(nil).CreateSecret), secretName, secretString)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockSecretsManager)(nil).CreateSecret), secretName, secretString, tags)
+}
+
+// ListSecretNames mocks base method
+func (m *MockSecretsManager) ListSecretNames(tags map[string]string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecretNames", tags)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecretNames indicates an expected call of ListSecretNames
+func (mr *MockSecretsManagerMockRecorder) ListSecretNames(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretNames", reflect.TypeOf((*MockSecretsManager)(nil).ListSecretNames), tags)
+}
+
+// DeleteSecret mocks base method
+func (m *MockSecretsManager) DeleteSecret(secretName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecret", secretName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecret indicates an expected call of DeleteSecret
+func (mr *MockSecretsManagerMockRecorder) DeleteSecret(secretName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockSecretsManager)(nil).DeleteSecret), secretName)
 }
 
 // MockSecretCreator is a mock of SecretCreator interface
@@ -71,16 +100,91 @@ func (m *MockSecretCreator) EXPECT() *MockSecretCreatorMockRecorder {
 }
 
 // CreateSecret mocks base method
-func (m *MockSecretCreator) CreateSecret(secretName, secretString string) (string, error) {
+func (m *MockSecretCreator) CreateSecret(secretName, secretString string, tags map[string]string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSecret", secretName, secretString)
+	ret := m.ctrl.Call(m, "CreateSecret", secretName, secretString, tags)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateSecret indicates an expected call of CreateSecret
-func (mr *MockSecretCreatorMockRecorder) CreateSecret(secretName, secretString interface{}) *gomock.Call {
+func (mr *MockSecretCreatorMockRecorder) CreateSecret(secretName, secretString, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockSecretCreator)(nil).CreateSecret), secretName, secretString, tags)
+}
+
+// MockSecretLister is a mock of SecretLister interface
+type MockSecretLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretListerMockRecorder
+}
+
+// MockSecretListerMockRecorder is the mock recorder for MockSecretLister
+type MockSecretListerMockRecorder struct {
+	mock *MockSecretLister
+}
+
+// NewMockSecretLister creates a new mock instance
+func NewMockSecretLister(ctrl *gomock.Controller) *MockSecretLister {
+	mock := &MockSecretLister{ctrl: ctrl}
+	mock.recorder = &MockSecretListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSecretLister) EXPECT() *MockSecretListerMockRecorder {
+	return m.recorder
+}
+
+// ListSecretNames mocks base method
+func (m *MockSecretLister) ListSecretNames(tags map[string]string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecretNames", tags)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecretNames indicates an expected call of ListSecretNames
+func (mr *MockSecretListerMockRecorder) ListSecretNames(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretNames", reflect.TypeOf((*MockSecretLister)(nil).ListSecretNames), tags)
+}
+
+// MockSecretDeleter is a mock of SecretDeleter interface
+type MockSecretDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretDeleterMockRecorder
+}
+
+// MockSecretDeleterMockRecorder is the mock recorder for MockSecretDeleter
+type MockSecretDeleterMockRecorder struct {
+	mock *MockSecretDeleter
+}
+
+// NewMockSecretDeleter creates a new mock instance
+func NewMockSecretDeleter(ctrl *gomock.Controller) *MockSecretDeleter {
+	mock := &MockSecretDeleter{ctrl: ctrl}
+	mock.recorder = &MockSecretDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSecretDeleter) EXPECT() *MockSecretDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteSecret mocks base method
+func (m *MockSecretDeleter) DeleteSecret(secretName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecret", secretName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecret indicates an expected call of DeleteSecret
+func (mr *MockSecretDeleterMockRecorder) DeleteSecret(secretName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockSecretCreator)(nil).CreateSecret), secretName, secretString)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockSecretDeleter)(nil).DeleteSecret), secretName)
 }
@@ -63,6 +63,20 @@ func (mr *MockWorkspaceMockRecorder) ReadFile(filename interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFile", reflect.TypeOf((*MockWorkspace)(nil).ReadFile), filename)
 }
 
+// DeleteFile mocks base method
+func (m *MockWorkspace) DeleteFile(filename string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFile", filename)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFile indicates an expected call of DeleteFile
+func (mr *MockWorkspaceMockRecorder) DeleteFile(filename interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFile", reflect.TypeOf((*MockWorkspace)(nil).DeleteFile), filename)
+}
+
 // ListManifestFiles mocks base method
 func (m *MockWorkspace) ListManifestFiles() ([]string, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +150,21 @@ func (mr *MockWorkspaceMockRecorder) AppNames() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppNames", reflect.TypeOf((*MockWorkspace)(nil).AppNames))
 }
 
+// ListAddonFiles mocks base method
+func (m *MockWorkspace) ListAddonFiles(appName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAddonFiles", appName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAddonFiles indicates an expected call of ListAddonFiles
+func (mr *MockWorkspaceMockRecorder) ListAddonFiles(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAddonFiles", reflect.TypeOf((*MockWorkspace)(nil).ListAddonFiles), appName)
+}
+
 // MockManifestIO is a mock of ManifestIO interface
 type MockManifestIO struct {
 	ctrl     *gomock.Controller
@@ -189,6 +218,20 @@ func (mr *MockManifestIOMockRecorder) ReadFile(filename interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFile", reflect.TypeOf((*MockManifestIO)(nil).ReadFile), filename)
 }
 
+// DeleteFile mocks base method
+func (m *MockManifestIO) DeleteFile(filename string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFile", filename)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFile indicates an expected call of DeleteFile
+func (mr *MockManifestIOMockRecorder) DeleteFile(filename interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFile", reflect.TypeOf((*MockManifestIO)(nil).DeleteFile), filename)
+}
+
 // ListManifestFiles mocks base method
 func (m *MockManifestIO) ListManifestFiles() ([]string, error) {
 	m.ctrl.T.Helper()
@@ -270,3 +313,17 @@ func (mr *MockWorkspaceFileReadWriterMockRecorder) ReadFile(filename interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFile", reflect.TypeOf((*MockWorkspaceFileReadWriter)(nil).ReadFile), filename)
 }
+
+// DeleteFile mocks base method
+func (m *MockWorkspaceFileReadWriter) DeleteFile(filename string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFile", filename)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFile indicates an expected call of DeleteFile
+func (mr *MockWorkspaceFileReadWriterMockRecorder) DeleteFile(filename interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFile", reflect.TypeOf((*MockWorkspaceFileReadWriter)(nil).DeleteFile), filename)
+}